@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"github.com/zeromicro/go-zero/rest/httpx"
+	"posta/pkg/active"
 	"posta/pkg/xcode"
 
 	"posta/application/applet/internal/config"
@@ -26,6 +27,8 @@ func main() {
 	defer server.Stop()
 
 	ctx := svc.NewServiceContext(c)
+	// 记录登录用户的活跃时间，关注流只给最近活跃的粉丝推送
+	server.Use(active.Middleware(ctx.BizRedis, active.TouchInterval))
 	handler.RegisterHandlers(server, ctx)
 
 	// 注意：自定义错误处理方法
@@ -27,17 +27,16 @@ func NewArticleListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Artic
 func (l *ArticleListLogic) ArticleList(req *types.ArticleListRequest) (resp *types.ArticleListResponse, err error) {
 	articles, err := l.svcCtx.ArticleRPC.Articles(l.ctx, &article.ArticlesRequest{
 		UserId:    req.AuthorId,
-		Cursor:    req.Cursor,
 		PageSize:  req.PageSize,
 		SortType:  req.SortType,
-		ArticleId: req.ArticleId,
+		PageToken: req.PageToken,
 	})
 	if err != nil {
 		logx.Errorf("get articles req: %v err: %v", req, err)
 		return nil, err
 	}
 	if articles == nil || len(articles.Articles) == 0 {
-		return &types.ArticleListResponse{}, nil
+		return &types.ArticleListResponse{IsEnd: true}, nil
 	}
 	articleInfos := make([]types.ArticleInfo, 0, len(articles.Articles))
 	for _, a := range articles.Articles {
@@ -50,6 +49,8 @@ func (l *ArticleListLogic) ArticleList(req *types.ArticleListRequest) (resp *typ
 	}
 
 	return &types.ArticleListResponse{
-		Articles: articleInfos,
+		Articles:      articleInfos,
+		IsEnd:         articles.IsEnd,
+		NextPageToken: articles.NextPageToken,
 	}, nil
 }
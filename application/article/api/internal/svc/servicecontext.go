@@ -2,6 +2,7 @@ package svc
 
 import (
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/zeromicro/go-zero/zrpc"
 	"posta/application/article/api/internal/config"
 	"posta/application/article/rpc/article"
 	"posta/application/user/rpc/user"
@@ -33,7 +34,9 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	}
 
 	return &ServiceContext{
-		Config:    c,
-		OssClient: oc,
+		Config:     c,
+		OssClient:  oc,
+		ArticleRPC: article.NewArticle(zrpc.MustNewClient(c.ArticleRPC)),
+		UserRPC:    user.NewUser(zrpc.MustNewClient(c.UserRPC)),
 	}
 }
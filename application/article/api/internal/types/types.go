@@ -25,15 +25,16 @@ type ArticleInfo struct {
 }
 
 type ArticleListRequest struct {
-	AuthorId  int64 `form:"author_id"`
-	Cursor    int64 `form:"cursor"`
-	PageSize  int64 `form:"page_size"`
-	SortType  int32 `form:"sort_type"`
-	ArticleId int64 `form:"article_id"`
+	AuthorId  int64  `form:"author_id"`
+	PageSize  int64  `form:"page_size"`
+	SortType  int32  `form:"sort_type"`
+	PageToken string `form:"page_token,optional"`
 }
 
 type ArticleListResponse struct {
-	Articles []ArticleInfo `json:"articles"`
+	Articles      []ArticleInfo `json:"articles"`
+	IsEnd         bool          `json:"is_end"`
+	NextPageToken string        `json:"next_page_token"`
 }
 
 type PublishRequest struct {
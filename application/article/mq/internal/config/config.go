@@ -11,8 +11,10 @@ type Config struct {
 	service.ServiceConf
 	KqConsumerConf        kq.KqConf
 	ArticleKqConsumerConf kq.KqConf
-	Datasource            string
-	BizRedis              redis.RedisConf
+	// canal监听reply_count表的binlog，用于同步文章评论数
+	ReplyCountKqConsumerConf kq.KqConf
+	Datasource               string
+	BizRedis                 redis.RedisConf
 	// es config
 	Es struct {
 		Addresses []string
@@ -20,4 +22,9 @@ type Config struct {
 		Password  string
 	}
 	UserRPC zrpc.RpcClientConf
+	// 热榜全量重算，两次重算之间靠canal消息增量更新
+	HotRank struct {
+		Interval int `json:",default=600"`  // 全量重算的间隔，单位秒
+		MaxCount int `json:",default=1000"` // 每个时间窗口最多保留的文章数
+	}
 }
@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"posta/application/article/mq/internal/svc"
+	"posta/application/article/mq/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ArticleCommentNumLogic 消费reply_count表的binlog，把文章的评论总数同步到article.comment_num
+type ArticleCommentNumLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewArticleCommentNumLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ArticleCommentNumLogic {
+	return &ArticleCommentNumLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ArticleCommentNumLogic) Consume(ctx context.Context, _, val string) error {
+	var msg *types.CanalReplyCountMsg
+	err := json.Unmarshal([]byte(val), &msg)
+	if err != nil {
+		logx.Errorf("Consume val: %s error: %v", val, err)
+		return err
+	}
+
+	return l.updateArticleCommentNum(ctx, msg)
+}
+
+func (l *ArticleCommentNumLogic) updateArticleCommentNum(ctx context.Context, msg *types.CanalReplyCountMsg) error {
+	if len(msg.Data) == 0 {
+		return nil
+	}
+
+	for _, d := range msg.Data {
+		// reply_count表中也有其它业务的评论数，target_id不是文章id
+		if d.BizID != types.ReplyBizArticle {
+			continue
+		}
+		id, err := strconv.ParseInt(d.TargetID, 10, 64)
+		if err != nil {
+			logx.Errorf("strconv.ParseInt target_id: %s error: %v", d.TargetID, err)
+			continue
+		}
+		commentNum, err := strconv.ParseInt(d.ReplyNum, 10, 64)
+		if err != nil {
+			logx.Errorf("strconv.ParseInt reply_num: %s error: %v", d.ReplyNum, err)
+			continue
+		}
+		err = l.svcCtx.ArticleModel.UpdateCommentNum(ctx, id, commentNum)
+		if err != nil {
+			logx.Errorf("UpdateCommentNum id: %d comment: %d error: %v", id, commentNum, err)
+		}
+	}
+
+	return nil
+}
@@ -62,7 +62,15 @@ func (l *ArticleLikeNumLogic) updateArticleLikeNum(ctx context.Context, msg *typ
 		err = l.svcCtx.ArticleModel.UpdateLikeNum(ctx, id, likeNum)
 		if err != nil {
 			logx.Errorf("UpdateLikeNum id: %d like: %d", id, likeNum)
+			continue
+		}
+		// 点赞是热度变化最频繁的来源，直接更新热榜，不等文章表的binlog
+		article, err := l.svcCtx.ArticleModel.FindOne(ctx, id)
+		if err != nil {
+			logx.Errorf("FindOne id: %d error: %v", id, err)
+			continue
 		}
+		updateHotRank(ctx, l.svcCtx, article)
 	}
 
 	return nil
@@ -73,5 +81,7 @@ func Consumers(ctx context.Context, svcCtx *svc.ServiceContext) []service.Servic
 		// 注意：这里跟原来github的不一样，我改了articlelikenumlogic.go和articlelogic.go里面的consume函数。
 		kq.MustNewQueue(svcCtx.Config.KqConsumerConf, NewArticleLikeNumLogic(ctx, svcCtx)),
 		kq.MustNewQueue(svcCtx.Config.ArticleKqConsumerConf, NewArticleLogic(ctx, svcCtx)),
+		kq.MustNewQueue(svcCtx.Config.ReplyCountKqConsumerConf, NewArticleCommentNumLogic(ctx, svcCtx)),
+		NewHotRankLogic(ctx, svcCtx),
 	}
 }
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"github.com/elastic/go-elasticsearch/v8/esutil"
 	"posta/application/user/rpc/user"
+	"posta/pkg/zsetcache"
 	"strconv"
 	"strings"
 	"time"
 
+	"posta/application/article/mq/internal/model"
 	"posta/application/article/mq/internal/svc"
 	"posta/application/article/mq/internal/types"
 
@@ -51,40 +53,50 @@ func (l *ArticleLogic) articleOperate(msg *types.CanalArticleMsg) error {
 	for _, d := range msg.Data {
 		status, _ := strconv.Atoi(d.Status)
 		likNum, _ := strconv.ParseInt(d.LikeNum, 10, 64)
+		commentNum, _ := strconv.ParseInt(d.CommentNum, 10, 64)
+		collectNum, _ := strconv.ParseInt(d.CollectNum, 10, 64)
+		viewNum, _ := strconv.ParseInt(d.ViewNum, 10, 64)
+		shareNum, _ := strconv.ParseInt(d.ShareNum, 10, 64)
 		articleId, _ := strconv.ParseInt(d.ID, 10, 64)
 		authorId, _ := strconv.ParseInt(d.AuthorId, 10, 64)
 
 		t, err := time.ParseInLocation("2006-01-02 15:04:05", d.PublishTime, time.Local)
-		publishTimeKey := articlesKey(d.AuthorId, 0)
-		likeNumKey := articlesKey(d.AuthorId, 1)
+		publishTimeKey := articlesKey(authorId, 0)
+		likeNumKey := articlesKey(authorId, 1)
 
 		switch status {
 		case types.ArticleStatusVisible:
-			b, _ := l.svcCtx.BizRedis.ExistsCtx(l.ctx, publishTimeKey)
-			if b {
-				_, err = l.svcCtx.BizRedis.ZaddCtx(l.ctx, publishTimeKey, t.Unix(), d.ID)
-				if err != nil {
-					l.Logger.Errorf("ZaddCtx key: %s req: %v error: %v", publishTimeKey, d, err)
-				}
+			// 只有缓存存在时才会加入，点赞数变化时也会更新文章在按点赞数排序的列表中的位置
+			err = l.svcCtx.ArticlesCache.Add(l.ctx, publishTimeKey, zsetcache.Item{Id: articleId, Score: t.Unix()})
+			if err != nil {
+				l.Logger.Errorf("ArticlesCache.Add key: %s req: %v error: %v", publishTimeKey, d, err)
 			}
-			b, _ = l.svcCtx.BizRedis.ExistsCtx(l.ctx, likeNumKey)
-			if b {
-				_, err = l.svcCtx.BizRedis.ZaddCtx(l.ctx, likeNumKey, likNum, d.ID)
-				if err != nil {
-					l.Logger.Errorf("ZaddCtx key: %s req: %v error: %v", likeNumKey, d, err)
-				}
+			err = l.svcCtx.ArticlesCache.Add(l.ctx, likeNumKey, zsetcache.Item{Id: articleId, Score: likNum})
+			if err != nil {
+				l.Logger.Errorf("ArticlesCache.Add key: %s req: %v error: %v", likeNumKey, d, err)
 			}
 		case types.ArticleStatusUserDelete:
-			_, err = l.svcCtx.BizRedis.ZremCtx(l.ctx, publishTimeKey, d.ID)
+			err = l.svcCtx.ArticlesCache.Remove(l.ctx, publishTimeKey, articleId)
 			if err != nil {
-				l.Logger.Errorf("ZremCtx key: %s req: %v error: %v", publishTimeKey, d, err)
+				l.Logger.Errorf("ArticlesCache.Remove key: %s req: %v error: %v", publishTimeKey, d, err)
 			}
-			_, err = l.svcCtx.BizRedis.ZremCtx(l.ctx, likeNumKey, d.ID)
+			err = l.svcCtx.ArticlesCache.Remove(l.ctx, likeNumKey, articleId)
 			if err != nil {
-				l.Logger.Errorf("ZremCtx key: %s req: %v error: %v", likeNumKey, d, err)
+				l.Logger.Errorf("ArticlesCache.Remove key: %s req: %v error: %v", likeNumKey, d, err)
 			}
 		}
 
+		updateHotRank(l.ctx, l.svcCtx, &model.Article{
+			Id:          articleId,
+			Status:      int64(status),
+			LikeNum:     likNum,
+			CommentNum:  commentNum,
+			CollectNum:  collectNum,
+			ViewNum:     viewNum,
+			ShareNum:    shareNum,
+			PublishTime: t,
+		})
+
 		u, err := l.svcCtx.UserRPC.FindById(l.ctx, &user.FindByIdRequest{
 			UserId: authorId,
 		})
@@ -153,6 +165,7 @@ func (l *ArticleLogic) BatchUpSertToEs(ctx context.Context, data []*types.Articl
 	return bi.Close(ctx)
 }
 
-func articlesKey(uid string, sortType int32) string {
-	return fmt.Sprintf("biz#articles#%s#%d", uid, sortType)
+// articlesKey 和article-rpc中的key保持一致
+func articlesKey(uid int64, sortType int32) string {
+	return fmt.Sprintf("biz#articles#v2#%d#%d", uid, sortType)
 }
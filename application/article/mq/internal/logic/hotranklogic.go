@@ -0,0 +1,171 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"posta/application/article/mq/internal/model"
+	"posta/application/article/mq/internal/svc"
+	"posta/application/article/mq/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	prefixHotArticles = "biz#article#hot#%s"
+	// 热度的参考时间。Hacker News的热度会随时间变化，不同时间算出来的分数不能直接比较，
+	// 所以增量更新时统一按上次全量重算的时间计算文章的发布时长
+	hotRefTimeKey = "biz#article#hot#ref"
+)
+
+// go-zero的redis没有封装RENAME
+const renameScript = `return redis.call("RENAME", KEYS[1], KEYS[2])`
+
+// HotRankLogic 定时全量重算热榜
+type HotRankLogic struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewHotRankLogic(ctx context.Context, svcCtx *svc.ServiceContext) *HotRankLogic {
+	ctx, cancel := context.WithCancel(ctx)
+	return &HotRankLogic{
+		ctx:    ctx,
+		cancel: cancel,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *HotRankLogic) Start() {
+	ticker := time.NewTicker(time.Duration(l.svcCtx.Config.HotRank.Interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		if err := l.rebuild(); err != nil {
+			l.Logger.Errorf("[HotRank] rebuild error: %v", err)
+		}
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (l *HotRankLogic) Stop() {
+	l.cancel()
+}
+
+// rebuild 扫描最长窗口内发布的文章，按参考时间重新打分，写入临时key后整体替换
+func (l *HotRankLogic) rebuild() error {
+	ref := time.Now()
+	var longest time.Duration
+	for _, d := range types.HotWindows {
+		longest = max(longest, d)
+	}
+	articles, err := l.svcCtx.ArticleModel.ArticlesPublishedAfter(l.ctx, ref.Add(-longest), types.HotScanLimit)
+	if err != nil {
+		return err
+	}
+
+	for window, d := range types.HotWindows {
+		var pairs []redis.FloatPair
+		for _, a := range articles {
+			if ref.Sub(a.PublishTime) > d {
+				continue
+			}
+			pairs = append(pairs, redis.FloatPair{
+				Key:   strconv.FormatInt(a.Id, 10),
+				Score: hotScore(a, ref),
+			})
+		}
+		sort.Slice(pairs, func(i, j int) bool {
+			return pairs[i].Score > pairs[j].Score
+		})
+		if len(pairs) > l.svcCtx.Config.HotRank.MaxCount {
+			pairs = pairs[:l.svcCtx.Config.HotRank.MaxCount]
+		}
+		if err = l.replace(hotArticlesKey(window), pairs); err != nil {
+			return err
+		}
+	}
+
+	return l.svcCtx.BizRedis.SetCtx(l.ctx, hotRefTimeKey, strconv.FormatInt(ref.Unix(), 10))
+}
+
+func (l *HotRankLogic) replace(key string, pairs []redis.FloatPair) error {
+	if len(pairs) == 0 {
+		_, err := l.svcCtx.BizRedis.DelCtx(l.ctx, key)
+		return err
+	}
+	tmpKey := key + "#tmp"
+	if _, err := l.svcCtx.BizRedis.DelCtx(l.ctx, tmpKey); err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		if _, err := l.svcCtx.BizRedis.ZaddFloatCtx(l.ctx, tmpKey, pair.Score, pair.Key); err != nil {
+			return err
+		}
+	}
+	_, err := l.svcCtx.BizRedis.EvalCtx(l.ctx, renameScript, []string{tmpKey, key})
+	return err
+}
+
+// updateHotRank 文章的互动数或状态变化时增量更新热榜，不可见或超出时间窗口的文章从榜上移除
+func updateHotRank(ctx context.Context, svcCtx *svc.ServiceContext, a *model.Article) {
+	ref := hotRefTime(ctx, svcCtx)
+	member := strconv.FormatInt(a.Id, 10)
+	for window, d := range types.HotWindows {
+		key := hotArticlesKey(window)
+		if a.Status != types.ArticleStatusVisible || time.Since(a.PublishTime) > d {
+			if _, err := svcCtx.BizRedis.ZremCtx(ctx, key, member); err != nil {
+				logx.WithContext(ctx).Errorf("[HotRank] ZremCtx key: %s member: %s error: %v", key, member, err)
+			}
+			continue
+		}
+		if _, err := svcCtx.BizRedis.ZaddFloatCtx(ctx, key, hotScore(a, ref), member); err != nil {
+			logx.WithContext(ctx).Errorf("[HotRank] ZaddFloatCtx key: %s member: %s error: %v", key, member, err)
+			continue
+		}
+		// 只保留分数最高的MaxCount篇
+		if _, err := svcCtx.BizRedis.ZremrangebyrankCtx(ctx, key, 0, int64(-svcCtx.Config.HotRank.MaxCount-1)); err != nil {
+			logx.WithContext(ctx).Errorf("[HotRank] ZremrangebyrankCtx key: %s error: %v", key, err)
+		}
+	}
+}
+
+// hotScore Hacker News热度：互动数加权求和后，除以(发布时长+2)的HotGravity次方，发布时长按小时计算
+func hotScore(a *model.Article, ref time.Time) float64 {
+	points := types.HotLikeWeight*float64(a.LikeNum) +
+		types.HotCommentWeight*float64(a.CommentNum) +
+		types.HotCollectWeight*float64(a.CollectNum) +
+		types.HotShareWeight*float64(a.ShareNum) +
+		types.HotViewWeight*float64(a.ViewNum)
+	hours := max(ref.Sub(a.PublishTime).Hours(), 0)
+
+	return points / math.Pow(hours+2, types.HotGravity)
+}
+
+func hotRefTime(ctx context.Context, svcCtx *svc.ServiceContext) time.Time {
+	val, err := svcCtx.BizRedis.GetCtx(ctx, hotRefTimeKey)
+	if err != nil || val == "" {
+		return time.Now()
+	}
+	sec, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return time.Now()
+	}
+	return time.Unix(sec, 0)
+}
+
+func hotArticlesKey(window string) string {
+	return fmt.Sprintf(prefixHotArticles, window)
+}
@@ -3,7 +3,10 @@ package model
 import (
 	"context"
 	"fmt"
+	"time"
+
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"posta/application/article/mq/internal/types"
 )
 
 var _ ArticleModel = (*customArticleModel)(nil)
@@ -15,6 +18,8 @@ type (
 		articleModel
 		withSession(session sqlx.Session) ArticleModel
 		UpdateLikeNum(ctx context.Context, id, likeNum int64) error
+		UpdateCommentNum(ctx context.Context, id, commentNum int64) error
+		ArticlesPublishedAfter(ctx context.Context, since time.Time, limit int) ([]*Article, error)
 	}
 
 	customArticleModel struct {
@@ -38,3 +43,17 @@ func (m *customArticleModel) UpdateLikeNum(ctx context.Context, id, likeNum int6
 	_, err := m.conn.ExecCtx(ctx, query, likeNum, id)
 	return err
 }
+
+func (m *customArticleModel) UpdateCommentNum(ctx context.Context, id, commentNum int64) error {
+	query := fmt.Sprintf("update " + m.table + " set comment_num = ? where `id` = ?")
+	_, err := m.conn.ExecCtx(ctx, query, commentNum, id)
+	return err
+}
+
+// ArticlesPublishedAfter since之后发布的可见文章，用于全量重算热榜
+func (m *customArticleModel) ArticlesPublishedAfter(ctx context.Context, since time.Time, limit int) ([]*Article, error) {
+	query := fmt.Sprintf("select %s from %s where publish_time > ? and status = ? order by publish_time desc limit ?", articleRows, m.table)
+	var articles []*Article
+	err := m.conn.QueryRowsCtx(ctx, &articles, query, since, types.ArticleStatusVisible, limit)
+	return articles, err
+}
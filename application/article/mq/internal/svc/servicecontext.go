@@ -4,8 +4,10 @@ import (
 	"github.com/zeromicro/go-zero/zrpc"
 	"posta/application/article/mq/internal/config"
 	"posta/application/article/mq/internal/model"
+	"posta/application/article/mq/internal/types"
 	"posta/application/user/rpc/user"
 	"posta/pkg/es"
+	"posta/pkg/zsetcache"
 
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -17,6 +19,8 @@ type ServiceContext struct {
 	BizRedis     *redis.Redis
 	UserRPC      user.User
 	Es           *es.Es
+	// ArticlesCache 用户的文章列表缓存，由article-rpc回源写入，这里只更新已经存在的缓存
+	ArticlesCache *zsetcache.Cache
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -39,5 +43,10 @@ func NewServiceContext(c config.Config) *ServiceContext {
 			Username:  c.Es.Username,
 			Password:  c.Es.Password,
 		}),
+		ArticlesCache: zsetcache.New(rds, zsetcache.Conf{
+			Order:   zsetcache.Desc,
+			MaxSize: types.ArticlesCacheMaxSize,
+			Expire:  types.ArticlesCacheExpire,
+		}),
 	}
 }
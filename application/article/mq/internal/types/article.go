@@ -13,6 +13,18 @@ type CanalLikeMsg struct {
 	} `json:"data"`
 }
 
+// CanalReplyCountMsg canal解析reply_count binlog消息.
+type CanalReplyCountMsg struct {
+	Data []struct {
+		ID         string `json:"id"`
+		BizID      string `json:"biz_id"`
+		TargetID   string `json:"target_id"`
+		ReplyNum   string `json:"reply_num"`
+		CreateTime string `json:"create_time"`
+		UpdateTime string `json:"update_time"`
+	} `json:"data"`
+}
+
 type CanalArticleMsg struct {
 	Data []struct {
 		ID          string `json:"id"`
@@ -5,6 +5,9 @@ const (
 	BizReply
 )
 
+// ReplyBizArticle 文章评论在reply_count表中的业务ID，和reply-rpc中的定义保持一致
+const ReplyBizArticle = "article"
+
 const (
 	// ArticleStatusPending 待审核
 	ArticleStatusPending = iota
@@ -15,3 +18,9 @@ const (
 	// ArticleStatusUserDelete 用户删除
 	ArticleStatusUserDelete
 )
+
+// 用户文章列表缓存的配置，和article-rpc中的定义保持一致
+const (
+	ArticlesCacheMaxSize = 200
+	ArticlesCacheExpire  = 3600 * 24 * 2
+)
@@ -0,0 +1,28 @@
+package types
+
+import "time"
+
+// 热榜的时间窗口，只有窗口内发布的文章才会上榜
+const (
+	HotWindowDay  = "day"
+	HotWindowWeek = "week"
+)
+
+var HotWindows = map[string]time.Duration{
+	HotWindowDay:  24 * time.Hour,
+	HotWindowWeek: 7 * 24 * time.Hour,
+}
+
+const (
+	// HotGravity 热度随时间衰减的速度，和Hacker News一样取1.8
+	HotGravity = 1.8
+	// 各种互动在热度中的权重，越难产生的互动权重越高
+	HotLikeWeight    = 1
+	HotCommentWeight = 2
+	HotCollectWeight = 3
+	HotShareWeight   = 4
+	HotViewWeight    = 0.05
+)
+
+// HotScanLimit 全量重算时最多扫描的文章数
+const HotScanLimit = 20000
@@ -21,6 +21,9 @@ type (
 	ArticleItem           = pb.ArticleItem
 	ArticlesRequest       = pb.ArticlesRequest
 	ArticlesResponse      = pb.ArticlesResponse
+	HotArticlesRequest    = pb.HotArticlesRequest
+	HotArticlesResponse   = pb.HotArticlesResponse
+	MentionSpan           = pb.MentionSpan
 	PublishRequest        = pb.PublishRequest
 	PublishResponse       = pb.PublishResponse
 
@@ -29,6 +32,7 @@ type (
 		Articles(ctx context.Context, in *ArticlesRequest, opts ...grpc.CallOption) (*ArticlesResponse, error)
 		ArticleDelete(ctx context.Context, in *ArticleDeleteRequest, opts ...grpc.CallOption) (*ArticleDeleteResponse, error)
 		ArticleDetail(ctx context.Context, in *ArticleDetailRequest, opts ...grpc.CallOption) (*ArticleDetailResponse, error)
+		HotArticles(ctx context.Context, in *HotArticlesRequest, opts ...grpc.CallOption) (*HotArticlesResponse, error)
 	}
 
 	defaultArticle struct {
@@ -61,3 +65,8 @@ func (m *defaultArticle) ArticleDetail(ctx context.Context, in *ArticleDetailReq
 	client := pb.NewArticleClient(m.cli.Conn())
 	return client.ArticleDetail(ctx, in, opts...)
 }
+
+func (m *defaultArticle) HotArticles(ctx context.Context, in *HotArticlesRequest, opts ...grpc.CallOption) (*HotArticlesResponse, error) {
+	client := pb.NewArticleClient(m.cli.Conn())
+	return client.HotArticles(ctx, in, opts...)
+}
@@ -10,4 +10,6 @@ var (
 	ArticleTitleCantEmpty   = xcode.New(60003, "文章标题不能为空") // 文章标题不能为空
 	ArticleContentCantEmpty = xcode.New(60004, "文章内容不能为空") // 文章内容不能为空
 	ArticleIdInvalid        = xcode.New(60005, "文章ID无效")   // 文章ID无效
+	HotWindowInvalid        = xcode.New(60006, "热榜时间窗口无效") // 热榜时间窗口无效
+	PageTokenInvalid        = xcode.New(60007, "分页游标无效")   // 分页游标无效
 )
@@ -13,4 +13,7 @@ type Config struct {
 	CacheRedis cache.CacheConf
 	BizRedis   redis.RedisConf
 	Consul     consul.Conf
+	UserRPC    zrpc.RpcClientConf
+	// CursorSecret 分页游标的签名密钥
+	CursorSecret string
 }
@@ -49,13 +49,13 @@ func (l *ArticleDeleteLogic) ArticleDelete(in *pb.ArticleDeleteRequest) (*pb.Art
 		return nil, err
 	}
 	// 注意：删除文章要保证数据库和缓存的一致性。在上面操作完数据库之后，这里删除缓存中的数据。
-	_, err = l.svcCtx.BizRedis.ZremCtx(l.ctx, articlesKey(in.UserId, types.SortPublishTime), in.ArticleId)
+	err = l.svcCtx.ArticlesCache.Remove(l.ctx, articlesKey(in.UserId, types.SortPublishTime), in.ArticleId)
 	if err != nil {
-		l.Logger.Errorf("ZremCtx req: %v error: %v", in, err)
+		l.Logger.Errorf("ArticlesCache.Remove req: %v error: %v", in, err)
 	}
-	_, err = l.svcCtx.BizRedis.ZremCtx(l.ctx, articlesKey(in.UserId, types.SortLikeCount), in.ArticleId)
+	err = l.svcCtx.ArticlesCache.Remove(l.ctx, articlesKey(in.UserId, types.SortLikeCount), in.ArticleId)
 	if err != nil {
-		l.Logger.Errorf("ZremCtx req: %v error: %v", in, err)
+		l.Logger.Errorf("ArticlesCache.Remove req: %v error: %v", in, err)
 	}
 
 	return &pb.ArticleDeleteResponse{}, nil
@@ -35,16 +35,19 @@ func (l *ArticleDetailLogic) ArticleDetail(in *pb.ArticleDetailRequest) (*pb.Art
 		return nil, err
 	}
 
+	item := &pb.ArticleItem{
+		Id:          article.Id,
+		Title:       article.Title,
+		Content:     article.Content,
+		Description: article.Description,
+		Cover:       article.Cover,
+		AuthorId:    article.AuthorId,
+		LikeCount:   article.LikeNum,
+		PublishTime: article.PublishTime.Unix(),
+	}
+	fillMentions(l.ctx, l.svcCtx, []*pb.ArticleItem{item})
+
 	return &pb.ArticleDetailResponse{
-		Article: &pb.ArticleItem{
-			Id:          article.Id,
-			Title:       article.Title,
-			Content:     article.Content,
-			Description: article.Description,
-			Cover:       article.Cover,
-			AuthorId:    article.AuthorId,
-			LikeCount:   article.LikeNum,
-			PublishTime: article.PublishTime.Unix(),
-		},
+		Article: item,
 	}, nil
 }
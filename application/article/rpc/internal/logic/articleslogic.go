@@ -1,7 +1,6 @@
 package logic
 
 import (
-	"cmp"
 	"context"
 	"fmt"
 	"github.com/zeromicro/go-zero/core/mr"
@@ -9,8 +8,11 @@ import (
 	"posta/application/article/rpc/internal/code"
 	"posta/application/article/rpc/internal/model"
 	"posta/application/article/rpc/internal/types"
-	"slices"
-	"strconv"
+	"posta/application/user/rpc/user"
+	"posta/pkg/cursor"
+	"posta/pkg/mention"
+	"posta/pkg/zsetcache"
+	"strings"
 	"time"
 
 	"posta/application/article/rpc/internal/svc"
@@ -19,10 +21,8 @@ import (
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
-const (
-	prefixArticles = "biz#articles#%d#%d"
-	articlesExpire = 3600 * 24 * 2
-)
+// 缓存改用zsetcache的格式后换了key，旧格式的缓存不再读取
+const prefixArticles = "biz#articles#v2#%d#%d"
 
 type ArticlesLogic struct {
 	ctx    context.Context
@@ -45,160 +45,164 @@ func (l *ArticlesLogic) Articles(in *pb.ArticlesRequest) (*pb.ArticlesResponse,
 	if in.UserId <= 0 {
 		return nil, code.UserIdInvalid
 	}
-	if in.PageSize == 0 {
+	if in.PageSize <= 0 {
 		in.PageSize = types.DefaultPageSize
 	}
-	if in.Cursor == 0 {
-		if in.SortType == types.SortPublishTime {
-			in.Cursor = time.Now().Unix()
-		} else {
-			in.Cursor = types.DefaultSortLikeCursor
-		}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+	scope := articlesCursorScope(in.UserId, in.SortType)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
 	}
-
-	var (
-		sortField       string
-		sortLikeNum     int64
-		sortPublishTime string
-	)
-	if in.SortType == types.SortLikeCount {
-		sortField = "like_num"
-		sortLikeNum = in.Cursor
-	} else {
-		sortField = "publish_time"
-		sortPublishTime = time.Unix(in.Cursor, 0).Format("2006-01-02 15:04:05")
+	// Sort是上一页最后一篇文章的发布时间或点赞数，Id是这篇文章的id，第一页时为空
+	var after *zsetcache.Item
+	if pageCursor.Id > 0 {
+		after = &zsetcache.Item{Id: pageCursor.Id, Score: pageCursor.Sort}
 	}
 
 	var (
-		err            error
-		isCache, isEnd bool
-		lastId, cursor int64
-		curPage        []*pb.ArticleItem
-		articles       []*model.Article
+		isEnd    bool
+		articles []*model.Article
+		// 下一页从这一页最后一篇之后开始。缓存命中时用缓存中的score，和数据库中最新的点赞数可能不一样
+		last *zsetcache.Item
 	)
-	articleIds, _ := l.cacheArticles(l.ctx, in.UserId, in.Cursor, in.PageSize, in.SortType)
-	if len(articleIds) > 0 {
-		isCache = true
-		if articleIds[len(articleIds)-1] == -1 {
-			isEnd = true
+	page, err := l.svcCtx.ArticlesCache.Read(l.ctx, articlesKey(in.UserId, in.SortType), after, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("ArticlesCache.Read userId: %d sortType: %d error: %v", in.UserId, in.SortType, err)
+	}
+	if page != nil {
+		articleIds := make([]int64, 0, len(page.Items))
+		for _, item := range page.Items {
+			articleIds = append(articleIds, item.Id)
 		}
-		// 返回的articles是无序的
 		articles, err = l.articleByIds(l.ctx, articleIds)
 		if err != nil {
 			return nil, err
 		}
-
-		// 注意：这里似乎是MapReduce的一个bug，在后续的fix commit中被修复
-		// 通过sortFiled对articles进行排序
-		var cmpFunc func(a, b *model.Article) int
-		if sortField == "like_num" {
-			cmpFunc = func(a, b *model.Article) int {
-				return cmp.Compare(b.LikeNum, a.LikeNum)
-			}
-		} else {
-			cmpFunc = func(a, b *model.Article) int {
-				return cmp.Compare(b.PublishTime.Unix(), a.PublishTime.Unix())
-			}
-		}
-		slices.SortFunc(articles, cmpFunc)
-
-		for _, article := range articles {
-			curPage = append(curPage, &pb.ArticleItem{
-				Id:           article.Id,
-				Title:        article.Title,
-				Content:      article.Content,
-				LikeCount:    article.LikeNum,
-				CommentCount: article.CommentNum,
-				PublishTime:  article.PublishTime.Unix(),
-			})
+		isEnd = page.IsEnd
+		if len(page.Items) > 0 {
+			last = &page.Items[len(page.Items)-1]
 		}
 	} else {
-		// 注意：防止缓存击穿，保证同一时刻只有一个请求访问数据库。
-		v, err, _ := l.svcCtx.SingleFlightGroup.Do(fmt.Sprintf("ArticlesByUserId:%d:%d", in.UserId, in.SortType), func() (interface{}, error) {
-			return l.svcCtx.ArticleModel.ArticlesByUserId(l.ctx, in.UserId, sortLikeNum, sortPublishTime, sortField, types.DefaultLimit)
-		})
+		articles, isEnd, err = l.articlesFromDB(in, after)
 		if err != nil {
-			logx.Errorf("ArticlesByUserId userId: %d sortField: %s error: %v", in.UserId, sortField, err)
 			return nil, err
 		}
-		if v == nil {
-			return &pb.ArticlesResponse{}, nil
-		}
-		articles = v.([]*model.Article)
-		var firstPageArticles []*model.Article
-		if len(articles) > int(in.PageSize) {
-			firstPageArticles = articles[:int(in.PageSize)]
-		} else {
-			firstPageArticles = articles
-			isEnd = true
-		}
-		for _, article := range firstPageArticles {
-			curPage = append(curPage, &pb.ArticleItem{
-				Id:           article.Id,
-				Title:        article.Title,
-				Content:      article.Content,
-				LikeCount:    article.LikeNum,
-				CommentCount: article.CommentNum,
-				PublishTime:  article.PublishTime.Unix(),
-			})
+		if len(articles) > 0 {
+			article := articles[len(articles)-1]
+			last = &zsetcache.Item{Id: article.Id, Score: articleScore(article, in.SortType)}
 		}
 	}
 
-	if len(curPage) > 0 {
-		pageLast := curPage[len(curPage)-1]
-		lastId = pageLast.Id
+	curPage := make([]*pb.ArticleItem, 0, len(articles))
+	for _, article := range articles {
+		curPage = append(curPage, &pb.ArticleItem{
+			Id:           article.Id,
+			Title:        article.Title,
+			Content:      article.Content,
+			LikeCount:    article.LikeNum,
+			CommentCount: article.CommentNum,
+			PublishTime:  article.PublishTime.Unix(),
+		})
+	}
+	fillMentions(l.ctx, l.svcCtx, curPage)
+
+	ret := &pb.ArticlesResponse{
+		IsEnd:    isEnd,
+		Articles: curPage,
+	}
+	if last != nil {
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: last.Score, Id: last.Id})
+	}
+
+	return ret, nil
+}
+
+// firstPageArticles 回源查询的第一页和查询前的缓存版本号
+type firstPageArticles struct {
+	articles []*model.Article
+	version  int64
+	canFill  bool
+}
+
+// articlesFromDB 缓存没有命中时查数据库。第一页多查DefaultLimit篇用于写缓存，之后的页只查一页，
+// 不能用中间的一段写缓存，否则缓存中的列表前面会缺一段
+func (l *ArticlesLogic) articlesFromDB(in *pb.ArticlesRequest, after *zsetcache.Item) ([]*model.Article, bool, error) {
+	sortField := "publish_time"
+	if in.SortType == types.SortLikeCount {
+		sortField = "like_num"
+	}
+
+	if after != nil {
+		var sortValue any = after.Score
 		if in.SortType == types.SortPublishTime {
-			cursor = pageLast.PublishTime
-		} else {
-			cursor = pageLast.LikeCount
+			sortValue = time.Unix(after.Score, 0).Format("2006-01-02 15:04:05")
 		}
-		if cursor < 0 {
-			cursor = 0
+		// 多查一篇用于判断是否还有下一页
+		articles, err := l.svcCtx.ArticleModel.ArticlesByUserId(l.ctx, in.UserId, sortField, sortValue, after.Id, int(in.PageSize)+1)
+		if err != nil {
+			l.Logger.Errorf("ArticlesByUserId userId: %d sortField: %s error: %v", in.UserId, sortField, err)
+			return nil, false, err
 		}
-		for k, article := range curPage {
-			if in.SortType == types.SortPublishTime {
-				if article.PublishTime == in.Cursor && article.Id == in.ArticleId {
-					curPage = curPage[k:]
-					break
-				}
-			} else {
-				if article.LikeCount == in.Cursor && article.Id == in.ArticleId {
-					curPage = curPage[k:]
-					break
-				}
-			}
+		if len(articles) > int(in.PageSize) {
+			return articles[:in.PageSize], false, nil
 		}
+		return articles, true, nil
 	}
 
-	ret := &pb.ArticlesResponse{
-		IsEnd:     isEnd,
-		Cursor:    cursor,
-		ArticleId: lastId,
-		Articles:  curPage,
+	// 注意：防止缓存击穿，保证同一时刻只有一个请求访问数据库。
+	// 合并的请求共用同一次查询的结果，版本号也要在这次查询之前取
+	key := articlesKey(in.UserId, in.SortType)
+	v, err, _ := l.svcCtx.SingleFlightGroup.Do(fmt.Sprintf("ArticlesByUserId:%d:%d", in.UserId, in.SortType), func() (interface{}, error) {
+		version, verErr := l.svcCtx.ArticlesCache.Version(l.ctx, key)
+		if verErr != nil {
+			l.Logger.Errorf("ArticlesCache.Version key: %s error: %v", key, verErr)
+		}
+		articles, err := l.svcCtx.ArticleModel.ArticlesByUserId(l.ctx, in.UserId, sortField, nil, 0, types.DefaultLimit)
+		if err != nil {
+			return nil, err
+		}
+		return &firstPageArticles{articles: articles, version: version, canFill: verErr == nil}, nil
+	})
+	if err != nil {
+		l.Logger.Errorf("ArticlesByUserId userId: %d sortField: %s error: %v", in.UserId, sortField, err)
+		return nil, false, err
 	}
+	firstPage := v.(*firstPageArticles)
+	articles := firstPage.articles
 
-	if !isCache {
+	if firstPage.canFill {
+		items := make([]zsetcache.Item, 0, len(articles))
+		for _, article := range articles {
+			items = append(items, zsetcache.Item{Id: article.Id, Score: articleScore(article, in.SortType)})
+		}
 		threading.GoSafe(func() {
-			if len(articles) < types.DefaultLimit && len(articles) > 0 {
-				articles = append(articles, &model.Article{Id: -1})
-			}
-			err = l.addCacheArticles(context.Background(), articles, in.UserId, in.SortType)
+			// 不到DefaultLimit篇说明已经查到了全部文章
+			err := l.svcCtx.ArticlesCache.Fill(context.Background(), key, firstPage.version, items, len(articles) < types.DefaultLimit)
 			if err != nil {
-				logx.Errorf("addCacheArticles error: %v", err)
+				logx.Errorf("ArticlesCache.Fill userId: %d sortType: %d error: %v", in.UserId, in.SortType, err)
 			}
 		})
 	}
 
-	return ret, nil
+	if len(articles) > int(in.PageSize) {
+		return articles[:in.PageSize], false, nil
+	}
+	return articles, true, nil
 }
 
-// 接收一个 articleIds 的整数 ID 列表，然后并发查询每一个文章详情，最后组合成列表返回。
+// articleScore 文章在按sortType排序的列表中的score
+func articleScore(article *model.Article, sortType int32) int64 {
+	if sortType == types.SortLikeCount {
+		return article.LikeNum
+	}
+	return article.PublishTime.Unix()
+}
+
+// 接收一个 articleIds 的整数 ID 列表，然后并发查询每一个文章详情，最后按articleIds的顺序组合成列表返回。
 func (l *ArticlesLogic) articleByIds(ctx context.Context, articleIds []int64) ([]*model.Article, error) {
-	articles, err := mr.MapReduce[int64, *model.Article, []*model.Article](func(source chan<- int64) {
+	articles, err := mr.MapReduce[int64, *model.Article, map[int64]*model.Article](func(source chan<- int64) {
 		for _, aid := range articleIds {
-			if aid == -1 {
-				continue
-			}
 			source <- aid
 		}
 	}, func(id int64, writer mr.Writer[*model.Article], cancel func(error)) {
@@ -211,10 +215,10 @@ func (l *ArticlesLogic) articleByIds(ctx context.Context, articleIds []int64) ([
 			return
 		}
 		writer.Write(p)
-	}, func(pipe <-chan *model.Article, writer mr.Writer[[]*model.Article], cancel func(error)) {
-		var articles []*model.Article
+	}, func(pipe <-chan *model.Article, writer mr.Writer[map[int64]*model.Article], cancel func(error)) {
+		articles := make(map[int64]*model.Article, len(articleIds))
 		for article := range pipe {
-			articles = append(articles, article)
+			articles[article.Id] = article
 		}
 		writer.Write(articles)
 	})
@@ -222,66 +226,60 @@ func (l *ArticlesLogic) articleByIds(ctx context.Context, articleIds []int64) ([
 		return nil, err
 	}
 
-	return articles, nil
-}
-
-func articlesKey(uid int64, sortType int32) string {
-	return fmt.Sprintf(prefixArticles, uid, sortType)
+	ret := make([]*model.Article, 0, len(articles))
+	for _, aid := range articleIds {
+		if article, ok := articles[aid]; ok {
+			ret = append(ret, article)
+		}
+	}
+	return ret, nil
 }
 
-// 从 Redis 缓存中，获取某个用户的文章 ID 列表（按某种排序方式），用于分页展示。
-func (l *ArticlesLogic) cacheArticles(ctx context.Context, uid, cursor, ps int64, sortType int32) ([]int64, error) {
-	key := articlesKey(uid, sortType)
-	b, err := l.svcCtx.BizRedis.ExistsCtx(ctx, key)
-	if err != nil {
-		logx.Errorf("ExistsCtx key: %s error: %v", key, err)
-	}
-	// 注意：更新缓存缓存过期时间，防止缓存击穿。
-	if b {
-		err = l.svcCtx.BizRedis.ExpireCtx(ctx, key, articlesExpire)
-		if err != nil {
-			logx.Errorf("ExpireCtx key: %s error: %v", key, err)
+// fillMentions 通过user-rpc批量查询文章中@到的用户，并生成渲染用的@片段
+func fillMentions(ctx context.Context, svcCtx *svc.ServiceContext, items []*pb.ArticleItem) {
+	var articleIds []int64
+	for _, item := range items {
+		// 内容中没有@的文章不用查
+		if strings.Contains(item.Content, "@") {
+			articleIds = append(articleIds, item.Id)
 		}
 	}
-	pairs, err := l.svcCtx.BizRedis.ZrevrangebyscoreWithScoresAndLimitCtx(ctx, key, 0, cursor, 0, int(ps))
+	if len(articleIds) == 0 {
+		return
+	}
+	resp, err := svcCtx.UserRPC.MentionsByObjs(ctx, &user.MentionsByObjsRequest{
+		BizId:  mention.BizArticle,
+		ObjIds: articleIds,
+	})
 	if err != nil {
-		logx.Errorf("ZrevrangebyscoreWithScoresAndLimit key: %s error: %v", key, err)
-		return nil, err
+		// @片段查询失败不影响文章返回，前端按纯文本展示
+		logx.WithContext(ctx).Errorf("UserRPC.MentionsByObjs articleIds: %v error: %v", articleIds, err)
+		return
 	}
-	var ids []int64
-	for _, pair := range pairs {
-		id, err := strconv.ParseInt(pair.Key, 10, 64)
-		if err != nil {
-			logx.Errorf("strconv.ParseInt key: %s error: %v", pair.Key, err)
-			return nil, err
+	userIds := make(map[int64]map[string]int64)
+	for _, m := range resp.Mentions {
+		if userIds[m.ObjId] == nil {
+			userIds[m.ObjId] = make(map[string]int64)
 		}
-		ids = append(ids, id)
-	}
-
-	return ids, nil
-}
-
-// 将一批文章的信息（ID + 排序依据）加入到 Redis 的有序集合（ZSet）中用于缓存，并设置过期时间。
-func (l *ArticlesLogic) addCacheArticles(ctx context.Context, articles []*model.Article, userId int64, sortType int32) error {
-	if len(articles) == 0 {
-		return nil
+		userIds[m.ObjId][m.Username] = m.UserId
 	}
-	key := articlesKey(userId, sortType)
-	for _, article := range articles {
-		var score int64
-		if sortType == types.SortLikeCount {
-			score = article.LikeNum
-		} else if sortType == types.SortPublishTime && article.Id != -1 {
-			score = article.PublishTime.Local().Unix()
-		}
-		if score < 0 {
-			score = 0
-		}
-		_, err := l.svcCtx.BizRedis.ZaddCtx(ctx, key, score, strconv.Itoa(int(article.Id)))
-		if err != nil {
-			return err
+	for _, item := range items {
+		for _, span := range mention.BuildSpans(item.Content, userIds[item.Id]) {
+			item.Mentions = append(item.Mentions, &pb.MentionSpan{
+				UserId:   span.UserId,
+				Username: span.Username,
+				Offset:   int32(span.Offset),
+				Length:   int32(span.Length),
+			})
 		}
 	}
+}
+
+// articlesCursorScope 分页游标绑定作者和排序方式，换了排序方式要从第一页开始
+func articlesCursorScope(uid int64, sortType int32) string {
+	return fmt.Sprintf("articles#%d#%d", uid, sortType)
+}
 
-	return l.svcCtx.BizRedis.ExpireCtx(ctx, key, articlesExpire)
+func articlesKey(uid int64, sortType int32) string {
+	return fmt.Sprintf(prefixArticles, uid, sortType)
 }
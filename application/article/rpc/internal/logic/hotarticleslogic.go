@@ -0,0 +1,174 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"posta/application/article/rpc/internal/code"
+	"posta/application/article/rpc/internal/model"
+	"posta/application/article/rpc/internal/svc"
+	"posta/application/article/rpc/internal/types"
+	"posta/application/article/rpc/pb"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/mr"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 热榜由article-mq的HotRankLogic维护
+const prefixHotArticles = "biz#article#hot#%s"
+
+// 按(score, member)读取游标之后的ARGV[3]条。热榜的分数一直在变，不能按排名的偏移量翻页，
+// 否则排名变化后会重复或者漏掉文章。分数相同（比如没有互动的文章都是0）时zset按member的字典序倒序排列
+const hotPageScript = `
+local need = tonumber(ARGV[3])
+if ARGV[4] == "0" then
+	return redis.call("ZREVRANGE", KEYS[1], 0, need - 1, "WITHSCORES")
+end
+local score, member = ARGV[1], ARGV[2]
+local ret = {}
+for _, m in ipairs(redis.call("ZREVRANGEBYSCORE", KEYS[1], score, score)) do
+	if #ret >= need * 2 then
+		return ret
+	end
+	if m < member then
+		table.insert(ret, m)
+		table.insert(ret, score)
+	end
+end
+local rest = redis.call("ZREVRANGEBYSCORE", KEYS[1], "(" .. score, "-inf", "WITHSCORES", "LIMIT", 0, need - #ret / 2)
+for _, v in ipairs(rest) do
+	table.insert(ret, v)
+end
+return ret`
+
+type HotArticlesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewHotArticlesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *HotArticlesLogic {
+	return &HotArticlesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *HotArticlesLogic) HotArticles(in *pb.HotArticlesRequest) (*pb.HotArticlesResponse, error) {
+	if in.Window == "" {
+		in.Window = types.HotWindowDay
+	}
+	if in.Window != types.HotWindowDay && in.Window != types.HotWindowWeek {
+		return nil, code.HotWindowInvalid
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+	scope := fmt.Sprintf("hotArticles#%s", in.Window)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	// Sort是上一页最后一篇文章热度的float64位表示，Id是这篇文章的id
+	hasCursor, score, member := "0", "0", ""
+	if !pageCursor.IsZero() {
+		hasCursor = "1"
+		score = strconv.FormatFloat(math.Float64frombits(uint64(pageCursor.Sort)), 'g', -1, 64)
+		member = strconv.FormatInt(pageCursor.Id, 10)
+	}
+
+	key := fmt.Sprintf(prefixHotArticles, in.Window)
+	// 多读一篇用于判断是否还有下一页
+	val, err := l.svcCtx.BizRedis.EvalCtx(l.ctx, hotPageScript, []string{key}, score, member, in.PageSize+1, hasCursor)
+	if err != nil {
+		l.Logger.Errorf("[HotArticles] hotPageScript key: %s error: %v", key, err)
+		return nil, err
+	}
+	values, _ := val.([]any)
+	isEnd := int64(len(values)/2) <= in.PageSize
+
+	var (
+		ids  []int64
+		last cursor.Cursor
+	)
+	for i := 0; i+1 < len(values) && int64(i/2) < in.PageSize; i += 2 {
+		rawMember, _ := values[i].(string)
+		rawScore, _ := values[i+1].(string)
+		id, err := strconv.ParseInt(rawMember, 10, 64)
+		if err != nil {
+			continue
+		}
+		hot, err := strconv.ParseFloat(rawScore, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+		last = cursor.Cursor{Sort: int64(math.Float64bits(hot)), Id: id}
+	}
+
+	articles, err := l.articlesByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// 热榜按全量重算的周期刷新，期间被删除或下架的文章在这里过滤掉
+	items := make([]*pb.ArticleItem, 0, len(ids))
+	for _, id := range ids {
+		article, ok := articles[id]
+		if !ok || article.Status != types.ArticleStatusVisible {
+			continue
+		}
+		items = append(items, &pb.ArticleItem{
+			Id:           article.Id,
+			Title:        article.Title,
+			Content:      article.Content,
+			Description:  article.Description,
+			Cover:        article.Cover,
+			CommentCount: article.CommentNum,
+			LikeCount:    article.LikeNum,
+			PublishTime:  article.PublishTime.Unix(),
+			AuthorId:     article.AuthorId,
+		})
+	}
+	fillMentions(l.ctx, l.svcCtx, items)
+
+	ret := &pb.HotArticlesResponse{
+		Articles: items,
+		IsEnd:    isEnd,
+	}
+	if len(ids) > 0 {
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, last)
+	}
+
+	return ret, nil
+}
+
+func (l *HotArticlesLogic) articlesByIds(ids []int64) (map[int64]*model.Article, error) {
+	return mr.MapReduce[int64, *model.Article, map[int64]*model.Article](func(source chan<- int64) {
+		for _, id := range ids {
+			source <- id
+		}
+	}, func(id int64, writer mr.Writer[*model.Article], cancel func(error)) {
+		article, err := l.svcCtx.ArticleModel.FindOne(l.ctx, id)
+		if err != nil {
+			if !errors.Is(err, sqlx.ErrNotFound) {
+				cancel(err)
+			}
+			return
+		}
+		writer.Write(article)
+	}, func(pipe <-chan *model.Article, writer mr.Writer[map[int64]*model.Article], cancel func(error)) {
+		articles := make(map[int64]*model.Article)
+		for article := range pipe {
+			articles[article.Id] = article
+		}
+		writer.Write(articles)
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"posta/application/article/rpc/internal/code"
 	"posta/application/article/rpc/internal/model"
 	"posta/application/article/rpc/internal/types"
-	"strconv"
+	"posta/application/user/rpc/user"
+	"posta/pkg/mention"
+	"posta/pkg/zsetcache"
 	"time"
 
 	"posta/application/article/rpc/internal/svc"
@@ -38,6 +40,7 @@ func (l *PublishLogic) Publish(in *pb.PublishRequest) (*pb.PublishResponse, erro
 	if len(in.Content) == 0 {
 		return nil, code.ArticleContentCantEmpty
 	}
+	publishTime := time.Now()
 	ret, err := l.svcCtx.ArticleModel.Insert(l.ctx, &model.Article{
 		AuthorId:    in.UserId,
 		Title:       in.Title,
@@ -46,7 +49,7 @@ func (l *PublishLogic) Publish(in *pb.PublishRequest) (*pb.PublishResponse, erro
 		// 注意：一般不会这样写，因为需要审核流程。
 		Status:      types.ArticleStatusVisible,
 		Cover:       in.Cover,
-		PublishTime: time.Now(),
+		PublishTime: publishTime,
 		CreateTime:  time.Now(),
 		UpdateTime:  time.Now(),
 	})
@@ -61,27 +64,44 @@ func (l *PublishLogic) Publish(in *pb.PublishRequest) (*pb.PublishResponse, erro
 		return nil, err
 	}
 
-	var (
-		articleIdStr   = strconv.FormatInt(articleId, 10)
-		publishTimeKey = articlesKey(in.UserId, types.SortPublishTime)
-		likeNumKey     = articlesKey(in.UserId, types.SortLikeCount)
-	)
+	// 解析文章中的@，记录后由user-rpc发送通知
+	l.addMentions(articleId, in.UserId, in.Content)
+
 	// 注意：为了保证缓存和数据库的一致性，只有当缓存存在时，才会往缓存中加入数据。
-	// 如果缓存不存在，说明没人调用过articles方法，我们只需要改变数据库就行，如果仍然执行zadd的话那缓存中就只有这个值了。
-	b, _ := l.svcCtx.BizRedis.ExistsCtx(l.ctx, publishTimeKey)
-	if b {
-		_, err = l.svcCtx.BizRedis.ZaddCtx(l.ctx, publishTimeKey, time.Now().Unix(), articleIdStr)
-		if err != nil {
-			logx.Errorf("ZaddCtx req: %v error: %v", in, err)
-		}
+	// 如果缓存不存在，说明没人调用过articles方法，我们只需要改变数据库就行，判断和写入在Lua中原子地执行。
+	err = l.svcCtx.ArticlesCache.Add(l.ctx, articlesKey(in.UserId, types.SortPublishTime), zsetcache.Item{Id: articleId, Score: publishTime.Unix()})
+	if err != nil {
+		logx.Errorf("ArticlesCache.Add req: %v error: %v", in, err)
 	}
-	b, _ = l.svcCtx.BizRedis.ExistsCtx(l.ctx, likeNumKey)
-	if b {
-		_, err = l.svcCtx.BizRedis.ZaddCtx(l.ctx, likeNumKey, 0, articleIdStr)
-		if err != nil {
-			logx.Errorf("ZaddCtx req: %v error: %v", in, err)
-		}
+	err = l.svcCtx.ArticlesCache.Add(l.ctx, articlesKey(in.UserId, types.SortLikeCount), zsetcache.Item{Id: articleId, Score: 0})
+	if err != nil {
+		logx.Errorf("ArticlesCache.Add req: %v error: %v", in, err)
 	}
 
 	return &pb.PublishResponse{ArticleId: articleId}, nil
 }
+
+// addMentions 把文章中的@username通过user-rpc解析成用户ID并记录下来，失败不影响文章发布
+func (l *PublishLogic) addMentions(articleId, userId int64, content string) {
+	usernames := mention.Usernames(content)
+	if len(usernames) == 0 {
+		return
+	}
+	users, err := l.svcCtx.UserRPC.FindByUsernames(l.ctx, &user.FindByUsernamesRequest{Usernames: usernames})
+	if err != nil {
+		l.Logger.Errorf("UserRPC.FindByUsernames usernames: %v error: %v", usernames, err)
+		return
+	}
+	if len(users.Users) == 0 {
+		return
+	}
+	_, err = l.svcCtx.UserRPC.AddMentions(l.ctx, &user.AddMentionsRequest{
+		BizId:      mention.BizArticle,
+		ObjId:      articleId,
+		FromUserId: userId,
+		Users:      users.Users,
+	})
+	if err != nil {
+		l.Logger.Errorf("UserRPC.AddMentions articleId: %d error: %v", articleId, err)
+	}
+}
@@ -15,7 +15,7 @@ type (
 	// and implement the added methods in customArticleModel.
 	ArticleModel interface {
 		articleModel
-		ArticlesByUserId(ctx context.Context, userId, likeNum int64, pubTime, sortField string, limit int) ([]*Article, error)
+		ArticlesByUserId(ctx context.Context, userId int64, sortField string, sortValue any, lastId int64, limit int) ([]*Article, error)
 		UpdateArticleStatus(ctx context.Context, id int64, status int) error
 	}
 
@@ -31,22 +31,20 @@ func NewArticleModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option)
 	}
 }
 
-func (m *customArticleModel) ArticlesByUserId(ctx context.Context, userId, likeNum int64, pubTime, sortField string, limit int) ([]*Article, error) {
+// ArticlesByUserId 按sortField和id倒序查询作者的文章，lastId大于0时从上一页最后一篇(sortValue, lastId)之后开始查
+func (m *customArticleModel) ArticlesByUserId(ctx context.Context, userId int64, sortField string, sortValue any, lastId int64, limit int) ([]*Article, error) {
 	var (
 		err      error
-		sql      string
-		anyField any
 		articles []*Article
 	)
 	// 注意：这里并不会将行记录加入缓存
-	if sortField == "like_num" {
-		anyField = likeNum
-		sql = fmt.Sprintf("select "+articleRows+" from "+m.table+" where author_id=? and like_num < ? and status=2 order by %s desc limit ?", sortField)
+	if lastId > 0 {
+		sql := fmt.Sprintf("select "+articleRows+" from "+m.table+" where author_id=? and status=2 and (%[1]s < ? or (%[1]s = ? and id < ?)) order by %[1]s desc, id desc limit ?", sortField)
+		err = m.QueryRowsNoCacheCtx(ctx, &articles, sql, userId, sortValue, sortValue, lastId, limit)
 	} else {
-		anyField = pubTime
-		sql = fmt.Sprintf("select "+articleRows+" from "+m.table+" where author_id=? and publish_time < ? and status=2 order by %s desc limit ?", sortField)
+		sql := fmt.Sprintf("select "+articleRows+" from "+m.table+" where author_id=? and status=2 order by %s desc, id desc limit ?", sortField)
+		err = m.QueryRowsNoCacheCtx(ctx, &articles, sql, userId, limit)
 	}
-	err = m.QueryRowsNoCacheCtx(ctx, &articles, sql, userId, anyField, limit)
 	if err != nil {
 		return nil, err
 	}
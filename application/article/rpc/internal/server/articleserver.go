@@ -42,3 +42,8 @@ func (s *ArticleServer) ArticleDetail(ctx context.Context, in *pb.ArticleDetailR
 	l := logic.NewArticleDetailLogic(ctx, s.svcCtx)
 	return l.ArticleDetail(in)
 }
+
+func (s *ArticleServer) HotArticles(ctx context.Context, in *pb.HotArticlesRequest) (*pb.HotArticlesResponse, error) {
+	l := logic.NewHotArticlesLogic(ctx, s.svcCtx)
+	return l.HotArticles(in)
+}
@@ -3,9 +3,14 @@ package svc
 import (
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
 	"golang.org/x/sync/singleflight"
 	"posta/application/article/rpc/internal/config"
 	"posta/application/article/rpc/internal/model"
+	"posta/application/article/rpc/internal/types"
+	"posta/application/user/rpc/user"
+	"posta/pkg/cursor"
+	"posta/pkg/zsetcache"
 )
 
 type ServiceContext struct {
@@ -13,6 +18,10 @@ type ServiceContext struct {
 	ArticleModel      model.ArticleModel
 	BizRedis          *redis.Redis
 	SingleFlightGroup singleflight.Group
+	UserRPC           user.User
+	CursorCodec       *cursor.Codec
+	// ArticlesCache 用户的文章列表缓存，按发布时间和点赞数各一份
+	ArticlesCache *zsetcache.Cache
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -27,5 +36,12 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		Config:       c,
 		ArticleModel: model.NewArticleModel(sqlx.NewMysql(c.DataSource), c.CacheRedis),
 		BizRedis:     rds,
+		UserRPC:      user.NewUser(zrpc.MustNewClient(c.UserRPC)),
+		CursorCodec:  cursor.NewCodec(c.CursorSecret),
+		ArticlesCache: zsetcache.New(rds, zsetcache.Conf{
+			Order:   zsetcache.Desc,
+			MaxSize: types.DefaultLimit,
+			Expire:  types.ArticlesCacheExpire,
+		}),
 	}
 }
@@ -7,9 +7,12 @@ const (
 
 const (
 	DefaultPageSize = 20
-	DefaultLimit    = 200
+	// MaxPageSize 每页最多的条数，第一页回源时多查的条数要比它大，否则判断不了是否到底
+	MaxPageSize  = 50
+	DefaultLimit = 200
 
-	DefaultSortLikeCursor = 1 << 30
+	// ArticlesCacheExpire 用户文章列表缓存的过期时间，单位秒
+	ArticlesCacheExpire = 3600 * 24 * 2
 )
 
 const (
@@ -22,3 +25,9 @@ const (
 	// ArticleStatusUserDelete 用户删除
 	ArticleStatusUserDelete
 )
+
+// 热榜的时间窗口，和article-mq中的定义保持一致
+const (
+	HotWindowDay  = "day"
+	HotWindowWeek = "week"
+)
@@ -144,10 +144,9 @@ func (x *PublishResponse) GetArticleId() int64 {
 type ArticlesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Cursor        int64                  `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	PageSize      int64                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
 	SortType      int32                  `protobuf:"varint,4,opt,name=sortType,proto3" json:"sortType,omitempty"`
-	ArticleId     int64                  `protobuf:"varint,5,opt,name=articleId,proto3" json:"articleId,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -189,13 +188,6 @@ func (x *ArticlesRequest) GetUserId() int64 {
 	return 0
 }
 
-func (x *ArticlesRequest) GetCursor() int64 {
-	if x != nil {
-		return x.Cursor
-	}
-	return 0
-}
-
 func (x *ArticlesRequest) GetPageSize() int64 {
 	if x != nil {
 		return x.PageSize
@@ -210,11 +202,11 @@ func (x *ArticlesRequest) GetSortType() int32 {
 	return 0
 }
 
-func (x *ArticlesRequest) GetArticleId() int64 {
+func (x *ArticlesRequest) GetPageToken() string {
 	if x != nil {
-		return x.ArticleId
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
 type ArticleItem struct {
@@ -228,6 +220,7 @@ type ArticleItem struct {
 	LikeCount     int64                  `protobuf:"varint,7,opt,name=likeCount,proto3" json:"likeCount,omitempty"`
 	PublishTime   int64                  `protobuf:"varint,8,opt,name=publishTime,proto3" json:"publishTime,omitempty"`
 	AuthorId      int64                  `protobuf:"varint,9,opt,name=authorId,proto3" json:"authorId,omitempty"`
+	Mentions      []*MentionSpan         `protobuf:"bytes,10,rep,name=mentions,proto3" json:"mentions,omitempty"` // 内容中的@片段
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -325,19 +318,94 @@ func (x *ArticleItem) GetAuthorId() int64 {
 	return 0
 }
 
+func (x *ArticleItem) GetMentions() []*MentionSpan {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+// MentionSpan 内容中的一个@片段，offset和length按字符计算
+type MentionSpan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length        int32                  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MentionSpan) Reset() {
+	*x = MentionSpan{}
+	mi := &file_article_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MentionSpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MentionSpan) ProtoMessage() {}
+
+func (x *MentionSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MentionSpan.ProtoReflect.Descriptor instead.
+func (*MentionSpan) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MentionSpan) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MentionSpan) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MentionSpan) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *MentionSpan) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
 type ArticlesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Articles      []*ArticleItem         `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
 	IsEnd         bool                   `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
-	Cursor        int64                  `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	ArticleId     int64                  `protobuf:"varint,4,opt,name=articleId,proto3" json:"articleId,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ArticlesResponse) Reset() {
 	*x = ArticlesResponse{}
-	mi := &file_article_proto_msgTypes[4]
+	mi := &file_article_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -349,7 +417,7 @@ func (x *ArticlesResponse) String() string {
 func (*ArticlesResponse) ProtoMessage() {}
 
 func (x *ArticlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_proto_msgTypes[4]
+	mi := &file_article_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +430,7 @@ func (x *ArticlesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArticlesResponse.ProtoReflect.Descriptor instead.
 func (*ArticlesResponse) Descriptor() ([]byte, []int) {
-	return file_article_proto_rawDescGZIP(), []int{4}
+	return file_article_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ArticlesResponse) GetArticles() []*ArticleItem {
@@ -379,18 +447,11 @@ func (x *ArticlesResponse) GetIsEnd() bool {
 	return false
 }
 
-func (x *ArticlesResponse) GetCursor() int64 {
-	if x != nil {
-		return x.Cursor
-	}
-	return 0
-}
-
-func (x *ArticlesResponse) GetArticleId() int64 {
+func (x *ArticlesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.ArticleId
+		return x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
 type ArticleDeleteRequest struct {
@@ -403,7 +464,7 @@ type ArticleDeleteRequest struct {
 
 func (x *ArticleDeleteRequest) Reset() {
 	*x = ArticleDeleteRequest{}
-	mi := &file_article_proto_msgTypes[5]
+	mi := &file_article_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -415,7 +476,7 @@ func (x *ArticleDeleteRequest) String() string {
 func (*ArticleDeleteRequest) ProtoMessage() {}
 
 func (x *ArticleDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_proto_msgTypes[5]
+	mi := &file_article_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,7 +489,7 @@ func (x *ArticleDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArticleDeleteRequest.ProtoReflect.Descriptor instead.
 func (*ArticleDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_article_proto_rawDescGZIP(), []int{5}
+	return file_article_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ArticleDeleteRequest) GetUserId() int64 {
@@ -453,7 +514,7 @@ type ArticleDeleteResponse struct {
 
 func (x *ArticleDeleteResponse) Reset() {
 	*x = ArticleDeleteResponse{}
-	mi := &file_article_proto_msgTypes[6]
+	mi := &file_article_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -465,7 +526,7 @@ func (x *ArticleDeleteResponse) String() string {
 func (*ArticleDeleteResponse) ProtoMessage() {}
 
 func (x *ArticleDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_proto_msgTypes[6]
+	mi := &file_article_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +539,7 @@ func (x *ArticleDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArticleDeleteResponse.ProtoReflect.Descriptor instead.
 func (*ArticleDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_article_proto_rawDescGZIP(), []int{6}
+	return file_article_proto_rawDescGZIP(), []int{7}
 }
 
 type ArticleDetailRequest struct {
@@ -490,7 +551,7 @@ type ArticleDetailRequest struct {
 
 func (x *ArticleDetailRequest) Reset() {
 	*x = ArticleDetailRequest{}
-	mi := &file_article_proto_msgTypes[7]
+	mi := &file_article_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +563,7 @@ func (x *ArticleDetailRequest) String() string {
 func (*ArticleDetailRequest) ProtoMessage() {}
 
 func (x *ArticleDetailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_proto_msgTypes[7]
+	mi := &file_article_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +576,7 @@ func (x *ArticleDetailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArticleDetailRequest.ProtoReflect.Descriptor instead.
 func (*ArticleDetailRequest) Descriptor() ([]byte, []int) {
-	return file_article_proto_rawDescGZIP(), []int{7}
+	return file_article_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ArticleDetailRequest) GetArticleId() int64 {
@@ -534,7 +595,7 @@ type ArticleDetailResponse struct {
 
 func (x *ArticleDetailResponse) Reset() {
 	*x = ArticleDetailResponse{}
-	mi := &file_article_proto_msgTypes[8]
+	mi := &file_article_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -546,7 +607,7 @@ func (x *ArticleDetailResponse) String() string {
 func (*ArticleDetailResponse) ProtoMessage() {}
 
 func (x *ArticleDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_proto_msgTypes[8]
+	mi := &file_article_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +620,7 @@ func (x *ArticleDetailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArticleDetailResponse.ProtoReflect.Descriptor instead.
 func (*ArticleDetailResponse) Descriptor() ([]byte, []int) {
-	return file_article_proto_rawDescGZIP(), []int{8}
+	return file_article_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ArticleDetailResponse) GetArticle() *ArticleItem {
@@ -569,6 +630,126 @@ func (x *ArticleDetailResponse) GetArticle() *ArticleItem {
 	return nil
 }
 
+type HotArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        string                 `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"` // 时间窗口：day、week
+	PageSize      int64                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotArticlesRequest) Reset() {
+	*x = HotArticlesRequest{}
+	mi := &file_article_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotArticlesRequest) ProtoMessage() {}
+
+func (x *HotArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotArticlesRequest.ProtoReflect.Descriptor instead.
+func (*HotArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HotArticlesRequest) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *HotArticlesRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *HotArticlesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type HotArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*ArticleItem         `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	IsEnd         bool                   `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotArticlesResponse) Reset() {
+	*x = HotArticlesResponse{}
+	mi := &file_article_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotArticlesResponse) ProtoMessage() {}
+
+func (x *HotArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotArticlesResponse.ProtoReflect.Descriptor instead.
+func (*HotArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HotArticlesResponse) GetArticles() []*ArticleItem {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *HotArticlesResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *HotArticlesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_article_proto protoreflect.FileDescriptor
 
 const file_article_proto_rawDesc = "" +
@@ -581,13 +762,12 @@ const file_article_proto_rawDesc = "" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05cover\x18\x05 \x01(\tR\x05cover\"/\n" +
 	"\x0fPublishResponse\x12\x1c\n" +
-	"\tarticleId\x18\x01 \x01(\x03R\tarticleId\"\x97\x01\n" +
+	"\tarticleId\x18\x01 \x01(\x03R\tarticleId\"\x9e\x01\n" +
 	"\x0fArticlesRequest\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
-	"\x06cursor\x18\x02 \x01(\x03R\x06cursor\x12\x1a\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12\x1a\n" +
 	"\bsortType\x18\x04 \x01(\x05R\bsortType\x12\x1c\n" +
-	"\tarticleId\x18\x05 \x01(\x03R\tarticleId\"\x85\x02\n" +
+	"\tpageToken\x18\x06 \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03J\x04\b\x05\x10\x06R\x06cursorR\tarticleId\"\xb2\x02\n" +
 	"\vArticleItem\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -597,12 +777,18 @@ const file_article_proto_rawDesc = "" +
 	"\fcommentCount\x18\x06 \x01(\x03R\fcommentCount\x12\x1c\n" +
 	"\tlikeCount\x18\a \x01(\x03R\tlikeCount\x12 \n" +
 	"\vpublishTime\x18\b \x01(\x03R\vpublishTime\x12\x1a\n" +
-	"\bauthorId\x18\t \x01(\x03R\bauthorId\"\x8b\x01\n" +
+	"\bauthorId\x18\t \x01(\x03R\bauthorId\x12+\n" +
+	"\bmentions\x18\n" +
+	" \x03(\v2\x0f.pb.MentionSpanR\bmentions\"q\n" +
+	"\vMentionSpan\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06length\x18\x04 \x01(\x05R\x06length\"\x9a\x01\n" +
 	"\x10ArticlesResponse\x12+\n" +
 	"\barticles\x18\x01 \x03(\v2\x0f.pb.ArticleItemR\barticles\x12\x14\n" +
-	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12\x16\n" +
-	"\x06cursor\x18\x03 \x01(\x03R\x06cursor\x12\x1c\n" +
-	"\tarticleId\x18\x04 \x01(\x03R\tarticleId\"L\n" +
+	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x05 \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x06cursorR\tarticleId\"L\n" +
 	"\x14ArticleDeleteRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1c\n" +
 	"\tarticleId\x18\x02 \x01(\x03R\tarticleId\"\x17\n" +
@@ -610,12 +796,21 @@ const file_article_proto_rawDesc = "" +
 	"\x14ArticleDetailRequest\x12\x1c\n" +
 	"\tarticleId\x18\x01 \x01(\x03R\tarticleId\"B\n" +
 	"\x15ArticleDetailResponse\x12)\n" +
-	"\aarticle\x18\x01 \x01(\v2\x0f.pb.ArticleItemR\aarticle2\x80\x02\n" +
+	"\aarticle\x18\x01 \x01(\v2\x0f.pb.ArticleItemR\aarticle\"t\n" +
+	"\x12HotArticlesRequest\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\tR\x06window\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x04 \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03R\x06cursor\"\x8c\x01\n" +
+	"\x13HotArticlesResponse\x12+\n" +
+	"\barticles\x18\x01 \x03(\v2\x0f.pb.ArticleItemR\barticles\x12\x14\n" +
+	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x04 \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04R\x06cursor2\xc0\x02\n" +
 	"\aArticle\x122\n" +
 	"\aPublish\x12\x12.pb.PublishRequest\x1a\x13.pb.PublishResponse\x125\n" +
 	"\bArticles\x12\x13.pb.ArticlesRequest\x1a\x14.pb.ArticlesResponse\x12D\n" +
 	"\rArticleDelete\x12\x18.pb.ArticleDeleteRequest\x1a\x19.pb.ArticleDeleteResponse\x12D\n" +
-	"\rArticleDetail\x12\x18.pb.ArticleDetailRequest\x1a\x19.pb.ArticleDetailResponseB\x06Z\x04./pbb\x06proto3"
+	"\rArticleDetail\x12\x18.pb.ArticleDetailRequest\x1a\x19.pb.ArticleDetailResponse\x12>\n" +
+	"\vHotArticles\x12\x16.pb.HotArticlesRequest\x1a\x17.pb.HotArticlesResponseB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_article_proto_rawDescOnce sync.Once
@@ -629,34 +824,41 @@ func file_article_proto_rawDescGZIP() []byte {
 	return file_article_proto_rawDescData
 }
 
-var file_article_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_article_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_article_proto_goTypes = []any{
 	(*PublishRequest)(nil),        // 0: pb.PublishRequest
 	(*PublishResponse)(nil),       // 1: pb.PublishResponse
 	(*ArticlesRequest)(nil),       // 2: pb.ArticlesRequest
 	(*ArticleItem)(nil),           // 3: pb.ArticleItem
-	(*ArticlesResponse)(nil),      // 4: pb.ArticlesResponse
-	(*ArticleDeleteRequest)(nil),  // 5: pb.ArticleDeleteRequest
-	(*ArticleDeleteResponse)(nil), // 6: pb.ArticleDeleteResponse
-	(*ArticleDetailRequest)(nil),  // 7: pb.ArticleDetailRequest
-	(*ArticleDetailResponse)(nil), // 8: pb.ArticleDetailResponse
+	(*MentionSpan)(nil),           // 4: pb.MentionSpan
+	(*ArticlesResponse)(nil),      // 5: pb.ArticlesResponse
+	(*ArticleDeleteRequest)(nil),  // 6: pb.ArticleDeleteRequest
+	(*ArticleDeleteResponse)(nil), // 7: pb.ArticleDeleteResponse
+	(*ArticleDetailRequest)(nil),  // 8: pb.ArticleDetailRequest
+	(*ArticleDetailResponse)(nil), // 9: pb.ArticleDetailResponse
+	(*HotArticlesRequest)(nil),    // 10: pb.HotArticlesRequest
+	(*HotArticlesResponse)(nil),   // 11: pb.HotArticlesResponse
 }
 var file_article_proto_depIdxs = []int32{
-	3, // 0: pb.ArticlesResponse.articles:type_name -> pb.ArticleItem
-	3, // 1: pb.ArticleDetailResponse.article:type_name -> pb.ArticleItem
-	0, // 2: pb.Article.Publish:input_type -> pb.PublishRequest
-	2, // 3: pb.Article.Articles:input_type -> pb.ArticlesRequest
-	5, // 4: pb.Article.ArticleDelete:input_type -> pb.ArticleDeleteRequest
-	7, // 5: pb.Article.ArticleDetail:input_type -> pb.ArticleDetailRequest
-	1, // 6: pb.Article.Publish:output_type -> pb.PublishResponse
-	4, // 7: pb.Article.Articles:output_type -> pb.ArticlesResponse
-	6, // 8: pb.Article.ArticleDelete:output_type -> pb.ArticleDeleteResponse
-	8, // 9: pb.Article.ArticleDetail:output_type -> pb.ArticleDetailResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4,  // 0: pb.ArticleItem.mentions:type_name -> pb.MentionSpan
+	3,  // 1: pb.ArticlesResponse.articles:type_name -> pb.ArticleItem
+	3,  // 2: pb.ArticleDetailResponse.article:type_name -> pb.ArticleItem
+	3,  // 3: pb.HotArticlesResponse.articles:type_name -> pb.ArticleItem
+	0,  // 4: pb.Article.Publish:input_type -> pb.PublishRequest
+	2,  // 5: pb.Article.Articles:input_type -> pb.ArticlesRequest
+	6,  // 6: pb.Article.ArticleDelete:input_type -> pb.ArticleDeleteRequest
+	8,  // 7: pb.Article.ArticleDetail:input_type -> pb.ArticleDetailRequest
+	10, // 8: pb.Article.HotArticles:input_type -> pb.HotArticlesRequest
+	1,  // 9: pb.Article.Publish:output_type -> pb.PublishResponse
+	5,  // 10: pb.Article.Articles:output_type -> pb.ArticlesResponse
+	7,  // 11: pb.Article.ArticleDelete:output_type -> pb.ArticleDeleteResponse
+	9,  // 12: pb.Article.ArticleDetail:output_type -> pb.ArticleDetailResponse
+	11, // 13: pb.Article.HotArticles:output_type -> pb.HotArticlesResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_article_proto_init() }
@@ -670,7 +872,7 @@ func file_article_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_article_proto_rawDesc), len(file_article_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
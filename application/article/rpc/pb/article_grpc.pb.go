@@ -23,6 +23,7 @@ const (
 	Article_Articles_FullMethodName      = "/pb.Article/Articles"
 	Article_ArticleDelete_FullMethodName = "/pb.Article/ArticleDelete"
 	Article_ArticleDetail_FullMethodName = "/pb.Article/ArticleDetail"
+	Article_HotArticles_FullMethodName   = "/pb.Article/HotArticles"
 )
 
 // ArticleClient is the client API for Article service.
@@ -33,6 +34,7 @@ type ArticleClient interface {
 	Articles(ctx context.Context, in *ArticlesRequest, opts ...grpc.CallOption) (*ArticlesResponse, error)
 	ArticleDelete(ctx context.Context, in *ArticleDeleteRequest, opts ...grpc.CallOption) (*ArticleDeleteResponse, error)
 	ArticleDetail(ctx context.Context, in *ArticleDetailRequest, opts ...grpc.CallOption) (*ArticleDetailResponse, error)
+	HotArticles(ctx context.Context, in *HotArticlesRequest, opts ...grpc.CallOption) (*HotArticlesResponse, error)
 }
 
 type articleClient struct {
@@ -83,6 +85,16 @@ func (c *articleClient) ArticleDetail(ctx context.Context, in *ArticleDetailRequ
 	return out, nil
 }
 
+func (c *articleClient) HotArticles(ctx context.Context, in *HotArticlesRequest, opts ...grpc.CallOption) (*HotArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HotArticlesResponse)
+	err := c.cc.Invoke(ctx, Article_HotArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArticleServer is the server API for Article service.
 // All implementations must embed UnimplementedArticleServer
 // for forward compatibility.
@@ -91,6 +103,7 @@ type ArticleServer interface {
 	Articles(context.Context, *ArticlesRequest) (*ArticlesResponse, error)
 	ArticleDelete(context.Context, *ArticleDeleteRequest) (*ArticleDeleteResponse, error)
 	ArticleDetail(context.Context, *ArticleDetailRequest) (*ArticleDetailResponse, error)
+	HotArticles(context.Context, *HotArticlesRequest) (*HotArticlesResponse, error)
 	mustEmbedUnimplementedArticleServer()
 }
 
@@ -113,6 +126,9 @@ func (UnimplementedArticleServer) ArticleDelete(context.Context, *ArticleDeleteR
 func (UnimplementedArticleServer) ArticleDetail(context.Context, *ArticleDetailRequest) (*ArticleDetailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArticleDetail not implemented")
 }
+func (UnimplementedArticleServer) HotArticles(context.Context, *HotArticlesRequest) (*HotArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HotArticles not implemented")
+}
 func (UnimplementedArticleServer) mustEmbedUnimplementedArticleServer() {}
 func (UnimplementedArticleServer) testEmbeddedByValue()                 {}
 
@@ -206,6 +222,24 @@ func _Article_ArticleDetail_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Article_HotArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HotArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).HotArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_HotArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).HotArticles(ctx, req.(*HotArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Article_ServiceDesc is the grpc.ServiceDesc for Article service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +263,10 @@ var Article_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ArticleDetail",
 			Handler:    _Article_ArticleDetail_Handler,
 		},
+		{
+			MethodName: "HotArticles",
+			Handler:    _Article_HotArticles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "article.proto",
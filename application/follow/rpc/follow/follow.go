@@ -14,16 +14,52 @@ import (
 )
 
 type (
-	FansItem           = pb.FansItem
-	FansListRequest    = pb.FansListRequest
-	FansListResponse   = pb.FansListResponse
-	FollowItem         = pb.FollowItem
-	FollowListRequest  = pb.FollowListRequest
-	FollowListResponse = pb.FollowListResponse
-	FollowRequest      = pb.FollowRequest
-	FollowResponse     = pb.FollowResponse
-	UnFollowRequest    = pb.UnFollowRequest
-	UnFollowResponse   = pb.UnFollowResponse
+	AddGroupMembersRequest     = pb.AddGroupMembersRequest
+	AddGroupMembersResponse    = pb.AddGroupMembersResponse
+	BlockItem                  = pb.BlockItem
+	BlockListRequest           = pb.BlockListRequest
+	BlockListResponse          = pb.BlockListResponse
+	BlockRequest               = pb.BlockRequest
+	BlockResponse              = pb.BlockResponse
+	BlockedIdsRequest          = pb.BlockedIdsRequest
+	BlockedIdsResponse         = pb.BlockedIdsResponse
+	CheckBlockedRequest        = pb.CheckBlockedRequest
+	CheckBlockedResponse       = pb.CheckBlockedResponse
+	CreateGroupRequest         = pb.CreateGroupRequest
+	CreateGroupResponse        = pb.CreateGroupResponse
+	DeleteGroupRequest         = pb.DeleteGroupRequest
+	DeleteGroupResponse        = pb.DeleteGroupResponse
+	FansItem                   = pb.FansItem
+	FansListRequest            = pb.FansListRequest
+	FansListResponse           = pb.FansListResponse
+	FollowItem                 = pb.FollowItem
+	FollowListRequest          = pb.FollowListRequest
+	FollowListResponse         = pb.FollowListResponse
+	FollowRequest              = pb.FollowRequest
+	FollowResponse             = pb.FollowResponse
+	FriendsListRequest         = pb.FriendsListRequest
+	FriendsListResponse        = pb.FriendsListResponse
+	GroupItem                  = pb.GroupItem
+	GroupListRequest           = pb.GroupListRequest
+	GroupListResponse          = pb.GroupListResponse
+	GroupMemberIdsRequest      = pb.GroupMemberIdsRequest
+	GroupMemberIdsResponse     = pb.GroupMemberIdsResponse
+	GroupMemberItem            = pb.GroupMemberItem
+	GroupMembersRequest        = pb.GroupMembersRequest
+	GroupMembersResponse       = pb.GroupMembersResponse
+	IsFollowingRequest         = pb.IsFollowingRequest
+	IsFollowingResponse        = pb.IsFollowingResponse
+	RelationItem               = pb.RelationItem
+	RelationsRequest           = pb.RelationsRequest
+	RelationsResponse          = pb.RelationsResponse
+	RemoveGroupMembersRequest  = pb.RemoveGroupMembersRequest
+	RemoveGroupMembersResponse = pb.RemoveGroupMembersResponse
+	UnBlockRequest             = pb.UnBlockRequest
+	UnBlockResponse            = pb.UnBlockResponse
+	UnFollowRequest            = pb.UnFollowRequest
+	UnFollowResponse           = pb.UnFollowResponse
+	UpdateGroupRequest         = pb.UpdateGroupRequest
+	UpdateGroupResponse        = pb.UpdateGroupResponse
 
 	Follow interface {
 		// 关注
@@ -34,6 +70,38 @@ type (
 		FollowList(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error)
 		// 粉丝列表
 		FansList(ctx context.Context, in *FansListRequest, opts ...grpc.CallOption) (*FansListResponse, error)
+		// 是否关注了某个用户
+		IsFollowing(ctx context.Context, in *IsFollowingRequest, opts ...grpc.CallOption) (*IsFollowingResponse, error)
+		// 拉黑或屏蔽
+		Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+		// 解除拉黑或屏蔽
+		UnBlock(ctx context.Context, in *UnBlockRequest, opts ...grpc.CallOption) (*UnBlockResponse, error)
+		// 拉黑或屏蔽列表
+		BlockList(ctx context.Context, in *BlockListRequest, opts ...grpc.CallOption) (*BlockListResponse, error)
+		// 我拉黑和屏蔽的全部用户，用于过滤内容
+		BlockedIds(ctx context.Context, in *BlockedIdsRequest, opts ...grpc.CallOption) (*BlockedIdsResponse, error)
+		// 一批用户中哪些人拉黑了我，用于校验评论、点赞等操作
+		CheckBlocked(ctx context.Context, in *CheckBlockedRequest, opts ...grpc.CallOption) (*CheckBlockedResponse, error)
+		// 批量查询我和一批用户之间的关注关系
+		Relations(ctx context.Context, in *RelationsRequest, opts ...grpc.CallOption) (*RelationsResponse, error)
+		// 好友（互相关注）列表
+		FriendsList(ctx context.Context, in *FriendsListRequest, opts ...grpc.CallOption) (*FriendsListResponse, error)
+		// 创建关注分组
+		CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+		// 修改关注分组名称
+		UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error)
+		// 删除关注分组
+		DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+		// 关注分组列表
+		GroupList(ctx context.Context, in *GroupListRequest, opts ...grpc.CallOption) (*GroupListResponse, error)
+		// 添加分组成员
+		AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error)
+		// 移除分组成员
+		RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error)
+		// 分组成员列表
+		GroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersResponse, error)
+		// 分组的全部成员id，用于分组关注流
+		GroupMemberIds(ctx context.Context, in *GroupMemberIdsRequest, opts ...grpc.CallOption) (*GroupMemberIdsResponse, error)
 	}
 
 	defaultFollow struct {
@@ -70,3 +138,99 @@ func (m *defaultFollow) FansList(ctx context.Context, in *FansListRequest, opts
 	client := pb.NewFollowClient(m.cli.Conn())
 	return client.FansList(ctx, in, opts...)
 }
+
+// 是否关注了某个用户
+func (m *defaultFollow) IsFollowing(ctx context.Context, in *IsFollowingRequest, opts ...grpc.CallOption) (*IsFollowingResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.IsFollowing(ctx, in, opts...)
+}
+
+// 拉黑或屏蔽
+func (m *defaultFollow) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.Block(ctx, in, opts...)
+}
+
+// 解除拉黑或屏蔽
+func (m *defaultFollow) UnBlock(ctx context.Context, in *UnBlockRequest, opts ...grpc.CallOption) (*UnBlockResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.UnBlock(ctx, in, opts...)
+}
+
+// 拉黑或屏蔽列表
+func (m *defaultFollow) BlockList(ctx context.Context, in *BlockListRequest, opts ...grpc.CallOption) (*BlockListResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.BlockList(ctx, in, opts...)
+}
+
+// 我拉黑和屏蔽的全部用户，用于过滤内容
+func (m *defaultFollow) BlockedIds(ctx context.Context, in *BlockedIdsRequest, opts ...grpc.CallOption) (*BlockedIdsResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.BlockedIds(ctx, in, opts...)
+}
+
+// 一批用户中哪些人拉黑了我，用于校验评论、点赞等操作
+func (m *defaultFollow) CheckBlocked(ctx context.Context, in *CheckBlockedRequest, opts ...grpc.CallOption) (*CheckBlockedResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.CheckBlocked(ctx, in, opts...)
+}
+
+// 批量查询我和一批用户之间的关注关系
+func (m *defaultFollow) Relations(ctx context.Context, in *RelationsRequest, opts ...grpc.CallOption) (*RelationsResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.Relations(ctx, in, opts...)
+}
+
+// 好友（互相关注）列表
+func (m *defaultFollow) FriendsList(ctx context.Context, in *FriendsListRequest, opts ...grpc.CallOption) (*FriendsListResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.FriendsList(ctx, in, opts...)
+}
+
+// 创建关注分组
+func (m *defaultFollow) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.CreateGroup(ctx, in, opts...)
+}
+
+// 修改关注分组名称
+func (m *defaultFollow) UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.UpdateGroup(ctx, in, opts...)
+}
+
+// 删除关注分组
+func (m *defaultFollow) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.DeleteGroup(ctx, in, opts...)
+}
+
+// 关注分组列表
+func (m *defaultFollow) GroupList(ctx context.Context, in *GroupListRequest, opts ...grpc.CallOption) (*GroupListResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.GroupList(ctx, in, opts...)
+}
+
+// 添加分组成员
+func (m *defaultFollow) AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.AddGroupMembers(ctx, in, opts...)
+}
+
+// 移除分组成员
+func (m *defaultFollow) RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.RemoveGroupMembers(ctx, in, opts...)
+}
+
+// 分组成员列表
+func (m *defaultFollow) GroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.GroupMembers(ctx, in, opts...)
+}
+
+// 分组的全部成员id，用于分组关注流
+func (m *defaultFollow) GroupMemberIds(ctx context.Context, in *GroupMemberIdsRequest, opts ...grpc.CallOption) (*GroupMemberIdsResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.GroupMemberIds(ctx, in, opts...)
+}
@@ -7,4 +7,15 @@ var (
 	FollowedUserIdEmpty = xcode.New(40002, "被关注用户id为空")
 	CannotFollowSelf    = xcode.New(40003, "不能关注自己")
 	UserIdEmpty         = xcode.New(40004, "用户id为空")
+	BlockTypeInvalid    = xcode.New(40005, "拉黑类型无效")
+	CannotBlockSelf     = xcode.New(40006, "不能拉黑自己")
+	FollowBlocked       = xcode.New(40007, "你们之间存在拉黑关系，不能关注")
+	GroupNameEmpty      = xcode.New(40008, "分组名称为空")
+	GroupNameTooLong    = xcode.New(40009, "分组名称过长")
+	GroupNameExists     = xcode.New(40010, "分组名称已存在")
+	GroupCountLimit     = xcode.New(40011, "分组数量已达上限")
+	GroupNotFound       = xcode.New(40012, "分组不存在")
+	GroupMemberLimit    = xcode.New(40013, "分组成员数量已达上限")
+	GroupMemberEmpty    = xcode.New(40014, "分组成员为空")
+	PageTokenInvalid    = xcode.New(40015, "分页游标无效")
 )
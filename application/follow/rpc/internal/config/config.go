@@ -14,4 +14,6 @@ type Config struct {
 		MaxLifetime  int `json:",default=3600"`
 	}
 	BizRedis redis.RedisConf
+	// CursorSecret 分页游标的签名密钥
+	CursorSecret string
 }
@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/model"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/internal/types"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type AddGroupMembersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAddGroupMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddGroupMembersLogic {
+	return &AddGroupMembersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AddGroupMembers 添加分组成员，未关注的用户会被忽略，已在分组中的成员不会重复添加
+func (l *AddGroupMembersLogic) AddGroupMembers(in *pb.AddGroupMembersRequest) (*pb.AddGroupMembersResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	if len(in.MemberUserIds) == 0 {
+		return nil, code.GroupMemberEmpty
+	}
+	group, err := findOwnGroup(l.ctx, l.svcCtx, in.UserId, in.GroupId)
+	if err != nil {
+		l.Logger.Errorf("[AddGroupMembers] findOwnGroup err: %v req: %v", err, in)
+		return nil, err
+	}
+	if group.MemberCount+len(in.MemberUserIds) > types.MaxGroupMemberCount {
+		return nil, code.GroupMemberLimit
+	}
+
+	following, err := followingIds(l.ctx, l.svcCtx, in.UserId, in.MemberUserIds)
+	if err != nil {
+		l.Logger.Errorf("[AddGroupMembers] followingIds err: %v req: %v", err, in)
+		return nil, err
+	}
+	members := make([]*model.FollowGroupMember, 0, len(following))
+	for _, memberUserId := range in.MemberUserIds {
+		if _, ok := following[memberUserId]; !ok {
+			continue
+		}
+		members = append(members, &model.FollowGroupMember{
+			GroupID:      group.ID,
+			UserID:       in.UserId,
+			MemberUserID: memberUserId,
+		})
+	}
+	if len(members) == 0 {
+		return &pb.AddGroupMembersResponse{}, nil
+	}
+
+	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		added, err := model.NewFollowGroupMemberModel(tx).InsertIgnore(l.ctx, members)
+		if err != nil {
+			return err
+		}
+		if added == 0 {
+			return nil
+		}
+		return model.NewFollowGroupModel(tx).IncrMemberCount(l.ctx, group.ID, added)
+	})
+	if err != nil {
+		l.Logger.Errorf("[AddGroupMembers] Transaction error: %v", err)
+		return nil, err
+	}
+	_, err = l.svcCtx.BizRedis.DelCtx(l.ctx, groupMemberIdsKey(in.UserId, group.ID))
+	if err != nil {
+		l.Logger.Errorf("[AddGroupMembers] BizRedis.DelCtx error: %v", err)
+	}
+
+	return &pb.AddGroupMembersResponse{}, nil
+}
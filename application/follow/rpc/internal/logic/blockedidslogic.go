@@ -0,0 +1,94 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/internal/types"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 缓存中的占位字段，没有拉黑任何人时也能区分缓存是否存在
+const blockedIdsPlaceholder = "0"
+
+type BlockedIdsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBlockedIdsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockedIdsLogic {
+	return &BlockedIdsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BlockedIds 我拉黑和屏蔽的全部用户，关注流和评论列表每次请求都会调用，缓存在redis的hash中（field是用户ID，value是类型）
+func (l *BlockedIdsLogic) BlockedIds(in *pb.BlockedIdsRequest) (*pb.BlockedIdsResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+
+	key := blockedIdsKey(in.UserId)
+	fields, err := l.svcCtx.BizRedis.HgetallCtx(l.ctx, key)
+	if err != nil {
+		l.Logger.Errorf("[BlockedIds] BizRedis.HgetallCtx key: %s error: %v", key, err)
+	}
+	if len(fields) == 0 {
+		fields, err = l.blockedIdsFromDB(in.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if err = l.svcCtx.BizRedis.HmsetCtx(l.ctx, key, fields); err != nil {
+			l.Logger.Errorf("[BlockedIds] BizRedis.HmsetCtx key: %s error: %v", key, err)
+		} else if err = l.svcCtx.BizRedis.ExpireCtx(l.ctx, key, types.BlockedIdsExpire); err != nil {
+			l.Logger.Errorf("[BlockedIds] BizRedis.ExpireCtx key: %s error: %v", key, err)
+		}
+	}
+
+	ret := &pb.BlockedIdsResponse{}
+	for field, value := range fields {
+		if field == blockedIdsPlaceholder {
+			continue
+		}
+		targetUserId, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			continue
+		}
+		blockType, _ := strconv.Atoi(value)
+		switch blockType {
+		case types.BlockTypeBlock:
+			ret.BlockedIds = append(ret.BlockedIds, targetUserId)
+		case types.BlockTypeMute:
+			ret.MutedIds = append(ret.MutedIds, targetUserId)
+		}
+	}
+
+	return ret, nil
+}
+
+// blockedIdsFromDB 同一个人既拉黑又屏蔽时按拉黑处理
+func (l *BlockedIdsLogic) blockedIdsFromDB(userId int64) (map[string]string, error) {
+	blocks, err := l.svcCtx.UserBlockModel.FindAllByUserId(l.ctx, userId, types.MaxBlockCount)
+	if err != nil {
+		l.Logger.Errorf("[BlockedIds] UserBlockModel.FindAllByUserId userId: %d err: %v", userId, err)
+		return nil, err
+	}
+
+	fields := map[string]string{blockedIdsPlaceholder: blockedIdsPlaceholder}
+	for _, block := range blocks {
+		field := strconv.FormatInt(block.TargetUserID, 10)
+		if fields[field] == strconv.Itoa(types.BlockTypeBlock) {
+			continue
+		}
+		fields[field] = strconv.Itoa(block.BlockType)
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/internal/types"
+	"posta/application/follow/rpc/pb"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BlockListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBlockListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockListLogic {
+	return &BlockListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BlockList 拉黑或屏蔽列表，只在设置页面使用，直接查数据库
+func (l *BlockListLogic) BlockList(in *pb.BlockListRequest) (*pb.BlockListResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	if !validBlockType(in.BlockType) {
+		return nil, code.BlockTypeInvalid
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+
+	// Id是上一页最后一条拉黑记录的Id
+	scope := fmt.Sprintf("blockList#%d#%d", in.UserId, in.BlockType)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+
+	blocks, err := l.svcCtx.UserBlockModel.FindByUserId(l.ctx, in.UserId, int(in.BlockType), pageCursor.Id, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[BlockList] UserBlockModel.FindByUserId err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	ret := &pb.BlockListResponse{
+		IsEnd: len(blocks) < int(in.PageSize),
+	}
+	for _, block := range blocks {
+		ret.Items = append(ret.Items, &pb.BlockItem{
+			Id:           block.ID,
+			TargetUserId: block.TargetUserID,
+			CreateTime:   block.CreateTime.Unix(),
+		})
+	}
+	if len(blocks) > 0 {
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Id: blocks[len(blocks)-1].ID})
+	}
+
+	return ret, nil
+}
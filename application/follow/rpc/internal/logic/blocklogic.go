@@ -0,0 +1,99 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/model"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/internal/types"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BlockLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBlockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockLogic {
+	return &BlockLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Block 拉黑或屏蔽。拉黑时双方互相取消关注，屏蔽保留关注关系
+func (l *BlockLogic) Block(in *pb.BlockRequest) (*pb.BlockResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	if in.TargetUserId == 0 {
+		return nil, code.FollowedUserIdEmpty
+	}
+	if in.UserId == in.TargetUserId {
+		return nil, code.CannotBlockSelf
+	}
+	if !validBlockType(in.BlockType) {
+		return nil, code.BlockTypeInvalid
+	}
+
+	block, err := l.svcCtx.UserBlockModel.FindOne(l.ctx, in.UserId, in.TargetUserId, int(in.BlockType))
+	if err != nil {
+		l.Logger.Errorf("[Block] UserBlockModel.FindOne err: %v req: %v", err, in)
+		return nil, err
+	}
+	if block != nil && block.BlockStatus == types.BlockStatusOn {
+		return &pb.BlockResponse{}, nil
+	}
+	if block != nil {
+		err = l.svcCtx.UserBlockModel.UpdateFields(l.ctx, block.ID, map[string]interface{}{
+			"block_status": types.BlockStatusOn,
+		})
+	} else {
+		err = l.svcCtx.UserBlockModel.Insert(l.ctx, &model.UserBlock{
+			UserID:       in.UserId,
+			TargetUserID: in.TargetUserId,
+			BlockType:    int(in.BlockType),
+			BlockStatus:  types.BlockStatusOn,
+			CreateTime:   time.Now(),
+			UpdateTime:   time.Now(),
+		})
+	}
+	if err != nil {
+		l.Logger.Errorf("[Block] save user block err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	if _, err = l.svcCtx.BizRedis.DelCtx(l.ctx, blockedIdsKey(in.UserId)); err != nil {
+		l.Logger.Errorf("[Block] BizRedis.DelCtx userId: %d error: %v", in.UserId, err)
+	}
+
+	if in.BlockType == types.BlockTypeBlock {
+		// 注意：拉黑后双方都不能再关注对方，已有的关注关系直接解除，计数和缓存沿用取消关注的逻辑
+		unFollow := NewUnFollowLogic(l.ctx, l.svcCtx)
+		if _, err = unFollow.UnFollow(&pb.UnFollowRequest{UserId: in.UserId, FollowedUserId: in.TargetUserId}); err != nil {
+			l.Logger.Errorf("[Block] UnFollow userId: %d followedUserId: %d err: %v", in.UserId, in.TargetUserId, err)
+			return nil, err
+		}
+		if _, err = unFollow.UnFollow(&pb.UnFollowRequest{UserId: in.TargetUserId, FollowedUserId: in.UserId}); err != nil {
+			l.Logger.Errorf("[Block] UnFollow userId: %d followedUserId: %d err: %v", in.TargetUserId, in.UserId, err)
+			return nil, err
+		}
+	}
+
+	return &pb.BlockResponse{}, nil
+}
+
+func validBlockType(blockType int32) bool {
+	return blockType == types.BlockTypeBlock || blockType == types.BlockTypeMute
+}
+
+func blockedIdsKey(userId int64) string {
+	return fmt.Sprintf("biz#user#blocked#%d", userId)
+}
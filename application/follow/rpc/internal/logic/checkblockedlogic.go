@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckBlockedLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckBlockedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckBlockedLogic {
+	return &CheckBlockedLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CheckBlocked 找出ownerIds中拉黑了userId的用户，评论、点赞前调用
+func (l *CheckBlockedLogic) CheckBlocked(in *pb.CheckBlockedRequest) (*pb.CheckBlockedResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+
+	ownerIds := make([]int64, 0, len(in.OwnerIds))
+	for _, ownerId := range in.OwnerIds {
+		// 自己的内容不存在拉黑
+		if ownerId > 0 && ownerId != in.UserId {
+			ownerIds = append(ownerIds, ownerId)
+		}
+	}
+	if len(ownerIds) == 0 {
+		return &pb.CheckBlockedResponse{}, nil
+	}
+
+	blockerIds, err := l.svcCtx.UserBlockModel.FindBlockers(l.ctx, ownerIds, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("[CheckBlocked] UserBlockModel.FindBlockers err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	return &pb.CheckBlockedResponse{BlockerIds: blockerIds}, nil
+}
@@ -0,0 +1,104 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/model"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/internal/types"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const prefixGroupMemberIds = "biz#follow#group#members#%d#%d"
+
+type CreateGroupLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateGroupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateGroupLogic {
+	return &CreateGroupLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateGroup 创建关注分组，同一个用户的分组名称不能重复
+func (l *CreateGroupLogic) CreateGroup(in *pb.CreateGroupRequest) (*pb.CreateGroupResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	name, err := validGroupName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := l.svcCtx.FollowGroupModel.CountByUserId(l.ctx, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("[CreateGroup] FollowGroupModel.CountByUserId err: %v req: %v", err, in)
+		return nil, err
+	}
+	if count >= types.MaxGroupCount {
+		return nil, code.GroupCountLimit
+	}
+	exist, err := l.svcCtx.FollowGroupModel.FindByUserIdAndName(l.ctx, in.UserId, name)
+	if err != nil {
+		l.Logger.Errorf("[CreateGroup] FollowGroupModel.FindByUserIdAndName err: %v req: %v", err, in)
+		return nil, err
+	}
+	if exist != nil {
+		return nil, code.GroupNameExists
+	}
+
+	group := &model.FollowGroup{
+		UserID: in.UserId,
+		Name:   name,
+	}
+	err = l.svcCtx.FollowGroupModel.Insert(l.ctx, group)
+	if err != nil {
+		l.Logger.Errorf("[CreateGroup] FollowGroupModel.Insert err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	return &pb.CreateGroupResponse{GroupId: group.ID}, nil
+}
+
+func validGroupName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		return "", code.GroupNameEmpty
+	}
+	if utf8.RuneCountInString(name) > types.MaxGroupNameLength {
+		return "", code.GroupNameTooLong
+	}
+
+	return name, nil
+}
+
+// findOwnGroup 查询分组并校验分组属于userId，不存在或不属于userId都返回GroupNotFound
+func findOwnGroup(ctx context.Context, svcCtx *svc.ServiceContext, userId, groupId int64) (*model.FollowGroup, error) {
+	if groupId == 0 {
+		return nil, code.GroupNotFound
+	}
+	group, err := svcCtx.FollowGroupModel.FindOne(ctx, groupId)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil || group.UserID != userId {
+		return nil, code.GroupNotFound
+	}
+
+	return group, nil
+}
+
+func groupMemberIdsKey(userId, groupId int64) string {
+	return fmt.Sprintf(prefixGroupMemberIds, userId, groupId)
+}
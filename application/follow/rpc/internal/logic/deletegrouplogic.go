@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/model"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type DeleteGroupLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteGroupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteGroupLogic {
+	return &DeleteGroupLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteGroup 删除分组和分组的全部成员，不影响关注关系
+func (l *DeleteGroupLogic) DeleteGroup(in *pb.DeleteGroupRequest) (*pb.DeleteGroupResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	group, err := findOwnGroup(l.ctx, l.svcCtx, in.UserId, in.GroupId)
+	if err == code.GroupNotFound {
+		return &pb.DeleteGroupResponse{}, nil
+	}
+	if err != nil {
+		l.Logger.Errorf("[DeleteGroup] findOwnGroup err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		err := model.NewFollowGroupMemberModel(tx).DeleteByGroupId(l.ctx, group.ID)
+		if err != nil {
+			return err
+		}
+		return model.NewFollowGroupModel(tx).Delete(l.ctx, group.ID)
+	})
+	if err != nil {
+		l.Logger.Errorf("[DeleteGroup] Transaction error: %v", err)
+		return nil, err
+	}
+	_, err = l.svcCtx.BizRedis.DelCtx(l.ctx, groupMemberIdsKey(in.UserId, group.ID))
+	if err != nil {
+		l.Logger.Errorf("[DeleteGroup] BizRedis.DelCtx error: %v", err)
+	}
+
+	return &pb.DeleteGroupResponse{}, nil
+}
@@ -2,20 +2,19 @@ package logic
 
 import (
 	"context"
+	"fmt"
 	"github.com/zeromicro/go-zero/core/threading"
 	"posta/application/follow/rpc/internal/code"
-	"posta/application/follow/rpc/internal/model"
 	"posta/application/follow/rpc/internal/svc"
 	"posta/application/follow/rpc/internal/types"
 	"posta/application/follow/rpc/pb"
-	"strconv"
+	"posta/pkg/cursor"
+	"posta/pkg/zsetcache"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
-const userFansExpireTime = 3600 * 24 * 2
-
 type FansListLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -36,76 +35,51 @@ func (l *FansListLogic) FansList(in *pb.FansListRequest) (*pb.FansListResponse,
 	if in.UserId == 0 {
 		return nil, code.UserIdEmpty
 	}
-	if in.PageSize == 0 {
+	if in.PageSize <= 0 {
 		in.PageSize = types.DefaultPageSize
 	}
-	if in.Cursor == 0 {
-		in.Cursor = time.Now().Unix()
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+	scope := fmt.Sprintf("fansList#%d", in.UserId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	// Sort是上一页最后一个粉丝的关注时间，Id是这个粉丝的id，第一页时为零值
+	var after *zsetcache.Item
+	if !pageCursor.IsZero() {
+		after = &zsetcache.Item{Id: pageCursor.Id, Score: pageCursor.Sort}
 	}
+
+	// 缓存中的score就是关注时间，不需要再查关注记录
 	var (
-		err            error
-		isCache, isEnd bool
-		lastId, cursor int64
-		fansUserIds    []int64
-		fansModel      []*model.Follow
-		curPage        []*pb.FansItem
+		isEnd bool
+		fans  []zsetcache.Item
 	)
-	fansUIds, createTime, _ := l.cacheFansUserIds(l.ctx, in.UserId, in.Cursor, in.PageSize)
-	if len(fansUIds) > 0 {
-		isCache = true
-		if fansUIds[len(fansUIds)-1] == -1 {
-			fansUIds = fansUIds[:len(fansUIds)-1]
-			isEnd = true
-		}
-		if len(fansUIds) == 0 {
-			return &pb.FansListResponse{}, nil
-		}
-		fansUserIds = fansUIds
-		for i, fansUId := range fansUIds {
-			curPage = append(curPage, &pb.FansItem{
-				UserId:     in.UserId,
-				FansUserId: fansUId,
-				CreateTime: createTime[i],
-			})
-		}
+	page, err := l.svcCtx.FansCache.Read(l.ctx, userFansKey(in.UserId), after, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[FansList] FansCache.Read error: %v req: %v", err, in)
+	}
+	if page != nil {
+		fans, isEnd = page.Items, page.IsEnd
 	} else {
-		fansModel, err = l.svcCtx.FollowModel.FindByFollowedUserId(l.ctx, in.UserId, types.CacheMaxFansCount)
+		fans, isEnd, err = l.fansFromDB(in, after)
 		if err != nil {
 			l.Logger.Errorf("[FansList] FollowModel.FindByFollowedUserId error: %v req: %v", err, in)
 			return nil, err
 		}
-		if len(fansModel) == 0 {
-			return &pb.FansListResponse{}, nil
-		}
-		var firstPageFans []*model.Follow
-		if len(fansModel) > int(in.PageSize) {
-			firstPageFans = fansModel[:in.PageSize]
-		} else {
-			firstPageFans = fansModel
-			isEnd = true
-		}
-		for _, fans := range firstPageFans {
-			fansUserIds = append(fansUserIds, fans.UserID)
-			curPage = append(curPage, &pb.FansItem{
-				UserId:     fans.FollowedUserID,
-				FansUserId: fans.UserID,
-				CreateTime: fans.CreateTime.Unix(),
-			})
-		}
 	}
-	if len(curPage) > 0 {
-		pageLast := curPage[len(curPage)-1]
-		lastId = pageLast.FansUserId
-		cursor = pageLast.CreateTime
-		if cursor < 0 {
-			cursor = 0
-		}
-		for i, fans := range curPage {
-			if fans.CreateTime == in.Cursor && fans.FansUserId == in.Id {
-				curPage = curPage[i:]
-				break
-			}
-		}
+
+	var (
+		fansUserIds []int64
+		curPage     []*pb.FansItem
+	)
+	for _, fan := range fans {
+		fansUserIds = append(fansUserIds, fan.Id)
+		curPage = append(curPage, &pb.FansItem{
+			UserId:     in.UserId,
+			FansUserId: fan.Id,
+			CreateTime: fan.Score,
+		})
 	}
 	fa, err := l.svcCtx.FollowCountModel.FindByUserIds(l.ctx, fansUserIds)
 	if err != nil {
@@ -121,75 +95,69 @@ func (l *FansListLogic) FansList(in *pb.FansListRequest) (*pb.FansListResponse,
 		cur.FansCount = int64(uidFansCount[cur.FansUserId])
 		cur.FollowCount = int64(uidFollowCount[cur.FansUserId])
 	}
+	// 我是否回关了粉丝，用于展示互相关注
+	mutual, err := followingIds(l.ctx, l.svcCtx, in.UserId, fansUserIds)
+	if err != nil {
+		l.Logger.Errorf("[FansList] followingIds error: %v fansUserIds: %v", err, fansUserIds)
+	}
+	for _, cur := range curPage {
+		_, cur.IsMutual = mutual[cur.FansUserId]
+	}
 
 	ret := &pb.FansListResponse{
-		Items:  curPage,
-		Cursor: cursor,
-		IsEnd:  isEnd,
-		Id:     lastId,
+		Items: curPage,
+		IsEnd: isEnd,
 	}
-
-	if !isCache {
-		threading.GoSafe(func() {
-			if len(fansModel) < types.CacheMaxFansCount && len(fansModel) > 0 {
-				fansModel = append(fansModel, &model.Follow{UserID: -1})
-			}
-			err = l.addCacheFans(context.Background(), in.UserId, fansModel)
-		})
+	if len(curPage) > 0 {
+		pageLast := curPage[len(curPage)-1]
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: pageLast.CreateTime, Id: pageLast.FansUserId})
 	}
+
 	return ret, nil
 }
 
-func (l *FansListLogic) cacheFansUserIds(ctx context.Context, userId, cursor, pageSize int64) ([]int64, []int64, error) {
-	key := userFansKey(userId)
-	b, err := l.svcCtx.BizRedis.ExistsCtx(ctx, key)
-	if err != nil {
-		logx.Errorf("[cacheFansUserIds] BizRedis.ExistsCtx error: %v", err)
-	}
-	if b {
-		err = l.svcCtx.BizRedis.ExpireCtx(ctx, key, userFansExpireTime)
-		if err != nil {
-			logx.Errorf("[cacheFansUserIds] BizRedis.ExpireCtx error: %v", err)
+// fansFromDB 缓存没有命中时查数据库。第一页多查CacheMaxFansCount条用于写缓存，之后的页只查一页，
+// 不能用中间的一段写缓存，否则缓存中的列表前面会缺一段
+func (l *FansListLogic) fansFromDB(in *pb.FansListRequest, after *zsetcache.Item) ([]zsetcache.Item, bool, error) {
+	limit := types.CacheMaxFansCount
+	lastTime, lastUserId := time.Time{}, int64(0)
+	if after != nil {
+		// 多查一条用于判断是否还有下一页
+		limit = int(in.PageSize) + 1
+		lastTime, lastUserId = time.Unix(after.Score, 0), after.Id
+	}
+	// 第一页查询前取缓存的版本号，查询期间粉丝有变化时不用这次的结果写缓存
+	var (
+		version int64
+		verErr  error
+	)
+	if after == nil {
+		version, verErr = l.svcCtx.FansCache.Version(l.ctx, userFansKey(in.UserId))
+		if verErr != nil {
+			l.Logger.Errorf("[FansList] FansCache.Version error: %v userId: %d", verErr, in.UserId)
 		}
 	}
-	pairs, err := l.svcCtx.BizRedis.ZrevrangebyscoreWithScoresAndLimitCtx(ctx, key, 0, cursor, 0, int(pageSize))
+	fansModel, err := l.svcCtx.FollowModel.FindByFollowedUserId(l.ctx, in.UserId, lastTime, lastUserId, limit)
 	if err != nil {
-		logx.Errorf("[cacheFansUserIds] BizRedis.ZrevrangebyscoreWithScoresAndLimitCtx error: %v", err)
-		return nil, nil, err
-	}
-	var uids []int64
-	var createTimes []int64
-	for _, pair := range pairs {
-		uid, err := strconv.ParseInt(pair.Key, 10, 64)
-		createTime, err := strconv.ParseInt(strconv.FormatInt(pair.Score, 10), 10, 64)
-		if err != nil {
-			logx.Errorf("[cacheFansUserIds] strconv.ParseInt error: %v", err)
-			continue
-		}
-		uids = append(uids, uid)
-		createTimes = append(createTimes, createTime)
+		return nil, false, err
 	}
-	return uids, createTimes, nil
-}
-
-func (l *FansListLogic) addCacheFans(ctx context.Context, userId int64, fans []*model.Follow) error {
-	if len(fans) == 0 {
-		return nil
+	fans := make([]zsetcache.Item, 0, len(fansModel))
+	for _, fan := range fansModel {
+		fans = append(fans, zsetcache.Item{Id: fan.UserID, Score: fan.CreateTime.Unix()})
 	}
-	key := userFansKey(userId)
-	for _, fan := range fans {
-		var score int64
-		if fan.UserID == -1 {
-			score = 0
-		} else {
-			score = fan.CreateTime.Unix()
-		}
-		_, err := l.svcCtx.BizRedis.ZaddCtx(ctx, key, score, strconv.FormatInt(fan.UserID, 10))
-		if err != nil {
-			logx.Errorf("[addCacheFans] BizRedis.ZaddCtx error: %v", err)
-			return err
-		}
+
+	if after == nil && verErr == nil {
+		threading.GoSafe(func() {
+			// 不到CacheMaxFansCount条说明已经查到了全部粉丝
+			err := l.svcCtx.FansCache.Fill(context.Background(), userFansKey(in.UserId), version, fans, len(fans) < types.CacheMaxFansCount)
+			if err != nil {
+				logx.Errorf("[FansList] FansCache.Fill error: %v userId: %d", err, in.UserId)
+			}
+		})
 	}
 
-	return l.svcCtx.BizRedis.ExpireCtx(ctx, key, userFollowExpireTime)
+	if len(fans) > int(in.PageSize) {
+		return fans[:in.PageSize], false, nil
+	}
+	return fans, true, nil
 }
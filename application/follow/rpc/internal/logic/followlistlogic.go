@@ -2,20 +2,20 @@ package logic
 
 import (
 	"context"
+	"fmt"
 	"github.com/zeromicro/go-zero/core/threading"
 	"posta/application/follow/rpc/internal/code"
 	"posta/application/follow/rpc/internal/model"
 	"posta/application/follow/rpc/internal/svc"
 	"posta/application/follow/rpc/internal/types"
 	"posta/application/follow/rpc/pb"
-	"strconv"
+	"posta/pkg/cursor"
+	"posta/pkg/zsetcache"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
-const userFollowExpireTime = 3600 * 24 * 2
-
 type FollowListLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -35,85 +35,59 @@ func (l *FollowListLogic) FollowList(in *pb.FollowListRequest) (*pb.FollowListRe
 	if in.UserId == 0 {
 		return nil, code.UserIdEmpty
 	}
-	if in.PageSize == 0 {
+	if in.PageSize <= 0 {
 		in.PageSize = types.DefaultPageSize
 	}
-	if in.Cursor == 0 {
-		in.Cursor = time.Now().Unix()
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+	scope := fmt.Sprintf("followList#%d", in.UserId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	// Sort是上一页最后一条的关注时间，Id是它的被关注者id，第一页时为零值
+	var after *zsetcache.Item
+	if !pageCursor.IsZero() {
+		after = &zsetcache.Item{Id: pageCursor.Id, Score: pageCursor.Sort}
 	}
 
 	var (
-		err             error
-		isCache, isEnd  bool
-		lastId, cursor  int64
+		isEnd           bool
 		followedUserIds []int64
 		follows         []*model.Follow
-		curPage         []*pb.FollowItem
 	)
-
-	followUserIds, _ := l.cacheFollowUserIds(l.ctx, in.UserId, in.Cursor, in.PageSize)
-	if len(followUserIds) > 0 {
-		isCache = true
-		if followUserIds[len(followUserIds)-1] == -1 {
-			followUserIds = followUserIds[:len(followUserIds)-1]
-			isEnd = true
-		}
-		if len(followUserIds) == 0 {
-			return &pb.FollowListResponse{}, nil
+	page, err := l.svcCtx.FollowCache.Read(l.ctx, userFollowKey(in.UserId), after, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[FollowList] FollowCache.Read error: %v req: %v", err, in)
+	}
+	if page != nil {
+		for _, item := range page.Items {
+			followedUserIds = append(followedUserIds, item.Id)
 		}
-		follows, err = l.svcCtx.FollowModel.FindByFollowedUserIds(l.ctx, in.UserId, followUserIds)
+		follows, err = l.followsByFollowedUserIds(in.UserId, followedUserIds)
 		if err != nil {
 			l.Logger.Errorf("[FollowList] FollowModel.FindByFollowedUserIds error: %v req: %v", err, in)
 			return nil, err
 		}
-		for _, follow := range follows {
-			followedUserIds = append(followedUserIds, follow.FollowedUserID)
-			curPage = append(curPage, &pb.FollowItem{
-				Id:             follow.ID,
-				FollowedUserId: follow.FollowedUserID,
-				CreateTime:     follow.CreateTime.Unix(),
-			})
-		}
+		isEnd = page.IsEnd
 	} else {
-		follows, err = l.svcCtx.FollowModel.FindByUserId(l.ctx, in.UserId, in.Cursor, types.CacheMaxFollowCount)
+		follows, isEnd, err = l.followsFromDB(in, after)
 		if err != nil {
 			l.Logger.Errorf("[FollowList] FollowModel.FindByUserId error: %v req: %v", err, in)
 			return nil, err
 		}
-		if len(follows) == 0 {
-			return &pb.FollowListResponse{}, nil
-		}
-		var firstPageFollows []*model.Follow
-		if len(follows) > int(in.PageSize) {
-			firstPageFollows = follows[:in.PageSize]
-		} else {
-			firstPageFollows = follows
-			isEnd = true
-		}
-		for _, follow := range firstPageFollows {
+		for _, follow := range follows {
 			followedUserIds = append(followedUserIds, follow.FollowedUserID)
-			curPage = append(curPage, &pb.FollowItem{
-				Id:             follow.ID,
-				FollowedUserId: follow.FollowedUserID,
-				CreateTime:     follow.CreateTime.Unix(),
-			})
-		}
-	}
-	if len(curPage) > 0 {
-		pageLast := curPage[len(curPage)-1]
-		lastId = pageLast.Id
-		cursor = pageLast.CreateTime
-		if cursor < 0 {
-			cursor = 0
-		}
-		for k, follow := range curPage {
-			if follow.CreateTime == in.Cursor && follow.Id == in.Id {
-				curPage = curPage[k:]
-				break
-			}
 		}
 	}
 
+	var curPage []*pb.FollowItem
+	for _, follow := range follows {
+		curPage = append(curPage, &pb.FollowItem{
+			Id:             follow.ID,
+			FollowedUserId: follow.FollowedUserID,
+			CreateTime:     follow.CreateTime.Unix(),
+		})
+	}
 	// 查找每个关注者的关注数量行记录（关注数和粉丝数）
 	// 然后把粉丝数也放到返回的页面的结果中
 	fc, err := l.svcCtx.FollowCountModel.FindByUserIds(l.ctx, followedUserIds)
@@ -127,76 +101,92 @@ func (l *FollowListLogic) FollowList(in *pb.FollowListRequest) (*pb.FollowListRe
 	for _, cur := range curPage {
 		cur.FansCount = int64(uidFansCount[cur.FollowedUserId])
 	}
+	// 对方是否回关了我，用于展示互相关注
+	backFollows, err := l.svcCtx.FollowModel.FindByUserIdsAndFollowedUserId(l.ctx, followedUserIds, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("[FollowList] FollowModel.FindByUserIdsAndFollowedUserId error: %v followedUserIds: %v", err, followedUserIds)
+	}
+	mutual := make(map[int64]struct{}, len(backFollows))
+	for _, f := range backFollows {
+		mutual[f.UserID] = struct{}{}
+	}
+	for _, cur := range curPage {
+		_, cur.IsMutual = mutual[cur.FollowedUserId]
+	}
 	ret := &pb.FollowListResponse{
-		IsEnd:  isEnd,
-		Cursor: cursor,
-		Id:     lastId,
-		Items:  curPage,
+		IsEnd: isEnd,
+		Items: curPage,
 	}
-
-	if !isCache {
-		threading.GoSafe(func() {
-			if len(follows) < types.CacheMaxFollowCount && len(follows) > 0 {
-				follows = append(follows, &model.Follow{FollowedUserID: -1})
-			}
-			err = l.addCacheFollow(context.Background(), in.UserId, follows)
-			if err != nil {
-				logx.Errorf("addCacheFollow error: %v", err)
-			}
-		})
+	if len(curPage) > 0 {
+		pageLast := curPage[len(curPage)-1]
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: pageLast.CreateTime, Id: pageLast.FollowedUserId})
 	}
 
 	return ret, nil
 }
 
-func (l *FollowListLogic) cacheFollowUserIds(ctx context.Context, userId, cursor, pageSize int64) ([]int64, error) {
-	key := userFollowKey(userId)
-	b, err := l.svcCtx.BizRedis.ExistsCtx(ctx, key)
-	if err != nil {
-		logx.Errorf("[cacheFollowUserIds] BizRedis.ExistsCtx error: %v", err)
-	}
-	if b {
-		err = l.svcCtx.BizRedis.ExpireCtx(ctx, key, userFollowExpireTime)
+// followsFromDB 缓存没有命中时查数据库。第一页多查CacheMaxFollowCount条用于写缓存，之后的页只查一页，
+// 不能用中间的一段写缓存，否则缓存中的列表前面会缺一段
+func (l *FollowListLogic) followsFromDB(in *pb.FollowListRequest, after *zsetcache.Item) ([]*model.Follow, bool, error) {
+	if after != nil {
+		// 多查一条用于判断是否还有下一页
+		follows, err := l.svcCtx.FollowModel.FindByUserId(l.ctx, in.UserId, time.Unix(after.Score, 0), after.Id, int(in.PageSize)+1)
 		if err != nil {
-			logx.Errorf("[cacheFollowUserIds] BizRedis.ExpireCtx error: %v", err)
+			return nil, false, err
+		}
+		if len(follows) > int(in.PageSize) {
+			return follows[:in.PageSize], false, nil
 		}
+		return follows, true, nil
 	}
-	pairs, err := l.svcCtx.BizRedis.ZrevrangebyscoreWithScoresAndLimitCtx(ctx, key, 0, cursor, 0, int(pageSize))
+
+	// 查询前取缓存的版本号，查询期间关注有变化时不用这次的结果写缓存
+	version, verErr := l.svcCtx.FollowCache.Version(l.ctx, userFollowKey(in.UserId))
+	if verErr != nil {
+		l.Logger.Errorf("[FollowList] FollowCache.Version error: %v userId: %d", verErr, in.UserId)
+	}
+	follows, err := l.svcCtx.FollowModel.FindByUserId(l.ctx, in.UserId, time.Time{}, 0, types.CacheMaxFollowCount)
 	if err != nil {
-		logx.Errorf("[cacheFollowUserIds] BizRedis.ZrevrangebyscoreWithScoresAndLimitCtx error: %v", err)
-		return nil, err
+		return nil, false, err
 	}
-	var uids []int64
-	for _, pair := range pairs {
-		uid, err := strconv.ParseInt(pair.Key, 10, 64)
-		if err != nil {
-			logx.Errorf("[cacheFollowUserIds] strconv.ParseInt error: %v", err)
-			continue
+	if verErr == nil {
+		items := make([]zsetcache.Item, 0, len(follows))
+		for _, follow := range follows {
+			items = append(items, zsetcache.Item{Id: follow.FollowedUserID, Score: follow.CreateTime.Unix()})
 		}
-		uids = append(uids, uid)
+		threading.GoSafe(func() {
+			// 不到CacheMaxFollowCount条说明已经查到了全部关注
+			err := l.svcCtx.FollowCache.Fill(context.Background(), userFollowKey(in.UserId), version, items, len(follows) < types.CacheMaxFollowCount)
+			if err != nil {
+				logx.Errorf("[FollowList] FollowCache.Fill error: %v userId: %d", err, in.UserId)
+			}
+		})
 	}
 
-	return uids, nil
+	if len(follows) > int(in.PageSize) {
+		return follows[:in.PageSize], false, nil
+	}
+	return follows, true, nil
 }
 
-func (l *FollowListLogic) addCacheFollow(ctx context.Context, userId int64, follows []*model.Follow) error {
-	if len(follows) == 0 {
-		return nil
+// followsByFollowedUserIds 查询缓存中的被关注者对应的关注记录，按followedUserIds的顺序返回
+func (l *FollowListLogic) followsByFollowedUserIds(userId int64, followedUserIds []int64) ([]*model.Follow, error) {
+	if len(followedUserIds) == 0 {
+		return nil, nil
+	}
+	follows, err := l.svcCtx.FollowModel.FindByFollowedUserIds(l.ctx, userId, followedUserIds)
+	if err != nil {
+		return nil, err
 	}
-	key := userFollowKey(userId)
+	followMap := make(map[int64]*model.Follow, len(follows))
 	for _, follow := range follows {
-		var score int64
-		if follow.FollowedUserID == -1 {
-			score = 0
-		} else {
-			score = follow.CreateTime.Unix()
-		}
-		_, err := l.svcCtx.BizRedis.ZaddCtx(ctx, key, score, strconv.FormatInt(follow.FollowedUserID, 10))
-		if err != nil {
-			logx.Errorf("[addCacheFollow] BizRedis.ZaddCtx error: %v", err)
-			return err
+		followMap[follow.FollowedUserID] = follow
+	}
+	ret := make([]*model.Follow, 0, len(follows))
+	for _, followedUserId := range followedUserIds {
+		if follow, ok := followMap[followedUserId]; ok {
+			ret = append(ret, follow)
 		}
 	}
-
-	return l.svcCtx.BizRedis.ExpireCtx(ctx, key, userFollowExpireTime)
+	return ret, nil
 }
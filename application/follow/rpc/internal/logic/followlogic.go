@@ -9,7 +9,7 @@ import (
 	"posta/application/follow/rpc/internal/svc"
 	"posta/application/follow/rpc/internal/types"
 	"posta/application/follow/rpc/pb"
-	"strconv"
+	"posta/pkg/zsetcache"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -40,6 +40,14 @@ func (l *FollowLogic) Follow(in *pb.FollowRequest) (*pb.FollowResponse, error) {
 	if in.UserId == in.FollowedUserId {
 		return nil, code.CannotFollowSelf
 	}
+	blocked, err := l.svcCtx.UserBlockModel.IsBlockedEither(l.ctx, in.UserId, in.FollowedUserId)
+	if err != nil {
+		l.Logger.Errorf("[Follow] UserBlockModel.IsBlockedEither err: %v req: %v", err, in)
+		return nil, err
+	}
+	if blocked {
+		return nil, code.FollowBlocked
+	}
 	follow, err := l.svcCtx.FollowModel.FindByUserIDAndFollowedUserID(l.ctx, in.UserId, in.FollowedUserId)
 	if err != nil {
 		l.Logger.Errorf("[Follow] FollowModel.FindByUserIDAndFollowedUserID err: %v req: %v", err, in)
@@ -48,6 +56,8 @@ func (l *FollowLogic) Follow(in *pb.FollowRequest) (*pb.FollowResponse, error) {
 	if follow != nil && follow.FollowStatus == types.FollowStatusFollow {
 		return &pb.FollowResponse{}, nil
 	}
+	// 关注时间是列表缓存的score，数据库中的时间只精确到秒，先截断保证两边一致
+	now := time.Now().Truncate(time.Second)
 	// 事务
 	// 注意：使用事务保证操作的原子性
 	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
@@ -57,16 +67,19 @@ func (l *FollowLogic) Follow(in *pb.FollowRequest) (*pb.FollowResponse, error) {
 			// 后面在 tx 上执行的操作，都会在同一个事务内；
 			// 最后 GORM 自动根据 error 判断是否 COMMIT 或 ROLLBACK。
 			// Transaction是将当前传入的事务 tx（*gorm.DB 类型）注入到 FollowModel 中，使得后续的所有数据库操作都在这个事务中进行。
+			// 重新关注时关注时间也要更新，否则会按第一次关注的时间排在列表中间
 			err = model.NewFollowModel(tx).UpdateFields(l.ctx, follow.ID, map[string]interface{}{
 				"follow_status": types.FollowStatusFollow,
+				"create_time":   now,
+				"update_time":   now,
 			}) // map表示这是一个哈希表（字典）。string map的键（Key）是字符串。interface{} 表示map的值（Value）是任意类型（因为 interface 是Go的万能类型）
 		} else {
 			err = model.NewFollowModel(tx).Insert(l.ctx, &model.Follow{
 				UserID:         in.UserId,
 				FollowedUserID: in.FollowedUserId,
 				FollowStatus:   types.FollowStatusFollow,
-				CreateTime:     time.Now(),
-				UpdateTime:     time.Now(),
+				CreateTime:     now,
+				UpdateTime:     now,
 			})
 		}
 
@@ -83,51 +96,25 @@ func (l *FollowLogic) Follow(in *pb.FollowRequest) (*pb.FollowResponse, error) {
 		l.Logger.Errorf("[Follow] Transaction error: %v", err)
 		return nil, err
 	}
-	followExist, err := l.svcCtx.BizRedis.ExistsCtx(l.ctx, userFollowKey(in.UserId))
+	// 注意：只有当缓存中存在时才往里面插入数据。
+	// 只缓存最新的CacheMaxFollowCount个关注和CacheMaxFansCount个粉丝，毕竟没有人会真的翻完1000万个粉丝，超出的部分在Lua中从尾部裁掉。
+	err = l.svcCtx.FollowCache.Add(l.ctx, userFollowKey(in.UserId), zsetcache.Item{Id: in.FollowedUserId, Score: now.Unix()})
 	if err != nil {
-		l.Logger.Errorf("[Follow] Redis Exists error: %v", err)
-		return nil, err
+		l.Logger.Errorf("[Follow] FollowCache.Add error: %v", err)
 	}
-	if followExist {
-
-		// 注意：只有当缓存中存在时才往里面插入数据。
-		_, err = l.svcCtx.BizRedis.ZaddCtx(l.ctx, userFollowKey(in.UserId), time.Now().Unix(), strconv.FormatInt(in.FollowedUserId, 10))
-		if err != nil {
-			l.Logger.Errorf("[Follow] Redis Zadd error: %v", err)
-			return nil, err
-		}
-		// 注意：只缓存CacheMaxFollowCount个粉丝数，毕竟每人会那么闲真的会查看全部粉丝数，比如有1000万粉丝，我们只在缓存中保留最新的CacheMaxFollowCount个就行。
-		// 这里使用的是Byrank即按排序0 表示第一个（最旧的关注对象），-(types.CacheMaxFollowCount + 1) 表示删除多余的前 N 个（注意是负数，表示从末尾往前数）
-		// -1示最后一个元素（score 最大）,-(1+types.CacheMaxFollowCount)表示倒数第(1+types.CacheMaxFollowCount)个元素
-		_, err = l.svcCtx.BizRedis.ZremrangebyrankCtx(l.ctx, userFollowKey(in.UserId), 0, -(types.CacheMaxFollowCount + 1))
-		if err != nil {
-			l.Logger.Errorf("[Follow] Redis Zremrangebyrank error: %v", err)
-		}
-	}
-	fansExist, err := l.svcCtx.BizRedis.ExistsCtx(l.ctx, userFansKey(in.FollowedUserId))
+	err = l.svcCtx.FansCache.Add(l.ctx, userFansKey(in.FollowedUserId), zsetcache.Item{Id: in.UserId, Score: now.Unix()})
 	if err != nil {
-		l.Logger.Errorf("[Follow] Redis Exists error: %v", err)
-		return nil, err
-	}
-	if fansExist {
-		_, err = l.svcCtx.BizRedis.ZaddCtx(l.ctx, userFansKey(in.FollowedUserId), time.Now().Unix(), strconv.FormatInt(in.UserId, 10))
-		if err != nil {
-			l.Logger.Errorf("[Follow] Redis Zadd error: %v", err)
-			return nil, err
-		}
-		_, err = l.svcCtx.BizRedis.ZremrangebyrankCtx(l.ctx, userFansKey(in.FollowedUserId), 0, -(types.CacheMaxFansCount + 1))
-		if err != nil {
-			l.Logger.Errorf("[Follow] Redis Zremrangebyrank error: %v", err)
-		}
+		l.Logger.Errorf("[Follow] FansCache.Add error: %v", err)
 	}
 
 	return &pb.FollowResponse{}, nil
 }
 
+// 缓存改用zsetcache的格式后换了key，旧格式的缓存不再读取
 func userFollowKey(userId int64) string {
-	return fmt.Sprintf("biz#user#follow#%d", userId)
+	return fmt.Sprintf("biz#user#follow#v2#%d", userId)
 }
 
 func userFansKey(userId int64) string {
-	return fmt.Sprintf("biz#user#fans#%d", userId)
+	return fmt.Sprintf("biz#user#fans#v2#%d", userId)
 }
@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/internal/types"
+	"posta/application/follow/rpc/pb"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FriendsListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFriendsListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FriendsListLogic {
+	return &FriendsListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// FriendsList 好友列表，即互相关注的用户，按我关注对方的先后倒序。好友列表访问较少，直接查数据库
+func (l *FriendsListLogic) FriendsList(in *pb.FriendsListRequest) (*pb.FriendsListResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+
+	// Id是上一页最后一条关注记录的Id
+	scope := fmt.Sprintf("friendsList#%d", in.UserId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+
+	follows, err := l.svcCtx.FollowModel.FindMutualByUserId(l.ctx, in.UserId, pageCursor.Id, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[FriendsList] FollowModel.FindMutualByUserId err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	friendIds := make([]int64, 0, len(follows))
+	items := make([]*pb.FollowItem, 0, len(follows))
+	for _, follow := range follows {
+		friendIds = append(friendIds, follow.FollowedUserID)
+		items = append(items, &pb.FollowItem{
+			Id:             follow.ID,
+			FollowedUserId: follow.FollowedUserID,
+			CreateTime:     follow.CreateTime.Unix(),
+			IsMutual:       true,
+		})
+	}
+	if len(friendIds) > 0 {
+		fc, err := l.svcCtx.FollowCountModel.FindByUserIds(l.ctx, friendIds)
+		if err != nil {
+			l.Logger.Errorf("[FriendsList] FollowCountModel.FindByUserIds error: %v friendIds: %v", err, friendIds)
+		}
+		uidFansCount := make(map[int64]int, len(fc))
+		for _, f := range fc {
+			uidFansCount[f.UserID] = f.FansCount
+		}
+		for _, item := range items {
+			item.FansCount = int64(uidFansCount[item.FollowedUserId])
+		}
+	}
+
+	ret := &pb.FriendsListResponse{
+		Items: items,
+		IsEnd: len(follows) < int(in.PageSize),
+	}
+	if len(items) > 0 {
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Id: items[len(items)-1].Id})
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GroupListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGroupListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GroupListLogic {
+	return &GroupListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GroupList 分组数量有上限，一次返回全部分组
+func (l *GroupListLogic) GroupList(in *pb.GroupListRequest) (*pb.GroupListResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+
+	groups, err := l.svcCtx.FollowGroupModel.FindByUserId(l.ctx, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("[GroupList] FollowGroupModel.FindByUserId err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	items := make([]*pb.GroupItem, 0, len(groups))
+	for _, group := range groups {
+		items = append(items, &pb.GroupItem{
+			GroupId:     group.ID,
+			Name:        group.Name,
+			MemberCount: int64(group.MemberCount),
+			CreateTime:  group.CreateTime.Unix(),
+		})
+	}
+
+	return &pb.GroupListResponse{Items: items}, nil
+}
@@ -0,0 +1,93 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/internal/types"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 缓存中的占位成员，分组没有成员时也能区分缓存是否存在
+const groupMemberIdsPlaceholder = "0"
+
+type GroupMemberIdsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGroupMemberIdsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GroupMemberIdsLogic {
+	return &GroupMemberIdsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GroupMemberIds 分组的全部成员id，分组关注流每次请求都会调用，缓存在redis的set中。
+// key中带了userId，命中缓存时不需要再校验分组归属
+func (l *GroupMemberIdsLogic) GroupMemberIds(in *pb.GroupMemberIdsRequest) (*pb.GroupMemberIdsResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+
+	key := groupMemberIdsKey(in.UserId, in.GroupId)
+	members, err := l.svcCtx.BizRedis.SmembersCtx(l.ctx, key)
+	if err != nil {
+		l.Logger.Errorf("[GroupMemberIds] BizRedis.SmembersCtx key: %s error: %v", key, err)
+	}
+	if len(members) == 0 {
+		members, err = l.memberIdsFromDB(in.UserId, in.GroupId)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]any, 0, len(members))
+		for _, member := range members {
+			values = append(values, member)
+		}
+		if _, err = l.svcCtx.BizRedis.SaddCtx(l.ctx, key, values...); err != nil {
+			l.Logger.Errorf("[GroupMemberIds] BizRedis.SaddCtx key: %s error: %v", key, err)
+		} else if err = l.svcCtx.BizRedis.ExpireCtx(l.ctx, key, types.GroupMemberIdsExpire); err != nil {
+			l.Logger.Errorf("[GroupMemberIds] BizRedis.ExpireCtx key: %s error: %v", key, err)
+		}
+	}
+
+	ret := &pb.GroupMemberIdsResponse{}
+	for _, member := range members {
+		if member == groupMemberIdsPlaceholder {
+			continue
+		}
+		memberUserId, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		ret.MemberUserIds = append(ret.MemberUserIds, memberUserId)
+	}
+
+	return ret, nil
+}
+
+func (l *GroupMemberIdsLogic) memberIdsFromDB(userId, groupId int64) ([]string, error) {
+	group, err := findOwnGroup(l.ctx, l.svcCtx, userId, groupId)
+	if err != nil {
+		l.Logger.Errorf("[GroupMemberIds] findOwnGroup userId: %d groupId: %d err: %v", userId, groupId, err)
+		return nil, err
+	}
+	memberUserIds, err := l.svcCtx.GroupMemberModel.FindMemberUserIds(l.ctx, group.ID, types.MaxGroupMemberCount)
+	if err != nil {
+		l.Logger.Errorf("[GroupMemberIds] GroupMemberModel.FindMemberUserIds groupId: %d err: %v", groupId, err)
+		return nil, err
+	}
+
+	members := []string{groupMemberIdsPlaceholder}
+	for _, memberUserId := range memberUserIds {
+		members = append(members, strconv.FormatInt(memberUserId, 10))
+	}
+
+	return members, nil
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/internal/types"
+	"posta/application/follow/rpc/pb"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GroupMembersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGroupMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GroupMembersLogic {
+	return &GroupMembersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GroupMembers 分组成员列表，按加入分组的先后倒序
+func (l *GroupMembersLogic) GroupMembers(in *pb.GroupMembersRequest) (*pb.GroupMembersResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+	group, err := findOwnGroup(l.ctx, l.svcCtx, in.UserId, in.GroupId)
+	if err != nil {
+		l.Logger.Errorf("[GroupMembers] findOwnGroup err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	// Id是上一页最后一条分组成员记录的Id
+	scope := fmt.Sprintf("groupMembers#%d#%d", in.UserId, in.GroupId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+
+	members, err := l.svcCtx.GroupMemberModel.FindByGroupId(l.ctx, group.ID, pageCursor.Id, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[GroupMembers] GroupMemberModel.FindByGroupId err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	ret := &pb.GroupMembersResponse{
+		IsEnd: len(members) < int(in.PageSize),
+	}
+	for _, member := range members {
+		ret.Items = append(ret.Items, &pb.GroupMemberItem{
+			Id:           member.ID,
+			MemberUserId: member.MemberUserID,
+			CreateTime:   member.CreateTime.Unix(),
+		})
+	}
+	if len(members) > 0 {
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Id: members[len(members)-1].ID})
+	}
+
+	return ret, nil
+}
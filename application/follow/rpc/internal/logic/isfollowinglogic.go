@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/internal/types"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type IsFollowingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewIsFollowingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *IsFollowingLogic {
+	return &IsFollowingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// IsFollowing 是否关注了某个用户，用于私信等需要校验关注关系的场景
+func (l *IsFollowingLogic) IsFollowing(in *pb.IsFollowingRequest) (*pb.IsFollowingResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.FollowUserIdEmpty
+	}
+	if in.FollowedUserId == 0 {
+		return nil, code.FollowedUserIdEmpty
+	}
+
+	follow, err := l.svcCtx.FollowModel.FindByUserIDAndFollowedUserID(l.ctx, in.UserId, in.FollowedUserId)
+	if err != nil {
+		l.Logger.Errorf("[IsFollowing] FollowModel.FindByUserIDAndFollowedUserID err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	return &pb.IsFollowingResponse{
+		IsFollowing: follow != nil && follow.FollowStatus == types.FollowStatusFollow,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/internal/types"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 单次最多查询的用户数
+const maxRelationTargets = 100
+
+type RelationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRelationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RelationsLogic {
+	return &RelationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Relations 批量查询我和一批用户之间的关注关系，用于用户卡片、搜索结果等展示"已关注""回关""互相关注"
+func (l *RelationsLogic) Relations(in *pb.RelationsRequest) (*pb.RelationsResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	if len(in.TargetIds) == 0 {
+		return &pb.RelationsResponse{}, nil
+	}
+	targetIds := in.TargetIds
+	if len(targetIds) > maxRelationTargets {
+		targetIds = targetIds[:maxRelationTargets]
+	}
+
+	following, err := followingIds(l.ctx, l.svcCtx, in.UserId, targetIds)
+	if err != nil {
+		l.Logger.Errorf("[Relations] followingIds err: %v req: %v", err, in)
+		return nil, err
+	}
+	followers, err := l.svcCtx.FollowModel.FindByUserIdsAndFollowedUserId(l.ctx, targetIds, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("[Relations] FollowModel.FindByUserIdsAndFollowedUserId err: %v req: %v", err, in)
+		return nil, err
+	}
+	followedBy := make(map[int64]struct{}, len(followers))
+	for _, follower := range followers {
+		followedBy[follower.UserID] = struct{}{}
+	}
+
+	items := make([]*pb.RelationItem, 0, len(targetIds))
+	for _, targetId := range targetIds {
+		_, isFollowing := following[targetId]
+		_, isFollowedBy := followedBy[targetId]
+		items = append(items, &pb.RelationItem{
+			TargetId:     targetId,
+			IsFollowing:  isFollowing,
+			IsFollowedBy: isFollowedBy,
+			IsMutual:     isFollowing && isFollowedBy,
+		})
+	}
+
+	return &pb.RelationsResponse{Items: items}, nil
+}
+
+// followingIds 通过FindByFollowedUserIds查出userId关注了targetIds中的哪些人，已取消关注的记录不算
+func followingIds(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, targetIds []int64) (map[int64]struct{}, error) {
+	ids := make(map[int64]struct{}, len(targetIds))
+	if len(targetIds) == 0 {
+		return ids, nil
+	}
+	follows, err := svcCtx.FollowModel.FindByFollowedUserIds(ctx, userId, targetIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, follow := range follows {
+		if follow.FollowStatus == types.FollowStatusFollow {
+			ids[follow.FollowedUserID] = struct{}{}
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/model"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type RemoveGroupMembersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRemoveGroupMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveGroupMembersLogic {
+	return &RemoveGroupMembersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RemoveGroupMembers 移除分组成员，不影响关注关系
+func (l *RemoveGroupMembersLogic) RemoveGroupMembers(in *pb.RemoveGroupMembersRequest) (*pb.RemoveGroupMembersResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	if len(in.MemberUserIds) == 0 {
+		return nil, code.GroupMemberEmpty
+	}
+	group, err := findOwnGroup(l.ctx, l.svcCtx, in.UserId, in.GroupId)
+	if err != nil {
+		l.Logger.Errorf("[RemoveGroupMembers] findOwnGroup err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		removed, err := model.NewFollowGroupMemberModel(tx).DeleteByGroupIdAndMemberUserIds(l.ctx, group.ID, in.MemberUserIds)
+		if err != nil {
+			return err
+		}
+		if removed == 0 {
+			return nil
+		}
+		return model.NewFollowGroupModel(tx).IncrMemberCount(l.ctx, group.ID, -removed)
+	})
+	if err != nil {
+		l.Logger.Errorf("[RemoveGroupMembers] Transaction error: %v", err)
+		return nil, err
+	}
+	_, err = l.svcCtx.BizRedis.DelCtx(l.ctx, groupMemberIdsKey(in.UserId, group.ID))
+	if err != nil {
+		l.Logger.Errorf("[RemoveGroupMembers] BizRedis.DelCtx error: %v", err)
+	}
+
+	return &pb.RemoveGroupMembersResponse{}, nil
+}
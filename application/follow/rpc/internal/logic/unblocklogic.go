@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/internal/types"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnBlockLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnBlockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnBlockLogic {
+	return &UnBlockLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnBlock 解除拉黑或屏蔽，拉黑时解除的关注关系不会恢复
+func (l *UnBlockLogic) UnBlock(in *pb.UnBlockRequest) (*pb.UnBlockResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	if in.TargetUserId == 0 {
+		return nil, code.FollowedUserIdEmpty
+	}
+	if !validBlockType(in.BlockType) {
+		return nil, code.BlockTypeInvalid
+	}
+
+	block, err := l.svcCtx.UserBlockModel.FindOne(l.ctx, in.UserId, in.TargetUserId, int(in.BlockType))
+	if err != nil {
+		l.Logger.Errorf("[UnBlock] UserBlockModel.FindOne err: %v req: %v", err, in)
+		return nil, err
+	}
+	if block == nil || block.BlockStatus == types.BlockStatusOff {
+		return &pb.UnBlockResponse{}, nil
+	}
+
+	err = l.svcCtx.UserBlockModel.UpdateFields(l.ctx, block.ID, map[string]interface{}{
+		"block_status": types.BlockStatusOff,
+	})
+	if err != nil {
+		l.Logger.Errorf("[UnBlock] UserBlockModel.UpdateFields err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	if _, err = l.svcCtx.BizRedis.DelCtx(l.ctx, blockedIdsKey(in.UserId)); err != nil {
+		l.Logger.Errorf("[UnBlock] BizRedis.DelCtx userId: %d error: %v", in.UserId, err)
+	}
+
+	return &pb.UnBlockResponse{}, nil
+}
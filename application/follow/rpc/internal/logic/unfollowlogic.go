@@ -8,7 +8,6 @@ import (
 	"posta/application/follow/rpc/internal/svc"
 	"posta/application/follow/rpc/internal/types"
 	"posta/application/follow/rpc/pb"
-	"strconv"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -49,6 +48,13 @@ func (l *UnFollowLogic) UnFollow(in *pb.UnFollowRequest) (*pb.UnFollowResponse,
 		return &pb.UnFollowResponse{}, nil
 	}
 
+	// 取消关注后对方也从我的关注分组中移除
+	groupIds, err := l.svcCtx.GroupMemberModel.FindGroupIdsByMemberUserId(l.ctx, in.UserId, in.FollowedUserId)
+	if err != nil {
+		l.Logger.Errorf("[UnFollow] GroupMemberModel.FindGroupIdsByMemberUserId err: %v req: %v", err, in)
+		return nil, err
+	}
+
 	// 事务
 	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
 		err := model.NewFollowModel(tx).UpdateFields(l.ctx, follow.ID, map[string]interface{}{
@@ -57,6 +63,18 @@ func (l *UnFollowLogic) UnFollow(in *pb.UnFollowRequest) (*pb.UnFollowResponse,
 		if err != nil {
 			return err
 		}
+		if len(groupIds) > 0 {
+			err = model.NewFollowGroupMemberModel(tx).DeleteByMemberUserId(l.ctx, in.UserId, in.FollowedUserId)
+			if err != nil {
+				return err
+			}
+			for _, groupId := range groupIds {
+				err = model.NewFollowGroupModel(tx).IncrMemberCount(l.ctx, groupId, -1)
+				if err != nil {
+					return err
+				}
+			}
+		}
 		err = model.NewFollowCountModel(tx).DecrFollowCount(l.ctx, in.UserId)
 		if err != nil {
 			return err
@@ -67,14 +85,20 @@ func (l *UnFollowLogic) UnFollow(in *pb.UnFollowRequest) (*pb.UnFollowResponse,
 		l.Logger.Errorf("[UnFollow] Transaction error: %v", err)
 		return nil, err
 	}
-	_, err = l.svcCtx.BizRedis.ZremCtx(l.ctx, userFollowKey(in.UserId), strconv.FormatInt(in.FollowedUserId, 10))
+	for _, groupId := range groupIds {
+		_, err = l.svcCtx.BizRedis.DelCtx(l.ctx, groupMemberIdsKey(in.UserId, groupId))
+		if err != nil {
+			l.Logger.Errorf("[UnFollow] BizRedis.DelCtx error: %v", err)
+		}
+	}
+	err = l.svcCtx.FollowCache.Remove(l.ctx, userFollowKey(in.UserId), in.FollowedUserId)
 	if err != nil {
-		l.Logger.Errorf("[UnFollow] BizRedis.ZremCtx error: %v", err)
+		l.Logger.Errorf("[UnFollow] FollowCache.Remove error: %v", err)
 		return nil, err
 	}
-	_, err = l.svcCtx.BizRedis.ZremCtx(l.ctx, userFansKey(in.FollowedUserId), strconv.FormatInt(in.UserId, 10))
+	err = l.svcCtx.FansCache.Remove(l.ctx, userFansKey(in.FollowedUserId), in.UserId)
 	if err != nil {
-		l.Logger.Errorf("[UnFollow] BizRedis.ZremCtx error: %v", err)
+		l.Logger.Errorf("[UnFollow] FansCache.Remove error: %v", err)
 		return nil, err
 	}
 
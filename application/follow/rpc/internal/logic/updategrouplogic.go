@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/follow/rpc/internal/code"
+	"posta/application/follow/rpc/internal/svc"
+	"posta/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateGroupLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateGroupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateGroupLogic {
+	return &UpdateGroupLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateGroup 修改分组名称
+func (l *UpdateGroupLogic) UpdateGroup(in *pb.UpdateGroupRequest) (*pb.UpdateGroupResponse, error) {
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	name, err := validGroupName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	group, err := findOwnGroup(l.ctx, l.svcCtx, in.UserId, in.GroupId)
+	if err != nil {
+		l.Logger.Errorf("[UpdateGroup] findOwnGroup err: %v req: %v", err, in)
+		return nil, err
+	}
+	if group.Name == name {
+		return &pb.UpdateGroupResponse{}, nil
+	}
+	exist, err := l.svcCtx.FollowGroupModel.FindByUserIdAndName(l.ctx, in.UserId, name)
+	if err != nil {
+		l.Logger.Errorf("[UpdateGroup] FollowGroupModel.FindByUserIdAndName err: %v req: %v", err, in)
+		return nil, err
+	}
+	if exist != nil {
+		return nil, code.GroupNameExists
+	}
+
+	err = l.svcCtx.FollowGroupModel.UpdateFields(l.ctx, group.ID, map[string]interface{}{
+		"name": name,
+	})
+	if err != nil {
+		l.Logger.Errorf("[UpdateGroup] FollowGroupModel.UpdateFields err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	return &pb.UpdateGroupResponse{}, nil
+}
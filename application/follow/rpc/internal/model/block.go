@@ -0,0 +1,101 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type UserBlock struct {
+	ID           int64 `gorm:"primary_key"`
+	UserID       int64
+	TargetUserID int64
+	BlockType    int
+	BlockStatus  int
+	CreateTime   time.Time
+	UpdateTime   time.Time
+}
+
+func (m *UserBlock) TableName() string {
+	return "user_block"
+}
+
+type UserBlockModel struct {
+	db *gorm.DB
+}
+
+func NewUserBlockModel(db *gorm.DB) *UserBlockModel {
+	return &UserBlockModel{
+		db: db,
+	}
+}
+
+func (m *UserBlockModel) Insert(ctx context.Context, data *UserBlock) error {
+	return m.db.WithContext(ctx).Create(data).Error
+}
+
+func (m *UserBlockModel) UpdateFields(ctx context.Context, id int64, values map[string]interface{}) error {
+	return m.db.WithContext(ctx).Model(&UserBlock{}).Where("id = ?", id).Updates(values).Error
+}
+
+// FindOne 查询拉黑或屏蔽记录，没找到时返回nil, nil
+func (m *UserBlockModel) FindOne(ctx context.Context, userId, targetUserId int64, blockType int) (*UserBlock, error) {
+	var result UserBlock
+	err := m.db.WithContext(ctx).
+		Where("user_id = ? AND target_user_id = ? AND block_type = ?", userId, targetUserId, blockType).
+		First(&result).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &result, err
+}
+
+// FindByUserId 按id倒序分页查询用户拉黑或屏蔽的记录
+func (m *UserBlockModel) FindByUserId(ctx context.Context, userId int64, blockType int, cursorId int64, limit int) ([]*UserBlock, error) {
+	var result []*UserBlock
+	query := m.db.WithContext(ctx).
+		Where("user_id = ? AND block_type = ? AND block_status = ?", userId, blockType, 1)
+	if cursorId > 0 {
+		query = query.Where("id < ?", cursorId)
+	}
+	err := query.Order("id desc").
+		Limit(limit).
+		Find(&result).Error
+
+	return result, err
+}
+
+// FindAllByUserId 查询用户生效中的全部拉黑和屏蔽记录，每种类型最多limit条
+func (m *UserBlockModel) FindAllByUserId(ctx context.Context, userId int64, limit int) ([]*UserBlock, error) {
+	var result []*UserBlock
+	err := m.db.WithContext(ctx).
+		Where("user_id = ? AND block_status = ?", userId, 1).
+		Order("id desc").
+		Limit(limit * 2).
+		Find(&result).Error
+
+	return result, err
+}
+
+// FindBlockers 在userIds中找出拉黑了targetUserId的用户
+func (m *UserBlockModel) FindBlockers(ctx context.Context, userIds []int64, targetUserId int64) ([]int64, error) {
+	var result []int64
+	err := m.db.WithContext(ctx).Model(&UserBlock{}).
+		Where("user_id in (?) AND target_user_id = ? AND block_type = ? AND block_status = ?", userIds, targetUserId, 1, 1).
+		Pluck("user_id", &result).Error
+
+	return result, err
+}
+
+// IsBlockedEither 两个用户之间是否有一方拉黑了另一方
+func (m *UserBlockModel) IsBlockedEither(ctx context.Context, userId, targetUserId int64) (bool, error) {
+	var count int64
+	err := m.db.WithContext(ctx).Model(&UserBlock{}).
+		Where("((user_id = ? AND target_user_id = ?) OR (user_id = ? AND target_user_id = ?)) AND block_type = ? AND block_status = ?",
+			userId, targetUserId, targetUserId, userId, 1, 1).
+		Count(&count).Error
+
+	return count > 0, err
+}
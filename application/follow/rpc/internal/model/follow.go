@@ -73,17 +73,18 @@ func (m *FollowModel) FindByUserIDAndFollowedUserID(ctx context.Context, userId,
 //		return result, err
 //	}
 
-// 根据关注者的id找limit条关注记录
-func (m *FollowModel) FindByUserId(ctx context.Context, userId int64, cursorId int64, limit int) ([]*Follow, error) {
+// 根据关注者的id找limit条关注记录，按关注时间倒序，关注时间相同时按被关注者id倒序。
+// lastFollowedUserId大于0时从上一页最后一条(lastTime, lastFollowedUserId)之后开始查
+func (m *FollowModel) FindByUserId(ctx context.Context, userId int64, lastTime time.Time, lastFollowedUserId int64, limit int) ([]*Follow, error) {
 	var result []*Follow
 	query := m.db.WithContext(ctx).
 		Where("user_id = ? AND follow_status = ?", userId, 1)
 
-	if cursorId > 0 {
-		query = query.Where("id < ?", cursorId)
+	if lastFollowedUserId > 0 {
+		query = query.Where("(create_time < ? OR (create_time = ? AND followed_user_id < ?))", lastTime, lastTime, lastFollowedUserId)
 	}
 
-	err := query.Order("id desc").
+	err := query.Order("create_time desc, followed_user_id desc").
 		Limit(limit).
 		Find(&result).Error
 
@@ -101,12 +102,49 @@ func (m *FollowModel) FindByFollowedUserIds(ctx context.Context, userId int64, f
 	return result, err
 }
 
-func (m *FollowModel) FindByFollowedUserId(ctx context.Context, userId int64, limit int) ([]*Follow, error) {
+// 根据被关注者的id找limit条粉丝记录，按关注时间倒序，关注时间相同时按粉丝id倒序。
+// lastUserId大于0时从上一页最后一条(lastTime, lastUserId)之后开始查
+func (m *FollowModel) FindByFollowedUserId(ctx context.Context, followedUserId int64, lastTime time.Time, lastUserId int64, limit int) ([]*Follow, error) {
+	var result []*Follow
+	query := m.db.WithContext(ctx).
+		Where("followed_user_id = ? AND follow_status = ?", followedUserId, 1)
+
+	if lastUserId > 0 {
+		query = query.Where("(create_time < ? OR (create_time = ? AND user_id < ?))", lastTime, lastTime, lastUserId)
+	}
+
+	err := query.Order("create_time desc, user_id desc").
+		Limit(limit).
+		Find(&result).Error
+
+	return result, err
+}
+
+// 在userIds中找出关注了followedUserId的记录，和FindByFollowedUserIds方向相反，用来判断对方是否关注了我
+func (m *FollowModel) FindByUserIdsAndFollowedUserId(ctx context.Context, userIds []int64, followedUserId int64) ([]*Follow, error) {
 	var result []*Follow
 	err := m.db.WithContext(ctx).
-		Where("followed_user_id = ? AND follow_status = ?", userId, 1).
-		Order("id desc").
+		Where("user_id in (?)", userIds).
+		Where("followed_user_id = ? AND follow_status = ?", followedUserId, 1).
+		Find(&result).Error
+
+	return result, err
+}
+
+// 按id倒序分页查询互相关注的记录，cursorId为0时从最新的开始
+func (m *FollowModel) FindMutualByUserId(ctx context.Context, userId int64, cursorId int64, limit int) ([]*Follow, error) {
+	var result []*Follow
+	query := m.db.WithContext(ctx).
+		Table("follow AS f").
+		Select("f.*").
+		Joins("JOIN follow AS r ON r.user_id = f.followed_user_id AND r.followed_user_id = f.user_id AND r.follow_status = ?", 1).
+		Where("f.user_id = ? AND f.follow_status = ?", userId, 1)
+	if cursorId > 0 {
+		query = query.Where("f.id < ?", cursorId)
+	}
+	err := query.Order("f.id desc").
 		Limit(limit).
 		Find(&result).Error
+
 	return result, err
 }
@@ -0,0 +1,180 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FollowGroup struct {
+	ID          int64 `gorm:"primary_key"`
+	UserID      int64
+	Name        string
+	MemberCount int
+	CreateTime  time.Time
+	UpdateTime  time.Time
+}
+
+func (m *FollowGroup) TableName() string {
+	return "follow_group"
+}
+
+type FollowGroupMember struct {
+	ID           int64 `gorm:"primary_key"`
+	GroupID      int64
+	UserID       int64
+	MemberUserID int64
+	CreateTime   time.Time
+}
+
+func (m *FollowGroupMember) TableName() string {
+	return "follow_group_member"
+}
+
+type FollowGroupModel struct {
+	db *gorm.DB
+}
+
+func NewFollowGroupModel(db *gorm.DB) *FollowGroupModel {
+	return &FollowGroupModel{
+		db: db,
+	}
+}
+
+func (m *FollowGroupModel) Insert(ctx context.Context, data *FollowGroup) error {
+	return m.db.WithContext(ctx).Create(data).Error
+}
+
+func (m *FollowGroupModel) UpdateFields(ctx context.Context, id int64, values map[string]interface{}) error {
+	return m.db.WithContext(ctx).Model(&FollowGroup{}).Where("id = ?", id).Updates(values).Error
+}
+
+func (m *FollowGroupModel) Delete(ctx context.Context, id int64) error {
+	return m.db.WithContext(ctx).Where("id = ?", id).Delete(&FollowGroup{}).Error
+}
+
+// FindOne 查询分组，没找到时返回nil, nil
+func (m *FollowGroupModel) FindOne(ctx context.Context, id int64) (*FollowGroup, error) {
+	var result FollowGroup
+	err := m.db.WithContext(ctx).Where("id = ?", id).First(&result).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &result, err
+}
+
+// FindByUserIdAndName 按名称查询用户的分组，没找到时返回nil, nil
+func (m *FollowGroupModel) FindByUserIdAndName(ctx context.Context, userId int64, name string) (*FollowGroup, error) {
+	var result FollowGroup
+	err := m.db.WithContext(ctx).Where("user_id = ? AND name = ?", userId, name).First(&result).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &result, err
+}
+
+// FindByUserId 查询用户的全部分组，按创建先后排序
+func (m *FollowGroupModel) FindByUserId(ctx context.Context, userId int64) ([]*FollowGroup, error) {
+	var result []*FollowGroup
+	err := m.db.WithContext(ctx).
+		Where("user_id = ?", userId).
+		Order("id asc").
+		Find(&result).Error
+
+	return result, err
+}
+
+func (m *FollowGroupModel) CountByUserId(ctx context.Context, userId int64) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).Model(&FollowGroup{}).Where("user_id = ?", userId).Count(&count).Error
+	return count, err
+}
+
+// IncrMemberCount delta可以为负数，成员数不会小于0
+func (m *FollowGroupModel) IncrMemberCount(ctx context.Context, id int64, delta int64) error {
+	return m.db.WithContext(ctx).
+		Exec("UPDATE follow_group SET member_count = IF(member_count + ? > 0, member_count + ?, 0) WHERE id = ?", delta, delta, id).
+		Error
+}
+
+type FollowGroupMemberModel struct {
+	db *gorm.DB
+}
+
+func NewFollowGroupMemberModel(db *gorm.DB) *FollowGroupMemberModel {
+	return &FollowGroupMemberModel{
+		db: db,
+	}
+}
+
+// InsertIgnore 批量添加成员，已经在分组中的成员会被忽略，返回实际添加的数量
+func (m *FollowGroupMemberModel) InsertIgnore(ctx context.Context, data []*FollowGroupMember) (int64, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+	ret := m.db.WithContext(ctx).Clauses(clause.Insert{Modifier: "IGNORE"}).Create(&data)
+	return ret.RowsAffected, ret.Error
+}
+
+// DeleteByGroupIdAndMemberUserIds 返回实际删除的数量
+func (m *FollowGroupMemberModel) DeleteByGroupIdAndMemberUserIds(ctx context.Context, groupId int64, memberUserIds []int64) (int64, error) {
+	if len(memberUserIds) == 0 {
+		return 0, nil
+	}
+	ret := m.db.WithContext(ctx).
+		Where("group_id = ? AND member_user_id IN ?", groupId, memberUserIds).
+		Delete(&FollowGroupMember{})
+	return ret.RowsAffected, ret.Error
+}
+
+func (m *FollowGroupMemberModel) DeleteByGroupId(ctx context.Context, groupId int64) error {
+	return m.db.WithContext(ctx).Where("group_id = ?", groupId).Delete(&FollowGroupMember{}).Error
+}
+
+// FindGroupIdsByMemberUserId 查询memberUserId在userId的哪些分组中
+func (m *FollowGroupMemberModel) FindGroupIdsByMemberUserId(ctx context.Context, userId, memberUserId int64) ([]int64, error) {
+	var groupIds []int64
+	err := m.db.WithContext(ctx).
+		Model(&FollowGroupMember{}).
+		Where("user_id = ? AND member_user_id = ?", userId, memberUserId).
+		Pluck("group_id", &groupIds).Error
+
+	return groupIds, err
+}
+
+func (m *FollowGroupMemberModel) DeleteByMemberUserId(ctx context.Context, userId, memberUserId int64) error {
+	return m.db.WithContext(ctx).
+		Where("user_id = ? AND member_user_id = ?", userId, memberUserId).
+		Delete(&FollowGroupMember{}).Error
+}
+
+// FindByGroupId 按id倒序分页查询分组成员
+func (m *FollowGroupMemberModel) FindByGroupId(ctx context.Context, groupId int64, cursorId int64, limit int) ([]*FollowGroupMember, error) {
+	var result []*FollowGroupMember
+	query := m.db.WithContext(ctx).Where("group_id = ?", groupId)
+	if cursorId > 0 {
+		query = query.Where("id < ?", cursorId)
+	}
+	err := query.Order("id desc").
+		Limit(limit).
+		Find(&result).Error
+
+	return result, err
+}
+
+// FindMemberUserIds 查询分组的全部成员id
+func (m *FollowGroupMemberModel) FindMemberUserIds(ctx context.Context, groupId int64, limit int) ([]int64, error) {
+	var memberUserIds []int64
+	err := m.db.WithContext(ctx).
+		Model(&FollowGroupMember{}).
+		Where("group_id = ?", groupId).
+		Order("id desc").
+		Limit(limit).
+		Pluck("member_user_id", &memberUserIds).Error
+
+	return memberUserIds, err
+}
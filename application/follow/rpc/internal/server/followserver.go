@@ -46,3 +46,99 @@ func (s *FollowServer) FansList(ctx context.Context, in *pb.FansListRequest) (*p
 	l := logic.NewFansListLogic(ctx, s.svcCtx)
 	return l.FansList(in)
 }
+
+// 是否关注了某个用户
+func (s *FollowServer) IsFollowing(ctx context.Context, in *pb.IsFollowingRequest) (*pb.IsFollowingResponse, error) {
+	l := logic.NewIsFollowingLogic(ctx, s.svcCtx)
+	return l.IsFollowing(in)
+}
+
+// 拉黑或屏蔽
+func (s *FollowServer) Block(ctx context.Context, in *pb.BlockRequest) (*pb.BlockResponse, error) {
+	l := logic.NewBlockLogic(ctx, s.svcCtx)
+	return l.Block(in)
+}
+
+// 解除拉黑或屏蔽
+func (s *FollowServer) UnBlock(ctx context.Context, in *pb.UnBlockRequest) (*pb.UnBlockResponse, error) {
+	l := logic.NewUnBlockLogic(ctx, s.svcCtx)
+	return l.UnBlock(in)
+}
+
+// 拉黑或屏蔽列表
+func (s *FollowServer) BlockList(ctx context.Context, in *pb.BlockListRequest) (*pb.BlockListResponse, error) {
+	l := logic.NewBlockListLogic(ctx, s.svcCtx)
+	return l.BlockList(in)
+}
+
+// 我拉黑和屏蔽的全部用户，用于过滤内容
+func (s *FollowServer) BlockedIds(ctx context.Context, in *pb.BlockedIdsRequest) (*pb.BlockedIdsResponse, error) {
+	l := logic.NewBlockedIdsLogic(ctx, s.svcCtx)
+	return l.BlockedIds(in)
+}
+
+// 一批用户中哪些人拉黑了我，用于校验评论、点赞等操作
+func (s *FollowServer) CheckBlocked(ctx context.Context, in *pb.CheckBlockedRequest) (*pb.CheckBlockedResponse, error) {
+	l := logic.NewCheckBlockedLogic(ctx, s.svcCtx)
+	return l.CheckBlocked(in)
+}
+
+// 批量查询我和一批用户之间的关注关系
+func (s *FollowServer) Relations(ctx context.Context, in *pb.RelationsRequest) (*pb.RelationsResponse, error) {
+	l := logic.NewRelationsLogic(ctx, s.svcCtx)
+	return l.Relations(in)
+}
+
+// 好友（互相关注）列表
+func (s *FollowServer) FriendsList(ctx context.Context, in *pb.FriendsListRequest) (*pb.FriendsListResponse, error) {
+	l := logic.NewFriendsListLogic(ctx, s.svcCtx)
+	return l.FriendsList(in)
+}
+
+// 创建关注分组
+func (s *FollowServer) CreateGroup(ctx context.Context, in *pb.CreateGroupRequest) (*pb.CreateGroupResponse, error) {
+	l := logic.NewCreateGroupLogic(ctx, s.svcCtx)
+	return l.CreateGroup(in)
+}
+
+// 修改关注分组名称
+func (s *FollowServer) UpdateGroup(ctx context.Context, in *pb.UpdateGroupRequest) (*pb.UpdateGroupResponse, error) {
+	l := logic.NewUpdateGroupLogic(ctx, s.svcCtx)
+	return l.UpdateGroup(in)
+}
+
+// 删除关注分组
+func (s *FollowServer) DeleteGroup(ctx context.Context, in *pb.DeleteGroupRequest) (*pb.DeleteGroupResponse, error) {
+	l := logic.NewDeleteGroupLogic(ctx, s.svcCtx)
+	return l.DeleteGroup(in)
+}
+
+// 关注分组列表
+func (s *FollowServer) GroupList(ctx context.Context, in *pb.GroupListRequest) (*pb.GroupListResponse, error) {
+	l := logic.NewGroupListLogic(ctx, s.svcCtx)
+	return l.GroupList(in)
+}
+
+// 添加分组成员
+func (s *FollowServer) AddGroupMembers(ctx context.Context, in *pb.AddGroupMembersRequest) (*pb.AddGroupMembersResponse, error) {
+	l := logic.NewAddGroupMembersLogic(ctx, s.svcCtx)
+	return l.AddGroupMembers(in)
+}
+
+// 移除分组成员
+func (s *FollowServer) RemoveGroupMembers(ctx context.Context, in *pb.RemoveGroupMembersRequest) (*pb.RemoveGroupMembersResponse, error) {
+	l := logic.NewRemoveGroupMembersLogic(ctx, s.svcCtx)
+	return l.RemoveGroupMembers(in)
+}
+
+// 分组成员列表
+func (s *FollowServer) GroupMembers(ctx context.Context, in *pb.GroupMembersRequest) (*pb.GroupMembersResponse, error) {
+	l := logic.NewGroupMembersLogic(ctx, s.svcCtx)
+	return l.GroupMembers(in)
+}
+
+// 分组的全部成员id，用于分组关注流
+func (s *FollowServer) GroupMemberIds(ctx context.Context, in *pb.GroupMemberIdsRequest) (*pb.GroupMemberIdsResponse, error) {
+	l := logic.NewGroupMemberIdsLogic(ctx, s.svcCtx)
+	return l.GroupMemberIds(in)
+}
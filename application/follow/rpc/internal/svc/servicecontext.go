@@ -4,7 +4,10 @@ import (
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"posta/application/follow/rpc/internal/config"
 	"posta/application/follow/rpc/internal/model"
+	"posta/application/follow/rpc/internal/types"
+	"posta/pkg/cursor"
 	"posta/pkg/orm"
+	"posta/pkg/zsetcache"
 )
 
 type ServiceContext struct {
@@ -12,7 +15,14 @@ type ServiceContext struct {
 	DB               *orm.DB
 	FollowModel      *model.FollowModel
 	FollowCountModel *model.FollowCountModel
+	UserBlockModel   *model.UserBlockModel
+	FollowGroupModel *model.FollowGroupModel
+	GroupMemberModel *model.FollowGroupMemberModel
 	BizRedis         *redis.Redis
+	// FollowCache 关注列表缓存，FansCache 粉丝列表缓存，都按关注时间从新到旧排列
+	FollowCache *zsetcache.Cache
+	FansCache   *zsetcache.Cache
+	CursorCodec *cursor.Codec
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -34,6 +44,20 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		DB:               db,
 		FollowModel:      model.NewFollowModel(db.DB),
 		FollowCountModel: model.NewFollowCountModel(db.DB),
+		UserBlockModel:   model.NewUserBlockModel(db.DB),
+		FollowGroupModel: model.NewFollowGroupModel(db.DB),
+		GroupMemberModel: model.NewFollowGroupMemberModel(db.DB),
 		BizRedis:         rds,
+		FollowCache: zsetcache.New(rds, zsetcache.Conf{
+			Order:   zsetcache.Desc,
+			MaxSize: types.CacheMaxFollowCount,
+			Expire:  types.FollowCacheExpire,
+		}),
+		FansCache: zsetcache.New(rds, zsetcache.Conf{
+			Order:   zsetcache.Desc,
+			MaxSize: types.CacheMaxFansCount,
+			Expire:  types.FollowCacheExpire,
+		}),
+		CursorCodec: cursor.NewCodec(c.CursorSecret),
 	}
 }
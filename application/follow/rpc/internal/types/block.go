@@ -0,0 +1,18 @@
+package types
+
+const (
+	BlockTypeBlock = iota + 1 // 拉黑：对方不能关注我、评论和点赞我的内容，我也看不到对方的内容
+	BlockTypeMute             // 屏蔽：保留关注关系，只是在关注流中看不到对方的内容
+)
+
+const (
+	BlockStatusOn  = iota + 1 // 生效
+	BlockStatusOff            // 已解除
+)
+
+const (
+	// MaxBlockCount 每种类型最多拉黑或屏蔽的人数，BlockedIds会一次性返回全部
+	MaxBlockCount = 5000
+	// BlockedIdsExpire 拉黑屏蔽列表缓存的过期时间
+	BlockedIdsExpire = 3600 * 24
+)
@@ -6,7 +6,12 @@ const (
 )
 
 const (
-	DefaultPageSize     = 20
+	DefaultPageSize = 20
+	// MaxPageSize 每页最多的条数，第一页回源时多查的条数要比它大，否则判断不了是否到底
+	MaxPageSize         = 50
 	CacheMaxFollowCount = 1000 // 缓存最大关注数
 	CacheMaxFansCount   = 1000 // 缓存最大粉丝数
+
+	// FollowCacheExpire 关注列表和粉丝列表缓存的过期时间
+	FollowCacheExpire = 3600 * 24 * 2
 )
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// MaxGroupCount 每个用户最多创建的分组数
+	MaxGroupCount = 20
+	// MaxGroupMemberCount 每个分组最多的成员数，分组关注流会一次性查出全部成员
+	MaxGroupMemberCount = 500
+	// MaxGroupNameLength 分组名称最大长度，按字符计算
+	MaxGroupNameLength = 16
+	// GroupMemberIdsExpire 分组成员id缓存的过期时间
+	GroupMemberIdsExpire = 3600 * 24
+)
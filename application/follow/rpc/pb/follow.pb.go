@@ -199,10 +199,9 @@ func (*UnFollowResponse) Descriptor() ([]byte, []int) {
 
 type FollowListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
-	Cursor        int64                  `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	PageSize      int64                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -237,13 +236,6 @@ func (*FollowListRequest) Descriptor() ([]byte, []int) {
 	return file_follow_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *FollowListRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
 func (x *FollowListRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
@@ -251,18 +243,18 @@ func (x *FollowListRequest) GetUserId() int64 {
 	return 0
 }
 
-func (x *FollowListRequest) GetCursor() int64 {
+func (x *FollowListRequest) GetPageSize() int64 {
 	if x != nil {
-		return x.Cursor
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *FollowListRequest) GetPageSize() int64 {
+func (x *FollowListRequest) GetPageToken() string {
 	if x != nil {
-		return x.PageSize
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
 type FollowItem struct {
@@ -271,6 +263,7 @@ type FollowItem struct {
 	FollowedUserId int64                  `protobuf:"varint,2,opt,name=followedUserId,proto3" json:"followedUserId,omitempty"` // 被关注者
 	FansCount      int64                  `protobuf:"varint,3,opt,name=fansCount,proto3" json:"fansCount,omitempty"`           // 粉丝数
 	CreateTime     int64                  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime,omitempty"`         // 关注时间
+	IsMutual       bool                   `protobuf:"varint,5,opt,name=isMutual,proto3" json:"isMutual,omitempty"`             // 对方是否也关注了我
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -333,12 +326,18 @@ func (x *FollowItem) GetCreateTime() int64 {
 	return 0
 }
 
+func (x *FollowItem) GetIsMutual() bool {
+	if x != nil {
+		return x.IsMutual
+	}
+	return false
+}
+
 type FollowListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*FollowItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Cursor        int64                  `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	IsEnd         bool                   `protobuf:"varint,3,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
-	Id            int64                  `protobuf:"varint,4,opt,name=Id,proto3" json:"Id,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -380,13 +379,6 @@ func (x *FollowListResponse) GetItems() []*FollowItem {
 	return nil
 }
 
-func (x *FollowListResponse) GetCursor() int64 {
-	if x != nil {
-		return x.Cursor
-	}
-	return 0
-}
-
 func (x *FollowListResponse) GetIsEnd() bool {
 	if x != nil {
 		return x.IsEnd
@@ -394,19 +386,18 @@ func (x *FollowListResponse) GetIsEnd() bool {
 	return false
 }
 
-func (x *FollowListResponse) GetId() int64 {
+func (x *FollowListResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Id
+		return x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
 type FansListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Cursor        int64                  `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	PageSize      int64                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
-	Id            int64                  `protobuf:"varint,4,opt,name=Id,proto3" json:"Id,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -448,13 +439,6 @@ func (x *FansListRequest) GetUserId() int64 {
 	return 0
 }
 
-func (x *FansListRequest) GetCursor() int64 {
-	if x != nil {
-		return x.Cursor
-	}
-	return 0
-}
-
 func (x *FansListRequest) GetPageSize() int64 {
 	if x != nil {
 		return x.PageSize
@@ -462,11 +446,11 @@ func (x *FansListRequest) GetPageSize() int64 {
 	return 0
 }
 
-func (x *FansListRequest) GetId() int64 {
+func (x *FansListRequest) GetPageToken() string {
 	if x != nil {
-		return x.Id
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
 type FansItem struct {
@@ -476,6 +460,7 @@ type FansItem struct {
 	FollowCount   int64                  `protobuf:"varint,3,opt,name=followCount,proto3" json:"followCount,omitempty"`
 	FansCount     int64                  `protobuf:"varint,4,opt,name=fansCount,proto3" json:"fansCount,omitempty"`
 	CreateTime    int64                  `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	IsMutual      bool                   `protobuf:"varint,6,opt,name=isMutual,proto3" json:"isMutual,omitempty"` // 我是否也关注了这个粉丝
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -545,12 +530,18 @@ func (x *FansItem) GetCreateTime() int64 {
 	return 0
 }
 
+func (x *FansItem) GetIsMutual() bool {
+	if x != nil {
+		return x.IsMutual
+	}
+	return false
+}
+
 type FansListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*FansItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Cursor        int64                  `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	IsEnd         bool                   `protobuf:"varint,3,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
-	Id            int64                  `protobuf:"varint,4,opt,name=Id,proto3" json:"Id,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -592,84 +583,2073 @@ func (x *FansListResponse) GetItems() []*FansItem {
 	return nil
 }
 
-func (x *FansListResponse) GetCursor() int64 {
+func (x *FansListResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *FansListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type IsFollowingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`                 // 关注者
+	FollowedUserId int64                  `protobuf:"varint,2,opt,name=followedUserId,proto3" json:"followedUserId,omitempty"` // 被关注者
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IsFollowingRequest) Reset() {
+	*x = IsFollowingRequest{}
+	mi := &file_follow_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsFollowingRequest) ProtoMessage() {}
+
+func (x *IsFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsFollowingRequest.ProtoReflect.Descriptor instead.
+func (*IsFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IsFollowingRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Cursor
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *FansListResponse) GetIsEnd() bool {
+func (x *IsFollowingRequest) GetFollowedUserId() int64 {
 	if x != nil {
-		return x.IsEnd
+		return x.FollowedUserId
+	}
+	return 0
+}
+
+type IsFollowingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsFollowing   bool                   `protobuf:"varint,1,opt,name=isFollowing,proto3" json:"isFollowing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsFollowingResponse) Reset() {
+	*x = IsFollowingResponse{}
+	mi := &file_follow_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsFollowingResponse) ProtoMessage() {}
+
+func (x *IsFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsFollowingResponse.ProtoReflect.Descriptor instead.
+func (*IsFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IsFollowingResponse) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
 	}
 	return false
 }
 
-func (x *FansListResponse) GetId() int64 {
+type BlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TargetUserId  int64                  `protobuf:"varint,2,opt,name=targetUserId,proto3" json:"targetUserId,omitempty"`
+	BlockType     int32                  `protobuf:"varint,3,opt,name=blockType,proto3" json:"blockType,omitempty"` // 1拉黑 2屏蔽
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	mi := &file_follow_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[12]
 	if x != nil {
-		return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BlockRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-var File_follow_proto protoreflect.FileDescriptor
+func (x *BlockRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
 
-const file_follow_proto_rawDesc = "" +
-	"\n" +
-	"\ffollow.proto\x12\aservice\"O\n" +
-	"\rFollowRequest\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12&\n" +
-	"\x0efollowedUserId\x18\x02 \x01(\x03R\x0efollowedUserId\"\x10\n" +
-	"\x0eFollowResponse\"Q\n" +
-	"\x0fUnFollowRequest\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12&\n" +
-	"\x0efollowedUserId\x18\x02 \x01(\x03R\x0efollowedUserId\"\x12\n" +
-	"\x10UnFollowResponse\"o\n" +
-	"\x11FollowListRequest\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x16\n" +
-	"\x06userId\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
-	"\x06cursor\x18\x03 \x01(\x03R\x06cursor\x12\x1a\n" +
-	"\bpageSize\x18\x04 \x01(\x03R\bpageSize\"\x82\x01\n" +
-	"\n" +
-	"FollowItem\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12&\n" +
-	"\x0efollowedUserId\x18\x02 \x01(\x03R\x0efollowedUserId\x12\x1c\n" +
-	"\tfansCount\x18\x03 \x01(\x03R\tfansCount\x12\x1e\n" +
-	"\n" +
-	"createTime\x18\x04 \x01(\x03R\n" +
-	"createTime\"}\n" +
-	"\x12FollowListResponse\x12)\n" +
-	"\x05items\x18\x01 \x03(\v2\x13.service.FollowItemR\x05items\x12\x16\n" +
-	"\x06cursor\x18\x02 \x01(\x03R\x06cursor\x12\x14\n" +
-	"\x05isEnd\x18\x03 \x01(\bR\x05isEnd\x12\x0e\n" +
-	"\x02Id\x18\x04 \x01(\x03R\x02Id\"m\n" +
-	"\x0fFansListRequest\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
-	"\x06cursor\x18\x02 \x01(\x03R\x06cursor\x12\x1a\n" +
-	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12\x0e\n" +
-	"\x02Id\x18\x04 \x01(\x03R\x02Id\"\xa2\x01\n" +
-	"\bFansItem\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1e\n" +
-	"\n" +
-	"fansUserId\x18\x02 \x01(\x03R\n" +
-	"fansUserId\x12 \n" +
-	"\vfollowCount\x18\x03 \x01(\x03R\vfollowCount\x12\x1c\n" +
-	"\tfansCount\x18\x04 \x01(\x03R\tfansCount\x12\x1e\n" +
-	"\n" +
-	"createTime\x18\x05 \x01(\x03R\n" +
-	"createTime\"y\n" +
-	"\x10FansListResponse\x12'\n" +
-	"\x05items\x18\x01 \x03(\v2\x11.service.FansItemR\x05items\x12\x16\n" +
-	"\x06cursor\x18\x02 \x01(\x03R\x06cursor\x12\x14\n" +
-	"\x05isEnd\x18\x03 \x01(\bR\x05isEnd\x12\x0e\n" +
-	"\x02Id\x18\x04 \x01(\x03R\x02Id2\x8c\x02\n" +
-	"\x06Follow\x129\n" +
-	"\x06Follow\x12\x16.service.FollowRequest\x1a\x17.service.FollowResponse\x12?\n" +
-	"\bUnFollow\x12\x18.service.UnFollowRequest\x1a\x19.service.UnFollowResponse\x12E\n" +
-	"\n" +
-	"FollowList\x12\x1a.service.FollowListRequest\x1a\x1b.service.FollowListResponse\x12?\n" +
-	"\bFansList\x12\x18.service.FansListRequest\x1a\x19.service.FansListResponseB\x06Z\x04./pbb\x06proto3"
+func (x *BlockRequest) GetBlockType() int32 {
+	if x != nil {
+		return x.BlockType
+	}
+	return 0
+}
+
+type BlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockResponse) Reset() {
+	*x = BlockResponse{}
+	mi := &file_follow_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockResponse) ProtoMessage() {}
+
+func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{13}
+}
+
+type UnBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TargetUserId  int64                  `protobuf:"varint,2,opt,name=targetUserId,proto3" json:"targetUserId,omitempty"`
+	BlockType     int32                  `protobuf:"varint,3,opt,name=blockType,proto3" json:"blockType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnBlockRequest) Reset() {
+	*x = UnBlockRequest{}
+	mi := &file_follow_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnBlockRequest) ProtoMessage() {}
+
+func (x *UnBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnBlockRequest.ProtoReflect.Descriptor instead.
+func (*UnBlockRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UnBlockRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnBlockRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+func (x *UnBlockRequest) GetBlockType() int32 {
+	if x != nil {
+		return x.BlockType
+	}
+	return 0
+}
+
+type UnBlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnBlockResponse) Reset() {
+	*x = UnBlockResponse{}
+	mi := &file_follow_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnBlockResponse) ProtoMessage() {}
+
+func (x *UnBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnBlockResponse.ProtoReflect.Descriptor instead.
+func (*UnBlockResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{15}
+}
+
+type BlockListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	BlockType     int32                  `protobuf:"varint,2,opt,name=blockType,proto3" json:"blockType,omitempty"`
+	PageSize      int64                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockListRequest) Reset() {
+	*x = BlockListRequest{}
+	mi := &file_follow_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockListRequest) ProtoMessage() {}
+
+func (x *BlockListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockListRequest.ProtoReflect.Descriptor instead.
+func (*BlockListRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BlockListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BlockListRequest) GetBlockType() int32 {
+	if x != nil {
+		return x.BlockType
+	}
+	return 0
+}
+
+func (x *BlockListRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *BlockListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type BlockItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	TargetUserId  int64                  `protobuf:"varint,2,opt,name=targetUserId,proto3" json:"targetUserId,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockItem) Reset() {
+	*x = BlockItem{}
+	mi := &file_follow_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockItem) ProtoMessage() {}
+
+func (x *BlockItem) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockItem.ProtoReflect.Descriptor instead.
+func (*BlockItem) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BlockItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BlockItem) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+func (x *BlockItem) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type BlockListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*BlockItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	IsEnd         bool                   `protobuf:"varint,3,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockListResponse) Reset() {
+	*x = BlockListResponse{}
+	mi := &file_follow_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockListResponse) ProtoMessage() {}
+
+func (x *BlockListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockListResponse.ProtoReflect.Descriptor instead.
+func (*BlockListResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BlockListResponse) GetItems() []*BlockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BlockListResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *BlockListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BlockedIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedIdsRequest) Reset() {
+	*x = BlockedIdsRequest{}
+	mi := &file_follow_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedIdsRequest) ProtoMessage() {}
+
+func (x *BlockedIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedIdsRequest.ProtoReflect.Descriptor instead.
+func (*BlockedIdsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BlockedIdsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type BlockedIdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockedIds    []int64                `protobuf:"varint,1,rep,packed,name=blockedIds,proto3" json:"blockedIds,omitempty"` // 我拉黑的用户
+	MutedIds      []int64                `protobuf:"varint,2,rep,packed,name=mutedIds,proto3" json:"mutedIds,omitempty"`     // 我屏蔽的用户
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedIdsResponse) Reset() {
+	*x = BlockedIdsResponse{}
+	mi := &file_follow_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedIdsResponse) ProtoMessage() {}
+
+func (x *BlockedIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedIdsResponse.ProtoReflect.Descriptor instead.
+func (*BlockedIdsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BlockedIdsResponse) GetBlockedIds() []int64 {
+	if x != nil {
+		return x.BlockedIds
+	}
+	return nil
+}
+
+func (x *BlockedIdsResponse) GetMutedIds() []int64 {
+	if x != nil {
+		return x.MutedIds
+	}
+	return nil
+}
+
+type CheckBlockedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`            // 操作者
+	OwnerIds      []int64                `protobuf:"varint,2,rep,packed,name=ownerIds,proto3" json:"ownerIds,omitempty"` // 内容的作者、被回复的人等
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBlockedRequest) Reset() {
+	*x = CheckBlockedRequest{}
+	mi := &file_follow_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBlockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBlockedRequest) ProtoMessage() {}
+
+func (x *CheckBlockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBlockedRequest.ProtoReflect.Descriptor instead.
+func (*CheckBlockedRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckBlockedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckBlockedRequest) GetOwnerIds() []int64 {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+type CheckBlockedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockerIds    []int64                `protobuf:"varint,1,rep,packed,name=blockerIds,proto3" json:"blockerIds,omitempty"` // ownerIds中拉黑了userId的用户，为空表示没有被拉黑
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBlockedResponse) Reset() {
+	*x = CheckBlockedResponse{}
+	mi := &file_follow_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBlockedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBlockedResponse) ProtoMessage() {}
+
+func (x *CheckBlockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBlockedResponse.ProtoReflect.Descriptor instead.
+func (*CheckBlockedResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckBlockedResponse) GetBlockerIds() []int64 {
+	if x != nil {
+		return x.BlockerIds
+	}
+	return nil
+}
+
+type RelationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TargetIds     []int64                `protobuf:"varint,2,rep,packed,name=targetIds,proto3" json:"targetIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationsRequest) Reset() {
+	*x = RelationsRequest{}
+	mi := &file_follow_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationsRequest) ProtoMessage() {}
+
+func (x *RelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationsRequest.ProtoReflect.Descriptor instead.
+func (*RelationsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RelationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RelationsRequest) GetTargetIds() []int64 {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+type RelationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      int64                  `protobuf:"varint,1,opt,name=targetId,proto3" json:"targetId,omitempty"`
+	IsFollowing   bool                   `protobuf:"varint,2,opt,name=isFollowing,proto3" json:"isFollowing,omitempty"`   // 我关注了对方
+	IsFollowedBy  bool                   `protobuf:"varint,3,opt,name=isFollowedBy,proto3" json:"isFollowedBy,omitempty"` // 对方关注了我
+	IsMutual      bool                   `protobuf:"varint,4,opt,name=isMutual,proto3" json:"isMutual,omitempty"`         // 互相关注
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationItem) Reset() {
+	*x = RelationItem{}
+	mi := &file_follow_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationItem) ProtoMessage() {}
+
+func (x *RelationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationItem.ProtoReflect.Descriptor instead.
+func (*RelationItem) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RelationItem) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *RelationItem) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+func (x *RelationItem) GetIsFollowedBy() bool {
+	if x != nil {
+		return x.IsFollowedBy
+	}
+	return false
+}
+
+func (x *RelationItem) GetIsMutual() bool {
+	if x != nil {
+		return x.IsMutual
+	}
+	return false
+}
+
+type RelationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RelationItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // 和targetIds顺序一致
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationsResponse) Reset() {
+	*x = RelationsResponse{}
+	mi := &file_follow_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationsResponse) ProtoMessage() {}
+
+func (x *RelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationsResponse.ProtoReflect.Descriptor instead.
+func (*RelationsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RelationsResponse) GetItems() []*RelationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type FriendsListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	PageSize      int64                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendsListRequest) Reset() {
+	*x = FriendsListRequest{}
+	mi := &file_follow_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendsListRequest) ProtoMessage() {}
+
+func (x *FriendsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendsListRequest.ProtoReflect.Descriptor instead.
+func (*FriendsListRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FriendsListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FriendsListRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FriendsListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type FriendsListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*FollowItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	IsEnd         bool                   `protobuf:"varint,3,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendsListResponse) Reset() {
+	*x = FriendsListResponse{}
+	mi := &file_follow_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendsListResponse) ProtoMessage() {}
+
+func (x *FriendsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendsListResponse.ProtoReflect.Descriptor instead.
+func (*FriendsListResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FriendsListResponse) GetItems() []*FollowItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *FriendsListResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *FriendsListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_follow_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateGroupRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupResponse) Reset() {
+	*x = CreateGroupResponse{}
+	mi := &file_follow_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponse) ProtoMessage() {}
+
+func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateGroupResponse) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type UpdateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	GroupId       int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_follow_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateGroupRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *UpdateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupResponse) Reset() {
+	*x = UpdateGroupResponse{}
+	mi := &file_follow_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupResponse) ProtoMessage() {}
+
+func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{31}
+}
+
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	GroupId       int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_follow_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteGroupRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type DeleteGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupResponse) Reset() {
+	*x = DeleteGroupResponse{}
+	mi := &file_follow_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupResponse) ProtoMessage() {}
+
+func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{33}
+}
+
+type GroupListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupListRequest) Reset() {
+	*x = GroupListRequest{}
+	mi := &file_follow_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupListRequest) ProtoMessage() {}
+
+func (x *GroupListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupListRequest.ProtoReflect.Descriptor instead.
+func (*GroupListRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GroupListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GroupItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MemberCount   int64                  `protobuf:"varint,3,opt,name=memberCount,proto3" json:"memberCount,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupItem) Reset() {
+	*x = GroupItem{}
+	mi := &file_follow_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupItem) ProtoMessage() {}
+
+func (x *GroupItem) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupItem.ProtoReflect.Descriptor instead.
+func (*GroupItem) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GroupItem) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupItem) GetMemberCount() int64 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *GroupItem) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type GroupListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*GroupItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupListResponse) Reset() {
+	*x = GroupListResponse{}
+	mi := &file_follow_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupListResponse) ProtoMessage() {}
+
+func (x *GroupListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupListResponse.ProtoReflect.Descriptor instead.
+func (*GroupListResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GroupListResponse) GetItems() []*GroupItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AddGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	GroupId       int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	MemberUserIds []int64                `protobuf:"varint,3,rep,packed,name=memberUserIds,proto3" json:"memberUserIds,omitempty"` // 只能添加已关注的用户
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupMembersRequest) Reset() {
+	*x = AddGroupMembersRequest{}
+	mi := &file_follow_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMembersRequest) ProtoMessage() {}
+
+func (x *AddGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AddGroupMembersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddGroupMembersRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AddGroupMembersRequest) GetMemberUserIds() []int64 {
+	if x != nil {
+		return x.MemberUserIds
+	}
+	return nil
+}
+
+type AddGroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupMembersResponse) Reset() {
+	*x = AddGroupMembersResponse{}
+	mi := &file_follow_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMembersResponse) ProtoMessage() {}
+
+func (x *AddGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*AddGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{38}
+}
+
+type RemoveGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	GroupId       int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	MemberUserIds []int64                `protobuf:"varint,3,rep,packed,name=memberUserIds,proto3" json:"memberUserIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMembersRequest) Reset() {
+	*x = RemoveGroupMembersRequest{}
+	mi := &file_follow_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMembersRequest) ProtoMessage() {}
+
+func (x *RemoveGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RemoveGroupMembersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveGroupMembersRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *RemoveGroupMembersRequest) GetMemberUserIds() []int64 {
+	if x != nil {
+		return x.MemberUserIds
+	}
+	return nil
+}
+
+type RemoveGroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMembersResponse) Reset() {
+	*x = RemoveGroupMembersResponse{}
+	mi := &file_follow_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMembersResponse) ProtoMessage() {}
+
+func (x *RemoveGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{40}
+}
+
+type GroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	GroupId       int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	PageSize      int64                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMembersRequest) Reset() {
+	*x = GroupMembersRequest{}
+	mi := &file_follow_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMembersRequest) ProtoMessage() {}
+
+func (x *GroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*GroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GroupMembersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GroupMembersRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupMembersRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GroupMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GroupMemberItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	MemberUserId  int64                  `protobuf:"varint,2,opt,name=memberUserId,proto3" json:"memberUserId,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime,omitempty"` // 加入分组时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMemberItem) Reset() {
+	*x = GroupMemberItem{}
+	mi := &file_follow_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMemberItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberItem) ProtoMessage() {}
+
+func (x *GroupMemberItem) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberItem.ProtoReflect.Descriptor instead.
+func (*GroupMemberItem) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GroupMemberItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GroupMemberItem) GetMemberUserId() int64 {
+	if x != nil {
+		return x.MemberUserId
+	}
+	return 0
+}
+
+func (x *GroupMemberItem) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type GroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*GroupMemberItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	IsEnd         bool                   `protobuf:"varint,3,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMembersResponse) Reset() {
+	*x = GroupMembersResponse{}
+	mi := &file_follow_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMembersResponse) ProtoMessage() {}
+
+func (x *GroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*GroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GroupMembersResponse) GetItems() []*GroupMemberItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GroupMembersResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *GroupMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GroupMemberIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	GroupId       int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMemberIdsRequest) Reset() {
+	*x = GroupMemberIdsRequest{}
+	mi := &file_follow_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMemberIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberIdsRequest) ProtoMessage() {}
+
+func (x *GroupMemberIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberIdsRequest.ProtoReflect.Descriptor instead.
+func (*GroupMemberIdsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GroupMemberIdsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GroupMemberIdsRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type GroupMemberIdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberUserIds []int64                `protobuf:"varint,1,rep,packed,name=memberUserIds,proto3" json:"memberUserIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMemberIdsResponse) Reset() {
+	*x = GroupMemberIdsResponse{}
+	mi := &file_follow_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMemberIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberIdsResponse) ProtoMessage() {}
+
+func (x *GroupMemberIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberIdsResponse.ProtoReflect.Descriptor instead.
+func (*GroupMemberIdsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GroupMemberIdsResponse) GetMemberUserIds() []int64 {
+	if x != nil {
+		return x.MemberUserIds
+	}
+	return nil
+}
+
+var File_follow_proto protoreflect.FileDescriptor
+
+const file_follow_proto_rawDesc = "" +
+	"\n" +
+	"\ffollow.proto\x12\aservice\"O\n" +
+	"\rFollowRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x0efollowedUserId\x18\x02 \x01(\x03R\x0efollowedUserId\"\x10\n" +
+	"\x0eFollowResponse\"Q\n" +
+	"\x0fUnFollowRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x0efollowedUserId\x18\x02 \x01(\x03R\x0efollowedUserId\"\x12\n" +
+	"\x10UnFollowResponse\"}\n" +
+	"\x11FollowListRequest\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x05 \x01(\tR\tpageTokenJ\x04\b\x01\x10\x02J\x04\b\x03\x10\x04R\x02IdR\x06cursor\"\x9e\x01\n" +
+	"\n" +
+	"FollowItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12&\n" +
+	"\x0efollowedUserId\x18\x02 \x01(\x03R\x0efollowedUserId\x12\x1c\n" +
+	"\tfansCount\x18\x03 \x01(\x03R\tfansCount\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x04 \x01(\x03R\n" +
+	"createTime\x12\x1a\n" +
+	"\bisMutual\x18\x05 \x01(\bR\bisMutual\"\x93\x01\n" +
+	"\x12FollowListResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.service.FollowItemR\x05items\x12\x14\n" +
+	"\x05isEnd\x18\x03 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x05 \x01(\tR\rnextPageTokenJ\x04\b\x02\x10\x03J\x04\b\x04\x10\x05R\x06cursorR\x02Id\"{\n" +
+	"\x0fFansListRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x05 \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03J\x04\b\x04\x10\x05R\x06cursorR\x02Id\"\xbe\x01\n" +
+	"\bFansItem\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1e\n" +
+	"\n" +
+	"fansUserId\x18\x02 \x01(\x03R\n" +
+	"fansUserId\x12 \n" +
+	"\vfollowCount\x18\x03 \x01(\x03R\vfollowCount\x12\x1c\n" +
+	"\tfansCount\x18\x04 \x01(\x03R\tfansCount\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x05 \x01(\x03R\n" +
+	"createTime\x12\x1a\n" +
+	"\bisMutual\x18\x06 \x01(\bR\bisMutual\"\x8f\x01\n" +
+	"\x10FansListResponse\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.service.FansItemR\x05items\x12\x14\n" +
+	"\x05isEnd\x18\x03 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x05 \x01(\tR\rnextPageTokenJ\x04\b\x02\x10\x03J\x04\b\x04\x10\x05R\x06cursorR\x02Id\"T\n" +
+	"\x12IsFollowingRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x0efollowedUserId\x18\x02 \x01(\x03R\x0efollowedUserId\"7\n" +
+	"\x13IsFollowingResponse\x12 \n" +
+	"\visFollowing\x18\x01 \x01(\bR\visFollowing\"h\n" +
+	"\fBlockRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\"\n" +
+	"\ftargetUserId\x18\x02 \x01(\x03R\ftargetUserId\x12\x1c\n" +
+	"\tblockType\x18\x03 \x01(\x05R\tblockType\"\x0f\n" +
+	"\rBlockResponse\"j\n" +
+	"\x0eUnBlockRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\"\n" +
+	"\ftargetUserId\x18\x02 \x01(\x03R\ftargetUserId\x12\x1c\n" +
+	"\tblockType\x18\x03 \x01(\x05R\tblockType\"\x11\n" +
+	"\x0fUnBlockResponse\"\x90\x01\n" +
+	"\x10BlockListRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1c\n" +
+	"\tblockType\x18\x02 \x01(\x05R\tblockType\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x05 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04R\x06cursor\"_\n" +
+	"\tBlockItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\"\n" +
+	"\ftargetUserId\x18\x02 \x01(\x03R\ftargetUserId\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x03 \x01(\x03R\n" +
+	"createTime\"\x87\x01\n" +
+	"\x11BlockListResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.service.BlockItemR\x05items\x12\x14\n" +
+	"\x05isEnd\x18\x03 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x04 \x01(\tR\rnextPageTokenJ\x04\b\x02\x10\x03R\x06cursor\"+\n" +
+	"\x11BlockedIdsRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\"P\n" +
+	"\x12BlockedIdsResponse\x12\x1e\n" +
+	"\n" +
+	"blockedIds\x18\x01 \x03(\x03R\n" +
+	"blockedIds\x12\x1a\n" +
+	"\bmutedIds\x18\x02 \x03(\x03R\bmutedIds\"I\n" +
+	"\x13CheckBlockedRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bownerIds\x18\x02 \x03(\x03R\bownerIds\"6\n" +
+	"\x14CheckBlockedResponse\x12\x1e\n" +
+	"\n" +
+	"blockerIds\x18\x01 \x03(\x03R\n" +
+	"blockerIds\"H\n" +
+	"\x10RelationsRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1c\n" +
+	"\ttargetIds\x18\x02 \x03(\x03R\ttargetIds\"\x8c\x01\n" +
+	"\fRelationItem\x12\x1a\n" +
+	"\btargetId\x18\x01 \x01(\x03R\btargetId\x12 \n" +
+	"\visFollowing\x18\x02 \x01(\bR\visFollowing\x12\"\n" +
+	"\fisFollowedBy\x18\x03 \x01(\bR\fisFollowedBy\x12\x1a\n" +
+	"\bisMutual\x18\x04 \x01(\bR\bisMutual\"@\n" +
+	"\x11RelationsResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.service.RelationItemR\x05items\"t\n" +
+	"\x12FriendsListRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x04 \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03R\x06cursor\"\x8a\x01\n" +
+	"\x13FriendsListResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.service.FollowItemR\x05items\x12\x14\n" +
+	"\x05isEnd\x18\x03 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x04 \x01(\tR\rnextPageTokenJ\x04\b\x02\x10\x03R\x06cursor\"@\n" +
+	"\x12CreateGroupRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"/\n" +
+	"\x13CreateGroupResponse\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\x03R\agroupId\"Z\n" +
+	"\x12UpdateGroupRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x15\n" +
+	"\x13UpdateGroupResponse\"F\n" +
+	"\x12DeleteGroupRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\"\x15\n" +
+	"\x13DeleteGroupResponse\"*\n" +
+	"\x10GroupListRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\"{\n" +
+	"\tGroupItem\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\x03R\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vmemberCount\x18\x03 \x01(\x03R\vmemberCount\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x04 \x01(\x03R\n" +
+	"createTime\"=\n" +
+	"\x11GroupListResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.service.GroupItemR\x05items\"p\n" +
+	"\x16AddGroupMembersRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12$\n" +
+	"\rmemberUserIds\x18\x03 \x03(\x03R\rmemberUserIds\"\x19\n" +
+	"\x17AddGroupMembersResponse\"s\n" +
+	"\x19RemoveGroupMembersRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12$\n" +
+	"\rmemberUserIds\x18\x03 \x03(\x03R\rmemberUserIds\"\x1c\n" +
+	"\x1aRemoveGroupMembersResponse\"\x8f\x01\n" +
+	"\x13GroupMembersRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x05 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04R\x06cursor\"e\n" +
+	"\x0fGroupMemberItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\"\n" +
+	"\fmemberUserId\x18\x02 \x01(\x03R\fmemberUserId\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x03 \x01(\x03R\n" +
+	"createTime\"\x90\x01\n" +
+	"\x14GroupMembersResponse\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.service.GroupMemberItemR\x05items\x12\x14\n" +
+	"\x05isEnd\x18\x03 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x04 \x01(\tR\rnextPageTokenJ\x04\b\x02\x10\x03R\x06cursor\"I\n" +
+	"\x15GroupMemberIdsRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\">\n" +
+	"\x16GroupMemberIdsResponse\x12$\n" +
+	"\rmemberUserIds\x18\x01 \x03(\x03R\rmemberUserIds2\xa9\v\n" +
+	"\x06Follow\x129\n" +
+	"\x06Follow\x12\x16.service.FollowRequest\x1a\x17.service.FollowResponse\x12?\n" +
+	"\bUnFollow\x12\x18.service.UnFollowRequest\x1a\x19.service.UnFollowResponse\x12E\n" +
+	"\n" +
+	"FollowList\x12\x1a.service.FollowListRequest\x1a\x1b.service.FollowListResponse\x12?\n" +
+	"\bFansList\x12\x18.service.FansListRequest\x1a\x19.service.FansListResponse\x12H\n" +
+	"\vIsFollowing\x12\x1b.service.IsFollowingRequest\x1a\x1c.service.IsFollowingResponse\x126\n" +
+	"\x05Block\x12\x15.service.BlockRequest\x1a\x16.service.BlockResponse\x12<\n" +
+	"\aUnBlock\x12\x17.service.UnBlockRequest\x1a\x18.service.UnBlockResponse\x12B\n" +
+	"\tBlockList\x12\x19.service.BlockListRequest\x1a\x1a.service.BlockListResponse\x12E\n" +
+	"\n" +
+	"BlockedIds\x12\x1a.service.BlockedIdsRequest\x1a\x1b.service.BlockedIdsResponse\x12K\n" +
+	"\fCheckBlocked\x12\x1c.service.CheckBlockedRequest\x1a\x1d.service.CheckBlockedResponse\x12B\n" +
+	"\tRelations\x12\x19.service.RelationsRequest\x1a\x1a.service.RelationsResponse\x12H\n" +
+	"\vFriendsList\x12\x1b.service.FriendsListRequest\x1a\x1c.service.FriendsListResponse\x12H\n" +
+	"\vCreateGroup\x12\x1b.service.CreateGroupRequest\x1a\x1c.service.CreateGroupResponse\x12H\n" +
+	"\vUpdateGroup\x12\x1b.service.UpdateGroupRequest\x1a\x1c.service.UpdateGroupResponse\x12H\n" +
+	"\vDeleteGroup\x12\x1b.service.DeleteGroupRequest\x1a\x1c.service.DeleteGroupResponse\x12B\n" +
+	"\tGroupList\x12\x19.service.GroupListRequest\x1a\x1a.service.GroupListResponse\x12T\n" +
+	"\x0fAddGroupMembers\x12\x1f.service.AddGroupMembersRequest\x1a .service.AddGroupMembersResponse\x12]\n" +
+	"\x12RemoveGroupMembers\x12\".service.RemoveGroupMembersRequest\x1a#.service.RemoveGroupMembersResponse\x12K\n" +
+	"\fGroupMembers\x12\x1c.service.GroupMembersRequest\x1a\x1d.service.GroupMembersResponse\x12Q\n" +
+	"\x0eGroupMemberIds\x12\x1e.service.GroupMemberIdsRequest\x1a\x1f.service.GroupMemberIdsResponseB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_follow_proto_rawDescOnce sync.Once
@@ -683,35 +2663,108 @@ func file_follow_proto_rawDescGZIP() []byte {
 	return file_follow_proto_rawDescData
 }
 
-var file_follow_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_follow_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_follow_proto_goTypes = []any{
-	(*FollowRequest)(nil),      // 0: service.FollowRequest
-	(*FollowResponse)(nil),     // 1: service.FollowResponse
-	(*UnFollowRequest)(nil),    // 2: service.UnFollowRequest
-	(*UnFollowResponse)(nil),   // 3: service.UnFollowResponse
-	(*FollowListRequest)(nil),  // 4: service.FollowListRequest
-	(*FollowItem)(nil),         // 5: service.FollowItem
-	(*FollowListResponse)(nil), // 6: service.FollowListResponse
-	(*FansListRequest)(nil),    // 7: service.FansListRequest
-	(*FansItem)(nil),           // 8: service.FansItem
-	(*FansListResponse)(nil),   // 9: service.FansListResponse
+	(*FollowRequest)(nil),              // 0: service.FollowRequest
+	(*FollowResponse)(nil),             // 1: service.FollowResponse
+	(*UnFollowRequest)(nil),            // 2: service.UnFollowRequest
+	(*UnFollowResponse)(nil),           // 3: service.UnFollowResponse
+	(*FollowListRequest)(nil),          // 4: service.FollowListRequest
+	(*FollowItem)(nil),                 // 5: service.FollowItem
+	(*FollowListResponse)(nil),         // 6: service.FollowListResponse
+	(*FansListRequest)(nil),            // 7: service.FansListRequest
+	(*FansItem)(nil),                   // 8: service.FansItem
+	(*FansListResponse)(nil),           // 9: service.FansListResponse
+	(*IsFollowingRequest)(nil),         // 10: service.IsFollowingRequest
+	(*IsFollowingResponse)(nil),        // 11: service.IsFollowingResponse
+	(*BlockRequest)(nil),               // 12: service.BlockRequest
+	(*BlockResponse)(nil),              // 13: service.BlockResponse
+	(*UnBlockRequest)(nil),             // 14: service.UnBlockRequest
+	(*UnBlockResponse)(nil),            // 15: service.UnBlockResponse
+	(*BlockListRequest)(nil),           // 16: service.BlockListRequest
+	(*BlockItem)(nil),                  // 17: service.BlockItem
+	(*BlockListResponse)(nil),          // 18: service.BlockListResponse
+	(*BlockedIdsRequest)(nil),          // 19: service.BlockedIdsRequest
+	(*BlockedIdsResponse)(nil),         // 20: service.BlockedIdsResponse
+	(*CheckBlockedRequest)(nil),        // 21: service.CheckBlockedRequest
+	(*CheckBlockedResponse)(nil),       // 22: service.CheckBlockedResponse
+	(*RelationsRequest)(nil),           // 23: service.RelationsRequest
+	(*RelationItem)(nil),               // 24: service.RelationItem
+	(*RelationsResponse)(nil),          // 25: service.RelationsResponse
+	(*FriendsListRequest)(nil),         // 26: service.FriendsListRequest
+	(*FriendsListResponse)(nil),        // 27: service.FriendsListResponse
+	(*CreateGroupRequest)(nil),         // 28: service.CreateGroupRequest
+	(*CreateGroupResponse)(nil),        // 29: service.CreateGroupResponse
+	(*UpdateGroupRequest)(nil),         // 30: service.UpdateGroupRequest
+	(*UpdateGroupResponse)(nil),        // 31: service.UpdateGroupResponse
+	(*DeleteGroupRequest)(nil),         // 32: service.DeleteGroupRequest
+	(*DeleteGroupResponse)(nil),        // 33: service.DeleteGroupResponse
+	(*GroupListRequest)(nil),           // 34: service.GroupListRequest
+	(*GroupItem)(nil),                  // 35: service.GroupItem
+	(*GroupListResponse)(nil),          // 36: service.GroupListResponse
+	(*AddGroupMembersRequest)(nil),     // 37: service.AddGroupMembersRequest
+	(*AddGroupMembersResponse)(nil),    // 38: service.AddGroupMembersResponse
+	(*RemoveGroupMembersRequest)(nil),  // 39: service.RemoveGroupMembersRequest
+	(*RemoveGroupMembersResponse)(nil), // 40: service.RemoveGroupMembersResponse
+	(*GroupMembersRequest)(nil),        // 41: service.GroupMembersRequest
+	(*GroupMemberItem)(nil),            // 42: service.GroupMemberItem
+	(*GroupMembersResponse)(nil),       // 43: service.GroupMembersResponse
+	(*GroupMemberIdsRequest)(nil),      // 44: service.GroupMemberIdsRequest
+	(*GroupMemberIdsResponse)(nil),     // 45: service.GroupMemberIdsResponse
 }
 var file_follow_proto_depIdxs = []int32{
-	5, // 0: service.FollowListResponse.items:type_name -> service.FollowItem
-	8, // 1: service.FansListResponse.items:type_name -> service.FansItem
-	0, // 2: service.Follow.Follow:input_type -> service.FollowRequest
-	2, // 3: service.Follow.UnFollow:input_type -> service.UnFollowRequest
-	4, // 4: service.Follow.FollowList:input_type -> service.FollowListRequest
-	7, // 5: service.Follow.FansList:input_type -> service.FansListRequest
-	1, // 6: service.Follow.Follow:output_type -> service.FollowResponse
-	3, // 7: service.Follow.UnFollow:output_type -> service.UnFollowResponse
-	6, // 8: service.Follow.FollowList:output_type -> service.FollowListResponse
-	9, // 9: service.Follow.FansList:output_type -> service.FansListResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5,  // 0: service.FollowListResponse.items:type_name -> service.FollowItem
+	8,  // 1: service.FansListResponse.items:type_name -> service.FansItem
+	17, // 2: service.BlockListResponse.items:type_name -> service.BlockItem
+	24, // 3: service.RelationsResponse.items:type_name -> service.RelationItem
+	5,  // 4: service.FriendsListResponse.items:type_name -> service.FollowItem
+	35, // 5: service.GroupListResponse.items:type_name -> service.GroupItem
+	42, // 6: service.GroupMembersResponse.items:type_name -> service.GroupMemberItem
+	0,  // 7: service.Follow.Follow:input_type -> service.FollowRequest
+	2,  // 8: service.Follow.UnFollow:input_type -> service.UnFollowRequest
+	4,  // 9: service.Follow.FollowList:input_type -> service.FollowListRequest
+	7,  // 10: service.Follow.FansList:input_type -> service.FansListRequest
+	10, // 11: service.Follow.IsFollowing:input_type -> service.IsFollowingRequest
+	12, // 12: service.Follow.Block:input_type -> service.BlockRequest
+	14, // 13: service.Follow.UnBlock:input_type -> service.UnBlockRequest
+	16, // 14: service.Follow.BlockList:input_type -> service.BlockListRequest
+	19, // 15: service.Follow.BlockedIds:input_type -> service.BlockedIdsRequest
+	21, // 16: service.Follow.CheckBlocked:input_type -> service.CheckBlockedRequest
+	23, // 17: service.Follow.Relations:input_type -> service.RelationsRequest
+	26, // 18: service.Follow.FriendsList:input_type -> service.FriendsListRequest
+	28, // 19: service.Follow.CreateGroup:input_type -> service.CreateGroupRequest
+	30, // 20: service.Follow.UpdateGroup:input_type -> service.UpdateGroupRequest
+	32, // 21: service.Follow.DeleteGroup:input_type -> service.DeleteGroupRequest
+	34, // 22: service.Follow.GroupList:input_type -> service.GroupListRequest
+	37, // 23: service.Follow.AddGroupMembers:input_type -> service.AddGroupMembersRequest
+	39, // 24: service.Follow.RemoveGroupMembers:input_type -> service.RemoveGroupMembersRequest
+	41, // 25: service.Follow.GroupMembers:input_type -> service.GroupMembersRequest
+	44, // 26: service.Follow.GroupMemberIds:input_type -> service.GroupMemberIdsRequest
+	1,  // 27: service.Follow.Follow:output_type -> service.FollowResponse
+	3,  // 28: service.Follow.UnFollow:output_type -> service.UnFollowResponse
+	6,  // 29: service.Follow.FollowList:output_type -> service.FollowListResponse
+	9,  // 30: service.Follow.FansList:output_type -> service.FansListResponse
+	11, // 31: service.Follow.IsFollowing:output_type -> service.IsFollowingResponse
+	13, // 32: service.Follow.Block:output_type -> service.BlockResponse
+	15, // 33: service.Follow.UnBlock:output_type -> service.UnBlockResponse
+	18, // 34: service.Follow.BlockList:output_type -> service.BlockListResponse
+	20, // 35: service.Follow.BlockedIds:output_type -> service.BlockedIdsResponse
+	22, // 36: service.Follow.CheckBlocked:output_type -> service.CheckBlockedResponse
+	25, // 37: service.Follow.Relations:output_type -> service.RelationsResponse
+	27, // 38: service.Follow.FriendsList:output_type -> service.FriendsListResponse
+	29, // 39: service.Follow.CreateGroup:output_type -> service.CreateGroupResponse
+	31, // 40: service.Follow.UpdateGroup:output_type -> service.UpdateGroupResponse
+	33, // 41: service.Follow.DeleteGroup:output_type -> service.DeleteGroupResponse
+	36, // 42: service.Follow.GroupList:output_type -> service.GroupListResponse
+	38, // 43: service.Follow.AddGroupMembers:output_type -> service.AddGroupMembersResponse
+	40, // 44: service.Follow.RemoveGroupMembers:output_type -> service.RemoveGroupMembersResponse
+	43, // 45: service.Follow.GroupMembers:output_type -> service.GroupMembersResponse
+	45, // 46: service.Follow.GroupMemberIds:output_type -> service.GroupMemberIdsResponse
+	27, // [27:47] is the sub-list for method output_type
+	7,  // [7:27] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_follow_proto_init() }
@@ -725,7 +2778,7 @@ func file_follow_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_follow_proto_rawDesc), len(file_follow_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
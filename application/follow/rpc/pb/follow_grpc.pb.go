@@ -19,10 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Follow_Follow_FullMethodName     = "/service.Follow/Follow"
-	Follow_UnFollow_FullMethodName   = "/service.Follow/UnFollow"
-	Follow_FollowList_FullMethodName = "/service.Follow/FollowList"
-	Follow_FansList_FullMethodName   = "/service.Follow/FansList"
+	Follow_Follow_FullMethodName             = "/service.Follow/Follow"
+	Follow_UnFollow_FullMethodName           = "/service.Follow/UnFollow"
+	Follow_FollowList_FullMethodName         = "/service.Follow/FollowList"
+	Follow_FansList_FullMethodName           = "/service.Follow/FansList"
+	Follow_IsFollowing_FullMethodName        = "/service.Follow/IsFollowing"
+	Follow_Block_FullMethodName              = "/service.Follow/Block"
+	Follow_UnBlock_FullMethodName            = "/service.Follow/UnBlock"
+	Follow_BlockList_FullMethodName          = "/service.Follow/BlockList"
+	Follow_BlockedIds_FullMethodName         = "/service.Follow/BlockedIds"
+	Follow_CheckBlocked_FullMethodName       = "/service.Follow/CheckBlocked"
+	Follow_Relations_FullMethodName          = "/service.Follow/Relations"
+	Follow_FriendsList_FullMethodName        = "/service.Follow/FriendsList"
+	Follow_CreateGroup_FullMethodName        = "/service.Follow/CreateGroup"
+	Follow_UpdateGroup_FullMethodName        = "/service.Follow/UpdateGroup"
+	Follow_DeleteGroup_FullMethodName        = "/service.Follow/DeleteGroup"
+	Follow_GroupList_FullMethodName          = "/service.Follow/GroupList"
+	Follow_AddGroupMembers_FullMethodName    = "/service.Follow/AddGroupMembers"
+	Follow_RemoveGroupMembers_FullMethodName = "/service.Follow/RemoveGroupMembers"
+	Follow_GroupMembers_FullMethodName       = "/service.Follow/GroupMembers"
+	Follow_GroupMemberIds_FullMethodName     = "/service.Follow/GroupMemberIds"
 )
 
 // FollowClient is the client API for Follow service.
@@ -37,6 +53,38 @@ type FollowClient interface {
 	FollowList(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error)
 	// 粉丝列表
 	FansList(ctx context.Context, in *FansListRequest, opts ...grpc.CallOption) (*FansListResponse, error)
+	// 是否关注了某个用户
+	IsFollowing(ctx context.Context, in *IsFollowingRequest, opts ...grpc.CallOption) (*IsFollowingResponse, error)
+	// 拉黑或屏蔽
+	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// 解除拉黑或屏蔽
+	UnBlock(ctx context.Context, in *UnBlockRequest, opts ...grpc.CallOption) (*UnBlockResponse, error)
+	// 拉黑或屏蔽列表
+	BlockList(ctx context.Context, in *BlockListRequest, opts ...grpc.CallOption) (*BlockListResponse, error)
+	// 我拉黑和屏蔽的全部用户，用于过滤内容
+	BlockedIds(ctx context.Context, in *BlockedIdsRequest, opts ...grpc.CallOption) (*BlockedIdsResponse, error)
+	// 一批用户中哪些人拉黑了我，用于校验评论、点赞等操作
+	CheckBlocked(ctx context.Context, in *CheckBlockedRequest, opts ...grpc.CallOption) (*CheckBlockedResponse, error)
+	// 批量查询我和一批用户之间的关注关系
+	Relations(ctx context.Context, in *RelationsRequest, opts ...grpc.CallOption) (*RelationsResponse, error)
+	// 好友（互相关注）列表
+	FriendsList(ctx context.Context, in *FriendsListRequest, opts ...grpc.CallOption) (*FriendsListResponse, error)
+	// 创建关注分组
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	// 修改关注分组名称
+	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error)
+	// 删除关注分组
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	// 关注分组列表
+	GroupList(ctx context.Context, in *GroupListRequest, opts ...grpc.CallOption) (*GroupListResponse, error)
+	// 添加分组成员
+	AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error)
+	// 移除分组成员
+	RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error)
+	// 分组成员列表
+	GroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersResponse, error)
+	// 分组的全部成员id，用于分组关注流
+	GroupMemberIds(ctx context.Context, in *GroupMemberIdsRequest, opts ...grpc.CallOption) (*GroupMemberIdsResponse, error)
 }
 
 type followClient struct {
@@ -87,6 +135,166 @@ func (c *followClient) FansList(ctx context.Context, in *FansListRequest, opts .
 	return out, nil
 }
 
+func (c *followClient) IsFollowing(ctx context.Context, in *IsFollowingRequest, opts ...grpc.CallOption) (*IsFollowingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsFollowingResponse)
+	err := c.cc.Invoke(ctx, Follow_IsFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, Follow_Block_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) UnBlock(ctx context.Context, in *UnBlockRequest, opts ...grpc.CallOption) (*UnBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnBlockResponse)
+	err := c.cc.Invoke(ctx, Follow_UnBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) BlockList(ctx context.Context, in *BlockListRequest, opts ...grpc.CallOption) (*BlockListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockListResponse)
+	err := c.cc.Invoke(ctx, Follow_BlockList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) BlockedIds(ctx context.Context, in *BlockedIdsRequest, opts ...grpc.CallOption) (*BlockedIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockedIdsResponse)
+	err := c.cc.Invoke(ctx, Follow_BlockedIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) CheckBlocked(ctx context.Context, in *CheckBlockedRequest, opts ...grpc.CallOption) (*CheckBlockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckBlockedResponse)
+	err := c.cc.Invoke(ctx, Follow_CheckBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) Relations(ctx context.Context, in *RelationsRequest, opts ...grpc.CallOption) (*RelationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RelationsResponse)
+	err := c.cc.Invoke(ctx, Follow_Relations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) FriendsList(ctx context.Context, in *FriendsListRequest, opts ...grpc.CallOption) (*FriendsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendsListResponse)
+	err := c.cc.Invoke(ctx, Follow_FriendsList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupResponse)
+	err := c.cc.Invoke(ctx, Follow_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGroupResponse)
+	err := c.cc.Invoke(ctx, Follow_UpdateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupResponse)
+	err := c.cc.Invoke(ctx, Follow_DeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) GroupList(ctx context.Context, in *GroupListRequest, opts ...grpc.CallOption) (*GroupListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupListResponse)
+	err := c.cc.Invoke(ctx, Follow_GroupList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGroupMembersResponse)
+	err := c.cc.Invoke(ctx, Follow_AddGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGroupMembersResponse)
+	err := c.cc.Invoke(ctx, Follow_RemoveGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) GroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupMembersResponse)
+	err := c.cc.Invoke(ctx, Follow_GroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) GroupMemberIds(ctx context.Context, in *GroupMemberIdsRequest, opts ...grpc.CallOption) (*GroupMemberIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupMemberIdsResponse)
+	err := c.cc.Invoke(ctx, Follow_GroupMemberIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FollowServer is the server API for Follow service.
 // All implementations must embed UnimplementedFollowServer
 // for forward compatibility.
@@ -99,6 +307,38 @@ type FollowServer interface {
 	FollowList(context.Context, *FollowListRequest) (*FollowListResponse, error)
 	// 粉丝列表
 	FansList(context.Context, *FansListRequest) (*FansListResponse, error)
+	// 是否关注了某个用户
+	IsFollowing(context.Context, *IsFollowingRequest) (*IsFollowingResponse, error)
+	// 拉黑或屏蔽
+	Block(context.Context, *BlockRequest) (*BlockResponse, error)
+	// 解除拉黑或屏蔽
+	UnBlock(context.Context, *UnBlockRequest) (*UnBlockResponse, error)
+	// 拉黑或屏蔽列表
+	BlockList(context.Context, *BlockListRequest) (*BlockListResponse, error)
+	// 我拉黑和屏蔽的全部用户，用于过滤内容
+	BlockedIds(context.Context, *BlockedIdsRequest) (*BlockedIdsResponse, error)
+	// 一批用户中哪些人拉黑了我，用于校验评论、点赞等操作
+	CheckBlocked(context.Context, *CheckBlockedRequest) (*CheckBlockedResponse, error)
+	// 批量查询我和一批用户之间的关注关系
+	Relations(context.Context, *RelationsRequest) (*RelationsResponse, error)
+	// 好友（互相关注）列表
+	FriendsList(context.Context, *FriendsListRequest) (*FriendsListResponse, error)
+	// 创建关注分组
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	// 修改关注分组名称
+	UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
+	// 删除关注分组
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	// 关注分组列表
+	GroupList(context.Context, *GroupListRequest) (*GroupListResponse, error)
+	// 添加分组成员
+	AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersResponse, error)
+	// 移除分组成员
+	RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersResponse, error)
+	// 分组成员列表
+	GroupMembers(context.Context, *GroupMembersRequest) (*GroupMembersResponse, error)
+	// 分组的全部成员id，用于分组关注流
+	GroupMemberIds(context.Context, *GroupMemberIdsRequest) (*GroupMemberIdsResponse, error)
 	mustEmbedUnimplementedFollowServer()
 }
 
@@ -121,6 +361,54 @@ func (UnimplementedFollowServer) FollowList(context.Context, *FollowListRequest)
 func (UnimplementedFollowServer) FansList(context.Context, *FansListRequest) (*FansListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FansList not implemented")
 }
+func (UnimplementedFollowServer) IsFollowing(context.Context, *IsFollowingRequest) (*IsFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFollowing not implemented")
+}
+func (UnimplementedFollowServer) Block(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedFollowServer) UnBlock(context.Context, *UnBlockRequest) (*UnBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnBlock not implemented")
+}
+func (UnimplementedFollowServer) BlockList(context.Context, *BlockListRequest) (*BlockListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockList not implemented")
+}
+func (UnimplementedFollowServer) BlockedIds(context.Context, *BlockedIdsRequest) (*BlockedIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedIds not implemented")
+}
+func (UnimplementedFollowServer) CheckBlocked(context.Context, *CheckBlockedRequest) (*CheckBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBlocked not implemented")
+}
+func (UnimplementedFollowServer) Relations(context.Context, *RelationsRequest) (*RelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Relations not implemented")
+}
+func (UnimplementedFollowServer) FriendsList(context.Context, *FriendsListRequest) (*FriendsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FriendsList not implemented")
+}
+func (UnimplementedFollowServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedFollowServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedFollowServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedFollowServer) GroupList(context.Context, *GroupListRequest) (*GroupListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupList not implemented")
+}
+func (UnimplementedFollowServer) AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupMembers not implemented")
+}
+func (UnimplementedFollowServer) RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMembers not implemented")
+}
+func (UnimplementedFollowServer) GroupMembers(context.Context, *GroupMembersRequest) (*GroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupMembers not implemented")
+}
+func (UnimplementedFollowServer) GroupMemberIds(context.Context, *GroupMemberIdsRequest) (*GroupMemberIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupMemberIds not implemented")
+}
 func (UnimplementedFollowServer) mustEmbedUnimplementedFollowServer() {}
 func (UnimplementedFollowServer) testEmbeddedByValue()                {}
 
@@ -214,6 +502,294 @@ func _Follow_FansList_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Follow_IsFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).IsFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_IsFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).IsFollowing(ctx, req.(*IsFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_Block_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).Block(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_UnBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).UnBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_UnBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).UnBlock(ctx, req.(*UnBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_BlockList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).BlockList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_BlockList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).BlockList(ctx, req.(*BlockListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_BlockedIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockedIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).BlockedIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_BlockedIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).BlockedIds(ctx, req.(*BlockedIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_CheckBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).CheckBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_CheckBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).CheckBlocked(ctx, req.(*CheckBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_Relations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).Relations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_Relations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).Relations(ctx, req.(*RelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_FriendsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).FriendsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_FriendsList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).FriendsList(ctx, req.(*FriendsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_UpdateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_GroupList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).GroupList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_GroupList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).GroupList(ctx, req.(*GroupListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_AddGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).AddGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_AddGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).AddGroupMembers(ctx, req.(*AddGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_RemoveGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).RemoveGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_RemoveGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).RemoveGroupMembers(ctx, req.(*RemoveGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_GroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).GroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_GroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).GroupMembers(ctx, req.(*GroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_GroupMemberIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMemberIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).GroupMemberIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_GroupMemberIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).GroupMemberIds(ctx, req.(*GroupMemberIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Follow_ServiceDesc is the grpc.ServiceDesc for Follow service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,6 +813,70 @@ var Follow_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FansList",
 			Handler:    _Follow_FansList_Handler,
 		},
+		{
+			MethodName: "IsFollowing",
+			Handler:    _Follow_IsFollowing_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _Follow_Block_Handler,
+		},
+		{
+			MethodName: "UnBlock",
+			Handler:    _Follow_UnBlock_Handler,
+		},
+		{
+			MethodName: "BlockList",
+			Handler:    _Follow_BlockList_Handler,
+		},
+		{
+			MethodName: "BlockedIds",
+			Handler:    _Follow_BlockedIds_Handler,
+		},
+		{
+			MethodName: "CheckBlocked",
+			Handler:    _Follow_CheckBlocked_Handler,
+		},
+		{
+			MethodName: "Relations",
+			Handler:    _Follow_Relations_Handler,
+		},
+		{
+			MethodName: "FriendsList",
+			Handler:    _Follow_FriendsList_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _Follow_CreateGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroup",
+			Handler:    _Follow_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _Follow_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "GroupList",
+			Handler:    _Follow_GroupList_Handler,
+		},
+		{
+			MethodName: "AddGroupMembers",
+			Handler:    _Follow_AddGroupMembers_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMembers",
+			Handler:    _Follow_RemoveGroupMembers_Handler,
+		},
+		{
+			MethodName: "GroupMembers",
+			Handler:    _Follow_GroupMembers_Handler,
+		},
+		{
+			MethodName: "GroupMemberIds",
+			Handler:    _Follow_GroupMemberIds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "follow.proto",
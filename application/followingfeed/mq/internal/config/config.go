@@ -16,4 +16,29 @@ type Config struct {
 	DataSourceFollowingFeed string
 	CacheRedis              cache.CacheConf
 	BizRedis                redis.RedisConf
+	// 粉丝数达到FansThreshold的作者切换为拉模式，降到FansThreshold*PushRatio以下才切回推模式，避免在阈值附近反复切换
+	BigUp struct {
+		FansThreshold int64   `json:",default=50000"`
+		PushRatio     float64 `json:",default=0.9"`
+	}
+	// 小up发布文章时只推送给ActiveFanDays天内活跃过的粉丝
+	ActiveFanDays int `json:",default=30"`
+	// 收信箱只保留最新的MaxCount条、最近MaxDays天的动态，更早的由定时任务移到归档表
+	InboxRetention struct {
+		MaxCount int `json:",default=1000"`
+		MaxDays  int `json:",default=90"`
+		Interval int `json:",default=3600"` // 扫描间隔，单位秒
+		// 每次扫描最多处理的用户数，没扫完的下次从上次停下的用户接着扫
+		UsersPerTick int `json:",default=10000"`
+	}
+	// 切换模式后迁移粉丝收信箱的任务
+	FeedModeMigrate struct {
+		Interval  int `json:",default=60"` // 扫描间隔，单位秒
+		BatchSize int `json:",default=10"` // 每次扫描处理的作者数
+	}
+	// 粉丝收信箱有新文章时推送给在线的粉丝
+	PushKqPusherConf struct {
+		Brokers []string
+		Topic   string
+	}
 }
@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"posta/application/followingfeed/mq/internal/model"
+	"posta/application/followingfeed/mq/internal/svc"
+	"posta/application/followingfeed/mq/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 每个用户关注的大UP集合，关注流每次请求读一次，由followingfeed-rpc在缓存不存在时从数据库加载。
+// 这里只在集合存在时增量更新，不存在时等下次查询懒加载。
+// 每次更新都先给版本号加1，rpc加载期间版本号变了就不写入，避免用更新前查到的结果覆盖这次更新
+const (
+	prefixBigUpIds        = "biz#feed#bigup#%d"
+	prefixBigUpIdsVersion = "biz#feed#bigup#%d#version"
+	// 和followingfeed-rpc中大UP集合的过期时间一致
+	bigUpIdsExpire = 3600 * 24 * 3
+)
+
+const (
+	addBigUpScript = `
+redis.call("INCR", KEYS[2])
+redis.call("EXPIRE", KEYS[2], ARGV[2])
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("SADD", KEYS[1], ARGV[1])
+end
+return 0`
+	removeBigUpScript = `
+redis.call("INCR", KEYS[2])
+redis.call("EXPIRE", KEYS[2], ARGV[2])
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("SREM", KEYS[1], ARGV[1])
+end
+return 0`
+)
+
+// isBigUp 粉丝读关注流时是否读这个作者的发件箱。迁移中的作者收信箱还不完整，也读发件箱
+func isBigUp(mode *model.AuthorFeedMode) bool {
+	return mode.Mode == types.FeedModePull || mode.MigrateStatus == types.MigrateStatusMigrating
+}
+
+func addBigUp(ctx context.Context, svcCtx *svc.ServiceContext, fanIds []int64, authorId int64) {
+	updateBigUpIds(ctx, svcCtx, addBigUpScript, fanIds, authorId)
+}
+
+func removeBigUp(ctx context.Context, svcCtx *svc.ServiceContext, fanIds []int64, authorId int64) {
+	updateBigUpIds(ctx, svcCtx, removeBigUpScript, fanIds, authorId)
+}
+
+func updateBigUpIds(ctx context.Context, svcCtx *svc.ServiceContext, script string, fanIds []int64, authorId int64) {
+	member := strconv.FormatInt(authorId, 10)
+	for _, fanId := range fanIds {
+		key := fmt.Sprintf(prefixBigUpIds, fanId)
+		keys := []string{key, fmt.Sprintf(prefixBigUpIdsVersion, fanId)}
+		if _, err := svcCtx.BizRedis.EvalCtx(ctx, script, keys, member, bigUpIdsExpire); err != nil {
+			logx.WithContext(ctx).Errorf("update big up ids key: %s author_id = %d, err: %v", key, authorId, err)
+		}
+	}
+}
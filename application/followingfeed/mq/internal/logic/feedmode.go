@@ -0,0 +1,208 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"posta/application/followingfeed/mq/internal/model"
+	"posta/application/followingfeed/mq/internal/svc"
+	"posta/application/followingfeed/mq/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 这个文件负责作者分发模式的判断和切换。
+// 小up是推模式，发布文章时写入所有粉丝的收信箱；大up是拉模式，粉丝读关注流时直接查大up的发件箱。
+// 作者跨过粉丝数阈值时切换模式并标记为迁移中，由FeedModeMigrateLogic补齐或清理粉丝的收信箱。
+// 迁移期间粉丝的关注流要同时包含推和拉两种方式中至少一种能看到的文章：切换为拉模式时立即加入粉丝的大UP集合，
+// 切回推模式时等收信箱补齐后才从大UP集合中移除。
+
+// authorFeedMode 查询作者的分发模式，并按当前粉丝数判断是否需要切换
+func authorFeedMode(ctx context.Context, svcCtx *svc.ServiceContext, authorId int64) (*model.AuthorFeedMode, error) {
+	fansCount, err := svcCtx.FollowCountModel.GetFansCount(ctx, authorId)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := svcCtx.AuthorFeedModeModel.FindOneByUserId(ctx, authorId)
+	if errors.Is(err, model.ErrNotFound) {
+		// 第一次记录的作者直接按粉丝数确定模式，之前的收信箱也是按粉丝数写入的，不需要迁移
+		mode = &model.AuthorFeedMode{
+			UserId:        authorId,
+			Mode:          types.FeedModePush,
+			MigrateStatus: types.MigrateStatusNone,
+		}
+		if fansCount >= svcCtx.Config.BigUp.FansThreshold {
+			mode.Mode = types.FeedModePull
+		}
+		if err = svcCtx.AuthorFeedModeModel.InsertIgnore(ctx, mode); err != nil {
+			return nil, err
+		}
+		return svcCtx.AuthorFeedModeModel.FindOneByUserId(ctx, authorId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	target := mode.Mode
+	switch {
+	case mode.Mode == types.FeedModePush && fansCount >= svcCtx.Config.BigUp.FansThreshold:
+		target = types.FeedModePull
+	case mode.Mode == types.FeedModePull && float64(fansCount) < float64(svcCtx.Config.BigUp.FansThreshold)*svcCtx.Config.BigUp.PushRatio:
+		target = types.FeedModePush
+	}
+	if target == mode.Mode {
+		return mode, nil
+	}
+
+	rows, err := svcCtx.AuthorFeedModeModel.SwitchMode(ctx, authorId, mode.Mode, target)
+	if err != nil {
+		return nil, err
+	}
+	if rows > 0 {
+		logx.WithContext(ctx).Infof("author %d switch feed mode %d -> %d, fansCount: %d", authorId, mode.Mode, target, fansCount)
+		// 切换为拉模式后新文章不再推送，要马上加入粉丝的大UP集合，不能等迁移任务，否则这期间的文章粉丝两边都看不到。
+		// 切回推模式时不用处理，迁移完成之前粉丝仍然会读他的发件箱
+		if target == types.FeedModePull {
+			// 失败时由迁移任务再加入
+			if fanIds, err := svcCtx.FollowModel.GetFanIds(ctx, authorId); err != nil {
+				logx.WithContext(ctx).Errorf("GetFanIds author_id = %d, err: %v", authorId, err)
+			} else {
+				addBigUp(ctx, svcCtx, fanIds, authorId)
+			}
+		}
+	}
+	// 并发切换时以数据库中的结果为准
+	return svcCtx.AuthorFeedModeModel.FindOneByUserId(ctx, authorId)
+}
+
+// isPushAuthor 推模式的作者发布文章时写入粉丝收信箱
+func isPushAuthor(mode *model.AuthorFeedMode) bool {
+	return mode.Mode == types.FeedModePush
+}
+
+// mayHaveInbox 推模式或迁移中的作者，粉丝收信箱中可能有他的动态，删除时需要同步清理
+func mayHaveInbox(mode *model.AuthorFeedMode) bool {
+	return mode.Mode == types.FeedModePush || mode.MigrateStatus == types.MigrateStatusMigrating
+}
+
+// FeedModeMigrateLogic 定时处理切换了模式的作者，迁移粉丝的收信箱
+type FeedModeMigrateLogic struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFeedModeMigrateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FeedModeMigrateLogic {
+	ctx, cancel := context.WithCancel(ctx)
+	return &FeedModeMigrateLogic{
+		ctx:    ctx,
+		cancel: cancel,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *FeedModeMigrateLogic) Start() {
+	ticker := time.NewTicker(time.Duration(l.svcCtx.Config.FeedModeMigrate.Interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		l.migrate()
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (l *FeedModeMigrateLogic) Stop() {
+	l.cancel()
+}
+
+func (l *FeedModeMigrateLogic) migrate() {
+	modes, err := l.svcCtx.AuthorFeedModeModel.FindMigrating(l.ctx, l.svcCtx.Config.FeedModeMigrate.BatchSize)
+	if err != nil {
+		l.Logger.Errorf("FindMigrating error: %v", err)
+		return
+	}
+
+	for _, mode := range modes {
+		if err = l.migrateAuthor(mode); err != nil {
+			l.Logger.Errorf("migrateAuthor author_id = %d, mode = %d, err: %v", mode.UserId, mode.Mode, err)
+		}
+	}
+}
+
+// migrateAuthor 切换为拉模式时清理粉丝收信箱中该作者的动态，切回推模式时把作者最近的文章补进粉丝收信箱。
+// 迁移过程中关注流仍按拉模式读取该作者的发件箱，和收信箱重复的文章在合并时去重。
+func (l *FeedModeMigrateLogic) migrateAuthor(mode *model.AuthorFeedMode) error {
+	fanIds, err := l.svcCtx.FollowModel.GetFanIds(l.ctx, mode.UserId)
+	if err != nil {
+		return err
+	}
+
+	if mode.Mode == types.FeedModePull {
+		// 切换时已经加入过粉丝的大UP集合，这里再加一次，切换时失败的粉丝在这里补上。
+		// 先加入大UP集合再清理收信箱，切换后到这里之前发布的文章会在粉丝读发件箱时出现
+		addBigUp(l.ctx, l.svcCtx, fanIds, mode.UserId)
+		if _, err = l.svcCtx.UserInBoxModel.DeleteBySender(l.ctx, mode.UserId); err != nil {
+			return err
+		}
+	} else if err = l.backfill(mode.UserId, fanIds); err != nil {
+		return err
+	}
+
+	// 粉丝的收信箱缓存直接删除，下次查询时重新加载
+	for _, fanId := range fanIds {
+		if _, err = l.svcCtx.BizRedis.DelCtx(l.ctx, fmt.Sprintf(prefixInbox, fanId)); err != nil {
+			l.Logger.Errorf("Invalidate inbox cache user_id = %d, err: %v", fanId, err)
+		}
+	}
+
+	if err = l.svcCtx.AuthorFeedModeModel.FinishMigrate(l.ctx, mode.Id, mode.Mode); err != nil {
+		return err
+	}
+	// 收信箱补齐后才从粉丝的大UP集合中移除
+	if mode.Mode == types.FeedModePush {
+		removeBigUp(l.ctx, l.svcCtx, fanIds, mode.UserId)
+	}
+	return nil
+}
+
+func (l *FeedModeMigrateLogic) backfill(authorId int64, fanIds []int64) error {
+	// 只补最近的文章，更早的文章粉丝很少翻到，避免一次写入过多的收信箱记录
+	articles, err := l.svcCtx.ArticleModel.ArticlesByUserId(l.ctx, authorId, types.BackfillLimit)
+	if err != nil {
+		return err
+	}
+	if len(articles) == 0 {
+		return nil
+	}
+
+	inboxBatch := make([]*model.UserInbox, 0, types.BatchSize)
+	for _, fanId := range fanIds {
+		for _, article := range articles {
+			inboxBatch = append(inboxBatch, &model.UserInbox{
+				UserId:      fanId,
+				SenderId:    authorId,
+				ArticleId:   article.Id,
+				Status:      types.ArticleStatusVisible,
+				PublishTime: article.PublishTime,
+				IsRead:      0,
+			})
+			if len(inboxBatch) == types.BatchSize {
+				if _, err = l.svcCtx.UserInBoxModel.BatchInsert(l.ctx, inboxBatch); err != nil {
+					return err
+				}
+				inboxBatch = inboxBatch[:0]
+			}
+		}
+	}
+	_, err = l.svcCtx.UserInBoxModel.BatchInsert(l.ctx, inboxBatch)
+	return err
+}
@@ -0,0 +1,191 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"posta/application/followingfeed/mq/internal/model"
+	"posta/application/followingfeed/mq/internal/svc"
+	"posta/application/followingfeed/mq/internal/types"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+type inboxKey struct {
+	userId, senderId, articleId int64
+}
+
+// fakeInboxModel 按uk_user_sender_article唯一键保存收信箱记录，BatchInsert和insert ignore一样跳过已存在的记录
+type fakeInboxModel struct {
+	model.UserInboxModel
+	rows map[inboxKey]*model.UserInbox
+}
+
+func (m *fakeInboxModel) BatchInsert(_ context.Context, data []*model.UserInbox) (sql.Result, error) {
+	for _, row := range data {
+		key := inboxKey{row.UserId, row.SenderId, row.ArticleId}
+		if _, ok := m.rows[key]; !ok {
+			copied := *row
+			m.rows[key] = &copied
+		}
+	}
+	return nil, nil
+}
+
+func (m *fakeInboxModel) DeleteBySender(_ context.Context, senderId int64) (sql.Result, error) {
+	for key := range m.rows {
+		if key.senderId == senderId {
+			delete(m.rows, key)
+		}
+	}
+	return nil, nil
+}
+
+type fakeFollowModel struct {
+	model.FollowModel
+	fanIds []int64
+}
+
+func (m *fakeFollowModel) GetFanIds(context.Context, int64) ([]int64, error) {
+	return m.fanIds, nil
+}
+
+type fakeFollowCountModel struct {
+	model.FollowCountModel
+	fansCount int64
+}
+
+func (m *fakeFollowCountModel) GetFansCount(context.Context, int64) (int64, error) {
+	return m.fansCount, nil
+}
+
+type fakeArticleModel struct {
+	model.ArticleModel
+	articles []*model.Article
+}
+
+func (m *fakeArticleModel) ArticlesByUserId(context.Context, int64, int) ([]*model.Article, error) {
+	return m.articles, nil
+}
+
+type fakeAuthorFeedModeModel struct {
+	model.AuthorFeedModeModel
+	mode *model.AuthorFeedMode
+}
+
+func (m *fakeAuthorFeedModeModel) FindOneByUserId(context.Context, int64) (*model.AuthorFeedMode, error) {
+	copied := *m.mode
+	return &copied, nil
+}
+
+func (m *fakeAuthorFeedModeModel) SwitchMode(_ context.Context, _, from, to int64) (int64, error) {
+	if m.mode.Mode != from {
+		return 0, nil
+	}
+	m.mode.Mode, m.mode.MigrateStatus = to, types.MigrateStatusMigrating
+	return 1, nil
+}
+
+func (m *fakeAuthorFeedModeModel) FinishMigrate(context.Context, int64, int64) error {
+	return nil
+}
+
+func TestMigrateAuthorRoundTrip(t *testing.T) {
+	const authorId = 100
+	fanIds := []int64{1, 2}
+	now := time.Now()
+	articles := []*model.Article{
+		{Id: 11, AuthorId: authorId, PublishTime: now},
+		{Id: 12, AuthorId: authorId, PublishTime: now.Add(-time.Hour)},
+	}
+
+	inbox := &fakeInboxModel{rows: make(map[inboxKey]*model.UserInbox)}
+	// 推模式时发布的文章已经在粉丝的收信箱中
+	for _, fanId := range fanIds {
+		for _, article := range articles {
+			inbox.rows[inboxKey{fanId, authorId, article.Id}] = &model.UserInbox{
+				UserId:      fanId,
+				SenderId:    authorId,
+				ArticleId:   article.Id,
+				Status:      types.ArticleStatusVisible,
+				PublishTime: article.PublishTime,
+			}
+		}
+	}
+
+	mr := miniredis.RunT(t)
+	svcCtx := &svc.ServiceContext{
+		UserInBoxModel:      inbox,
+		AuthorFeedModeModel: &fakeAuthorFeedModeModel{},
+		FollowModel:         &fakeFollowModel{fanIds: fanIds},
+		ArticleModel:        &fakeArticleModel{articles: articles},
+		BizRedis:            redis.MustNewRedis(redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType}),
+	}
+	l := NewFeedModeMigrateLogic(context.Background(), svcCtx)
+
+	// 推模式 -> 拉模式，收信箱中该作者的动态被清理
+	err := l.migrateAuthor(&model.AuthorFeedMode{UserId: authorId, Mode: types.FeedModePull, MigrateStatus: types.MigrateStatusMigrating})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inbox.rows) != 0 {
+		t.Fatalf("expected empty inbox after switching to pull, but got %d rows", len(inbox.rows))
+	}
+
+	// 拉模式 -> 推模式，作者最近的文章重新出现在所有粉丝的收信箱中
+	err = l.migrateAuthor(&model.AuthorFeedMode{UserId: authorId, Mode: types.FeedModePush, MigrateStatus: types.MigrateStatusMigrating})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fanId := range fanIds {
+		for _, article := range articles {
+			row, ok := inbox.rows[inboxKey{fanId, authorId, article.Id}]
+			if !ok || row.Status != types.ArticleStatusVisible {
+				t.Fatalf("expected visible inbox row for fan %d article %d, but got %+v", fanId, article.Id, row)
+			}
+		}
+	}
+}
+
+func TestSwitchToPullAddsBigUpBeforeMigrate(t *testing.T) {
+	const authorId = 100
+	fanIds := []int64{1, 2}
+
+	mr := miniredis.RunT(t)
+	svcCtx := &svc.ServiceContext{
+		AuthorFeedModeModel: &fakeAuthorFeedModeModel{mode: &model.AuthorFeedMode{Id: 1, UserId: authorId, Mode: types.FeedModePush}},
+		FollowModel:         &fakeFollowModel{fanIds: fanIds},
+		FollowCountModel:    &fakeFollowCountModel{fansCount: 10},
+		BizRedis:            redis.MustNewRedis(redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType}),
+	}
+	svcCtx.Config.BigUp.FansThreshold = 10
+	// 粉丝1的大UP集合已经加载过，粉丝2的还没有加载
+	if _, err := mr.SAdd(fmt.Sprintf(prefixBigUpIds, 1), "0"); err != nil {
+		t.Fatal(err)
+	}
+
+	mode, err := authorFeedMode(context.Background(), svcCtx, authorId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isPushAuthor(mode) || !isBigUp(mode) {
+		t.Fatalf("expected migrating pull author, but got %+v", mode)
+	}
+	// 迁移任务还没有运行，已经加载的大UP集合中就要有这个作者，否则这期间的新文章粉丝看不到
+	if ok, _ := mr.SIsMember(fmt.Sprintf(prefixBigUpIds, 1), "100"); !ok {
+		t.Fatal("expected author in the loaded big up set right after switching to pull")
+	}
+	if mr.Exists(fmt.Sprintf(prefixBigUpIds, 2)) {
+		t.Fatal("expected the unloaded big up set to stay lazy")
+	}
+	// 版本号变了，rpc切换之前开始的加载不会写入旧的集合
+	for _, fanId := range fanIds {
+		if v, _ := mr.Get(fmt.Sprintf(prefixBigUpIdsVersion, fanId)); v != "1" {
+			t.Fatalf("expected version 1 for fan %d, but got %q", fanId, v)
+		}
+	}
+}
@@ -49,29 +49,30 @@ func (l *FollowCompensateLogic) followOperate(msg *types.CanalFollowMsg) error {
 		followedUserId, _ := strconv.ParseInt(d.FollowedUserID, 10, 64)
 		status, _ := strconv.Atoi(d.Status)
 
-		// 这里沿用了b站的称呼，称为小up主。
-		var isSmallUp bool
-		fansCount, err := l.svcCtx.FollowCountModel.GetFansCount(l.ctx, followedUserId)
-
+		// 这里沿用了b站的称呼，推模式的作者称为小up主。粉丝数的变化可能让作者切换模式
+		mode, err := authorFeedMode(l.ctx, l.svcCtx, followedUserId)
 		if err != nil {
-			l.Logger.Errorf("GetFansCount fail, author_id = %d, err: %v", followedUserId, err)
+			l.Logger.Errorf("authorFeedMode fail, author_id = %d, err: %v", followedUserId, err)
 			continue
 		}
 
-		// 如果粉丝数小于BigUpFansThreshold，则是小up主
-		if fansCount < types.BigUpFansThreshold {
-			isSmallUp = true
+		// 维护粉丝关注的大UP集合
+		if status == types.FollowStatusFollow && isBigUp(mode) {
+			addBigUp(l.ctx, l.svcCtx, []int64{fanId}, followedUserId)
+		}
+		if status == types.FollowStatusUnfollow {
+			removeBigUp(l.ctx, l.svcCtx, []int64{fanId}, followedUserId)
 		}
 
 		// 新增关注
 		if status == types.FollowStatusFollow {
 			// 如果不是小up，不用补偿进收信箱
-			if !isSmallUp {
+			if !isPushAuthor(mode) {
 				continue
 			}
 
-			// 查找小up的所有articleId
-			articles, err := l.svcCtx.ArticleModel.ArticlesByUserId(l.ctx, followedUserId)
+			// 查找小up最近的文章
+			articles, err := l.svcCtx.ArticleModel.ArticlesByUserId(l.ctx, followedUserId, types.BackfillLimit)
 			if err != nil {
 				l.Logger.Errorf("ArticleIdsByUserId fail, author_id = %d, err: %v", followedUserId, err)
 			}
@@ -102,7 +103,7 @@ func (l *FollowCompensateLogic) followOperate(msg *types.CanalFollowMsg) error {
 			}
 		}
 		if status == types.FollowStatusUnfollow {
-			if !isSmallUp {
+			if !mayHaveInbox(mode) {
 				continue
 			}
 			_, err := l.svcCtx.UserInBoxModel.BatchSetDeleteBySenRec(l.ctx, fanId, followedUserId)
@@ -0,0 +1,135 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"posta/application/followingfeed/mq/internal/svc"
+	"posta/application/followingfeed/mq/internal/types"
+	"posta/pkg/active"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 归档表中最新一条动态的发布时间，followingfeed-rpc翻页超过保留窗口时改为拉作者的发件箱，归档后删除让rpc重新加载
+const prefixInboxArchived = "biz#inbox#archived#%d"
+
+// 上次扫描停下时处理到的用户id，扫完所有用户后删除，下次从头开始
+const inboxCompactCursorKey = "biz#inbox#compact#cursor"
+
+// InboxCompactLogic 定时把超出保留窗口的收信箱记录移到归档表
+type InboxCompactLogic struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewInboxCompactLogic(ctx context.Context, svcCtx *svc.ServiceContext) *InboxCompactLogic {
+	ctx, cancel := context.WithCancel(ctx)
+	return &InboxCompactLogic{
+		ctx:    ctx,
+		cancel: cancel,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *InboxCompactLogic) Start() {
+	ticker := time.NewTicker(time.Duration(l.svcCtx.Config.InboxRetention.Interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		l.compact()
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (l *InboxCompactLogic) Stop() {
+	l.cancel()
+}
+
+func (l *InboxCompactLogic) compact() {
+	// 推送只看ActiveFanDays天内活跃过的粉丝，更早的活跃记录顺便清掉
+	since := time.Now().AddDate(0, 0, -l.svcCtx.Config.ActiveFanDays)
+	if err := active.Trim(l.ctx, l.svcCtx.BizRedis, since); err != nil {
+		l.Logger.Errorf("active.Trim since: %v, err: %v", since, err)
+	}
+
+	// 每次只处理UsersPerTick个用户，下次和重启后都从上次停下的位置接着扫
+	val, err := l.svcCtx.BizRedis.GetCtx(l.ctx, inboxCompactCursorKey)
+	if err != nil {
+		l.Logger.Errorf("GetCtx key: %s, err: %v", inboxCompactCursorKey, err)
+		return
+	}
+	lastUserId, _ := strconv.ParseInt(val, 10, 64)
+	for processed := 0; processed < l.svcCtx.Config.InboxRetention.UsersPerTick; {
+		userIds, err := l.svcCtx.UserInBoxModel.UserIdsAfter(l.ctx, lastUserId, types.BatchSize)
+		if err != nil {
+			l.Logger.Errorf("UserIdsAfter user_id = %d, err: %v", lastUserId, err)
+			return
+		}
+		for _, userId := range userIds {
+			if l.ctx.Err() != nil {
+				return
+			}
+			if err = l.compactUser(userId); err != nil {
+				l.Logger.Errorf("compactUser user_id = %d, err: %v", userId, err)
+			}
+		}
+		if len(userIds) < types.BatchSize {
+			// 扫到最后一个用户了，下次从头开始
+			if _, err = l.svcCtx.BizRedis.DelCtx(l.ctx, inboxCompactCursorKey); err != nil {
+				l.Logger.Errorf("DelCtx key: %s, err: %v", inboxCompactCursorKey, err)
+			}
+			return
+		}
+		lastUserId = userIds[len(userIds)-1]
+		processed += len(userIds)
+		if err = l.svcCtx.BizRedis.SetCtx(l.ctx, inboxCompactCursorKey, strconv.FormatInt(lastUserId, 10)); err != nil {
+			l.Logger.Errorf("SetCtx key: %s, err: %v", inboxCompactCursorKey, err)
+		}
+	}
+}
+
+func (l *InboxCompactLogic) compactUser(userId int64) error {
+	maxAge := time.Now().AddDate(0, 0, -l.svcCtx.Config.InboxRetention.MaxDays)
+	cutoff, err := l.svcCtx.UserInBoxModel.RetentionCutoff(l.ctx, userId, l.svcCtx.Config.InboxRetention.MaxCount, maxAge)
+	if err != nil {
+		return err
+	}
+
+	var archived bool
+	for {
+		ids, err := l.svcCtx.UserInBoxModel.IdsBefore(l.ctx, userId, cutoff, types.BatchSize)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			break
+		}
+		if err = l.svcCtx.UserInboxArchiveModel.ArchiveByIds(l.ctx, ids); err != nil {
+			return err
+		}
+		archived = true
+		if len(ids) < types.BatchSize {
+			break
+		}
+	}
+	if !archived {
+		return nil
+	}
+
+	// 收信箱缓存和归档边界都直接删除，下次查询时重新加载
+	if _, err = l.svcCtx.BizRedis.DelCtx(l.ctx, fmt.Sprintf(prefixInbox, userId), fmt.Sprintf(prefixInboxArchived, userId)); err != nil {
+		return err
+	}
+	l.Logger.Infof("Archived inbox of user %d before %s", userId, cutoff.Format(time.DateTime))
+	return nil
+}
@@ -11,6 +11,8 @@ import (
 	"posta/application/followingfeed/mq/internal/model"
 	"posta/application/followingfeed/mq/internal/svc"
 	"posta/application/followingfeed/mq/internal/types"
+	"posta/pkg/active"
+	"posta/pkg/push"
 	"strconv"
 	"time"
 )
@@ -18,8 +20,17 @@ import (
 const (
 	prefixInbox = "biz#inbox#%d"
 	inboxExpire = 3600 * 24 * 2
+
+	// 不活跃粉丝第一篇漏推文章的发布时间
+	prefixPullSince = "biz#feed#pull#since#%d"
 )
 
+const markPullSinceScript = `
+for i = 1, #KEYS do
+	redis.call("SET", KEYS[i], ARGV[1], "NX", "EX", ARGV[2])
+end
+return 1`
+
 type OutboxToInboxLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -57,24 +68,17 @@ func (l *OutboxToInboxLogic) articleOperate(msg *types.CanalArticleMsg) error {
 		authorId, _ := strconv.ParseInt(d.AuthorId, 10, 64)
 		t, err := time.ParseInLocation("2006-01-02 15:04:05", d.PublishTime, time.Local)
 
-		// 这里沿用了b站的称呼，称为小up主。
-		var isSmallUp bool
-		fansCount, err := l.svcCtx.FollowCountModel.GetFansCount(l.ctx, authorId)
-
+		// 这里沿用了b站的称呼，推模式的作者称为小up主。
+		mode, err := authorFeedMode(l.ctx, l.svcCtx, authorId)
 		if err != nil {
-			l.Logger.Errorf("GetFansCount fail, author_id = %d, err: %v", authorId, err)
+			l.Logger.Errorf("authorFeedMode fail, author_id = %d, err: %v", authorId, err)
 			continue
 		}
 
-		// 如果粉丝数小于BigUpFansThreshold，则是小up主
-		if fansCount < types.BigUpFansThreshold {
-			isSmallUp = true
-		}
-
 		switch status {
 		// 如果是发布了一篇新文章
 		case types.ArticleStatusVisible:
-			if !isSmallUp {
+			if !isPushAuthor(mode) {
 				continue
 			}
 			fanIds, err := l.svcCtx.FollowModel.GetFanIds(l.ctx, authorId)
@@ -88,6 +92,7 @@ func (l *OutboxToInboxLogic) articleOperate(msg *types.CanalArticleMsg) error {
 
 			// 开启独立协程，避免阻塞主线程
 			threading.GoSafe(func() {
+				fanIds := l.activeFans(fanIds, t)
 				inboxBatch := make([]*model.UserInbox, 0, types.BatchSize)
 				insertedInboxes := make([]*model.UserInbox, 0, len(fanIds))
 				// 这里使用小批量插入，更加安全。
@@ -113,11 +118,13 @@ func (l *OutboxToInboxLogic) articleOperate(msg *types.CanalArticleMsg) error {
 
 				// 更新redis
 				l.updateInboxCacheForInsert(insertedInboxes)
+				// 通知在线的粉丝关注流有新内容，大up主的粉丝是拉模式，不推送
+				l.pushFeedEvents(insertedInboxes)
 			})
 
 		// 如果是删除了一篇文章
 		case types.ArticleStatusUserDelete:
-			if !isSmallUp {
+			if !mayHaveInbox(mode) {
 				continue
 			}
 
@@ -142,6 +149,55 @@ func Consumers(ctx context.Context, svcCtx *svc.ServiceContext) []service.Servic
 	return []service.Service{
 		kq.MustNewQueue(svcCtx.Config.ArticleKqConsumerConf, NewOutboxToInboxLogic(ctx, svcCtx)),
 		kq.MustNewQueue(svcCtx.Config.FollowKqConsumerConf, NewFollowCompensateLogic(ctx, svcCtx)),
+		NewFeedModeMigrateLogic(ctx, svcCtx),
+		NewInboxCompactLogic(ctx, svcCtx),
+	}
+}
+
+// activeFans 只推送给最近活跃的粉丝。不活跃的粉丝记下第一篇漏推文章的发布时间，
+// 下次打开关注流时由followingfeed-rpc从小up的发件箱补拉进收信箱
+func (l *OutboxToInboxLogic) activeFans(fanIds []int64, publishTime time.Time) []int64 {
+	since := time.Now().AddDate(0, 0, -l.svcCtx.Config.ActiveFanDays)
+	activeIds := make([]int64, 0, len(fanIds))
+	inactiveIds := make([]int64, 0)
+	for start := 0; start < len(fanIds); start += types.BatchSize {
+		batch := fanIds[start:min(start+types.BatchSize, len(fanIds))]
+		ids, err := active.Filter(context.Background(), l.svcCtx.BizRedis, batch, since)
+		if err != nil {
+			// 查询失败时全部推送，多写收信箱比漏推好
+			l.Logger.Errorf("active.Filter error: %v", err)
+			activeIds = append(activeIds, batch...)
+			continue
+		}
+		activeSet := make(map[int64]struct{}, len(ids))
+		for _, id := range ids {
+			activeSet[id] = struct{}{}
+		}
+		for _, fanId := range batch {
+			if _, ok := activeSet[fanId]; ok {
+				activeIds = append(activeIds, fanId)
+			} else {
+				inactiveIds = append(inactiveIds, fanId)
+			}
+		}
+	}
+
+	l.markPullSince(inactiveIds, publishTime)
+	return activeIds
+}
+
+// markPullSince 已经有漏推标记的粉丝保留更早的时间
+func (l *OutboxToInboxLogic) markPullSince(fanIds []int64, publishTime time.Time) {
+	for start := 0; start < len(fanIds); start += types.BatchSize {
+		batch := fanIds[start:min(start+types.BatchSize, len(fanIds))]
+		keys := make([]string, 0, len(batch))
+		for _, fanId := range batch {
+			keys = append(keys, fmt.Sprintf(prefixPullSince, fanId))
+		}
+		_, err := l.svcCtx.BizRedis.EvalCtx(context.Background(), markPullSinceScript, keys, publishTime.Unix(), types.PullSinceExpire)
+		if err != nil {
+			l.Logger.Errorf("markPullSince error: %v", err)
+		}
 	}
 }
 
@@ -173,6 +229,28 @@ func (l *OutboxToInboxLogic) updateInboxCacheForInsert(inboxes []*model.UserInbo
 	}
 }
 
+// 推送关注流新内容事件，推送失败不影响收信箱写入
+func (l *OutboxToInboxLogic) pushFeedEvents(inboxes []*model.UserInbox) {
+	for _, inbox := range inboxes {
+		msg, err := push.NewMsg(inbox.UserId, push.EventFeed, map[string]int64{
+			"articleId": inbox.ArticleId,
+			"authorId":  inbox.SenderId,
+		})
+		if err != nil {
+			l.Logger.Errorf("push.NewMsg inbox: %+v error: %v", inbox, err)
+			continue
+		}
+		data, err := json.Marshal(msg)
+		if err != nil {
+			l.Logger.Errorf("Marshal push msg: %+v error: %v", msg, err)
+			continue
+		}
+		if err = l.svcCtx.PushPusherClient.Push(context.Background(), string(data)); err != nil {
+			l.Logger.Errorf("PushPusherClient.Push userId: %d error: %v", inbox.UserId, err)
+		}
+	}
+}
+
 func (l *OutboxToInboxLogic) inboxKey(uid int64) string {
 	return fmt.Sprintf(prefixInbox, uid)
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"posta/application/followingfeed/mq/internal/types"
 )
 
 var _ ArticleModel = (*customArticleModel)(nil)
@@ -15,7 +16,7 @@ type (
 	// and implement the added methods in customArticleModel.
 	ArticleModel interface {
 		articleModel
-		ArticlesByUserId(ctx context.Context, userId int64) ([]*Article, error)
+		ArticlesByUserId(ctx context.Context, userId int64, limit int) ([]*Article, error)
 		UpdateArticleStatus(ctx context.Context, id int64, status int) error
 	}
 
@@ -31,15 +32,15 @@ func NewArticleModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option)
 	}
 }
 
-func (m *customArticleModel) ArticlesByUserId(ctx context.Context, userId int64) ([]*Article, error) {
+func (m *customArticleModel) ArticlesByUserId(ctx context.Context, userId int64, limit int) ([]*Article, error) {
 	var (
 		err      error
 		sql      string
 		articles []*Article
 	)
 	// 注意：这里并不会将行记录加入缓存
-	sql = fmt.Sprintf("select " + articleRows + " from " + m.table + " where author_id=? and publish_time < ? and status=2 order by publish_time desc limit ?")
-	err = m.QueryRowsNoCacheCtx(ctx, &articles, sql, userId)
+	sql = fmt.Sprintf("select " + articleRows + " from " + m.table + " where author_id=? and status=? order by publish_time desc limit ?")
+	err = m.QueryRowsNoCacheCtx(ctx, &articles, sql, userId, types.ArticleStatusVisible, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"posta/application/followingfeed/mq/internal/types"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ AuthorFeedModeModel = (*customAuthorFeedModeModel)(nil)
+
+type (
+	// AuthorFeedModeModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customAuthorFeedModeModel.
+	AuthorFeedModeModel interface {
+		authorFeedModeModel
+		withSession(session sqlx.Session) AuthorFeedModeModel
+		InsertIgnore(ctx context.Context, data *AuthorFeedMode) error
+		SwitchMode(ctx context.Context, userId, from, to int64) (int64, error)
+		FindMigrating(ctx context.Context, limit int) ([]*AuthorFeedMode, error)
+		FinishMigrate(ctx context.Context, id, mode int64) error
+	}
+
+	customAuthorFeedModeModel struct {
+		*defaultAuthorFeedModeModel
+	}
+)
+
+// NewAuthorFeedModeModel returns a model for the database table.
+func NewAuthorFeedModeModel(conn sqlx.SqlConn) AuthorFeedModeModel {
+	return &customAuthorFeedModeModel{
+		defaultAuthorFeedModeModel: newAuthorFeedModeModel(conn),
+	}
+}
+
+func (m *customAuthorFeedModeModel) withSession(session sqlx.Session) AuthorFeedModeModel {
+	return NewAuthorFeedModeModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// InsertIgnore 并发消费时同一个作者可能被同时初始化，已存在时忽略
+func (m *customAuthorFeedModeModel) InsertIgnore(ctx context.Context, data *AuthorFeedMode) error {
+	query := fmt.Sprintf("insert ignore into %s (%s) values (?, ?, ?)", m.table, authorFeedModeRowsExpectAutoSet)
+	_, err := m.conn.ExecCtx(ctx, query, data.UserId, data.Mode, data.MigrateStatus)
+	return err
+}
+
+// SwitchMode 只有当前模式是from时才切换，并标记为迁移中，返回影响的行数
+func (m *customAuthorFeedModeModel) SwitchMode(ctx context.Context, userId, from, to int64) (int64, error) {
+	query := fmt.Sprintf("update %s set `mode` = ?, `migrate_status` = ? where `user_id` = ? and `mode` = ?", m.table)
+	ret, err := m.conn.ExecCtx(ctx, query, to, types.MigrateStatusMigrating, userId, from)
+	if err != nil {
+		return 0, err
+	}
+	return ret.RowsAffected()
+}
+
+func (m *customAuthorFeedModeModel) FindMigrating(ctx context.Context, limit int) ([]*AuthorFeedMode, error) {
+	query := fmt.Sprintf("select %s from %s where `migrate_status` = ? order by `update_time` asc limit ?", authorFeedModeRows, m.table)
+	var modes []*AuthorFeedMode
+	err := m.conn.QueryRowsCtx(ctx, &modes, query, types.MigrateStatusMigrating, limit)
+	if err != nil {
+		return nil, err
+	}
+	return modes, nil
+}
+
+// FinishMigrate 迁移期间模式又被切换回去时不清除迁移状态，由下一轮按新模式重新迁移
+func (m *customAuthorFeedModeModel) FinishMigrate(ctx context.Context, id, mode int64) error {
+	query := fmt.Sprintf("update %s set `migrate_status` = ? where `id` = ? and `mode` = ?", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, types.MigrateStatusNone, id, mode)
+	return err
+}
@@ -34,7 +34,7 @@ func (m *customFollowCountModel) withSession(session sqlx.Session) FollowCountMo
 }
 
 func (m *customFollowCountModel) GetFansCount(ctx context.Context, userId int64) (int64, error) {
-	query := fmt.Sprintf("select fans_count from " + m.table + "where user_id = ?")
+	query := fmt.Sprintf("select fans_count from " + m.table + " where user_id = ?")
 	var fansCount int64
 	err := m.conn.QueryRowCtx(ctx, &fansCount, query, userId)
 	if err != nil {
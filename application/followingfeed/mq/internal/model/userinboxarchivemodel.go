@@ -0,0 +1,59 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ UserInboxArchiveModel = (*customUserInboxArchiveModel)(nil)
+
+type (
+	// UserInboxArchiveModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserInboxArchiveModel.
+	UserInboxArchiveModel interface {
+		userInboxArchiveModel
+		withSession(session sqlx.Session) UserInboxArchiveModel
+		ArchiveByIds(ctx context.Context, ids []int64) error
+	}
+
+	customUserInboxArchiveModel struct {
+		*defaultUserInboxArchiveModel
+	}
+)
+
+// NewUserInboxArchiveModel returns a model for the database table.
+func NewUserInboxArchiveModel(conn sqlx.SqlConn) UserInboxArchiveModel {
+	return &customUserInboxArchiveModel{
+		defaultUserInboxArchiveModel: newUserInboxArchiveModel(conn),
+	}
+}
+
+func (m *customUserInboxArchiveModel) withSession(session sqlx.Session) UserInboxArchiveModel {
+	return NewUserInboxArchiveModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// ArchiveByIds 在一个事务中把收信箱记录复制到归档表后删除
+func (m *customUserInboxArchiveModel) ArchiveByIds(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	columns := "id, user_id, article_id, sender_id, status, publish_time, is_read"
+
+	return m.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("insert ignore into %s (%s) select %s from `user_inbox` where id in (%s)", m.table, columns, columns, placeholders)
+		if _, err := session.ExecCtx(ctx, query, args...); err != nil {
+			return err
+		}
+		query = fmt.Sprintf("delete from `user_inbox` where id in (%s)", placeholders)
+		_, err := session.ExecCtx(ctx, query, args...)
+		return err
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"posta/application/followingfeed/mq/internal/types"
 	"strings"
+	"time"
 )
 
 var _ UserInboxModel = (*customUserInboxModel)(nil)
@@ -20,6 +21,10 @@ type (
 		BatchInsert(ctx context.Context, data []*UserInbox) (sql.Result, error)
 		BatchSetDeleteByArticle(ctx context.Context, articleId int64) (sql.Result, error)
 		BatchSetDeleteBySenRec(ctx context.Context, userId, senderId int64) (sql.Result, error)
+		DeleteBySender(ctx context.Context, senderId int64) (sql.Result, error)
+		UserIdsAfter(ctx context.Context, userId int64, limit int) ([]int64, error)
+		RetentionCutoff(ctx context.Context, userId int64, maxCount int, maxAge time.Time) (time.Time, error)
+		IdsBefore(ctx context.Context, userId int64, before time.Time, limit int) ([]int64, error)
 	}
 
 	customUserInboxModel struct {
@@ -78,3 +83,49 @@ func (m *defaultUserInboxModel) BatchSetDeleteBySenRec(ctx context.Context, user
 	query := fmt.Sprintf("update %s set status=? where user_id=? and sender_id=?", m.table)
 	return m.conn.ExecCtx(ctx, query, types.ArticleStatusUserDelete, userId, senderId)
 }
+
+// 硬删除: 作者切换为拉模式后，删除所有粉丝收信箱中该作者的动态。
+// 不能用软删除，切回推模式时补齐收信箱用的是insert ignore，软删除的记录会占着唯一键，补不回来
+func (m *defaultUserInboxModel) DeleteBySender(ctx context.Context, senderId int64) (sql.Result, error) {
+	query := fmt.Sprintf("delete from %s where sender_id=?", m.table)
+	return m.conn.ExecCtx(ctx, query, senderId)
+}
+
+// UserIdsAfter 按user_id分批遍历有收信箱记录的用户。按ix_user_publish_time的前缀分组，
+// 从userId之后的索引位置开始松散扫描，每个用户只读一条索引记录，读够limit个用户就停止
+func (m *defaultUserInboxModel) UserIdsAfter(ctx context.Context, userId int64, limit int) ([]int64, error) {
+	query := fmt.Sprintf("select user_id from %s force index(ix_user_publish_time) where user_id > ? group by user_id order by user_id limit ?", m.table)
+	var userIds []int64
+	err := m.conn.QueryRowsCtx(ctx, &userIds, query, userId, limit)
+	if err != nil {
+		return nil, err
+	}
+	return userIds, nil
+}
+
+// RetentionCutoff 收信箱保留最新的maxCount条且不早于maxAge，返回保留窗口的下界，早于它的记录需要归档
+func (m *defaultUserInboxModel) RetentionCutoff(ctx context.Context, userId int64, maxCount int, maxAge time.Time) (time.Time, error) {
+	query := fmt.Sprintf("select publish_time from %s where user_id = ? order by publish_time desc limit 1 offset ?", m.table)
+	var cutoff time.Time
+	err := m.conn.QueryRowCtx(ctx, &cutoff, query, userId, maxCount)
+	if err == sqlx.ErrNotFound {
+		return maxAge, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	if cutoff.Before(maxAge) {
+		return maxAge, nil
+	}
+	return cutoff, nil
+}
+
+func (m *defaultUserInboxModel) IdsBefore(ctx context.Context, userId int64, before time.Time, limit int) ([]int64, error) {
+	query := fmt.Sprintf("select id from %s where user_id = ? and publish_time < ? limit ?", m.table)
+	var ids []int64
+	err := m.conn.QueryRowsCtx(ctx, &ids, query, userId, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
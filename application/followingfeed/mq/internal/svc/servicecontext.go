@@ -1,6 +1,7 @@
 package svc
 
 import (
+	"github.com/zeromicro/go-queue/kq"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"posta/application/followingfeed/mq/internal/config"
@@ -8,12 +9,15 @@ import (
 )
 
 type ServiceContext struct {
-	Config           config.Config
-	UserInBoxModel   model.UserInboxModel
-	FollowCountModel model.FollowCountModel
-	FollowModel      model.FollowModel
-	ArticleModel     model.ArticleModel
-	BizRedis         *redis.Redis
+	Config                config.Config
+	UserInBoxModel        model.UserInboxModel
+	UserInboxArchiveModel model.UserInboxArchiveModel
+	AuthorFeedModeModel   model.AuthorFeedModeModel
+	FollowCountModel      model.FollowCountModel
+	FollowModel           model.FollowModel
+	ArticleModel          model.ArticleModel
+	BizRedis              *redis.Redis
+	PushPusherClient      *kq.Pusher
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -27,11 +31,14 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	}
 
 	return &ServiceContext{
-		Config:           c,
-		UserInBoxModel:   model.NewUserInboxModel(sqlx.NewMysql(c.DataSourceFollowingFeed)),
-		FollowModel:      model.NewFollowModel(sqlx.NewMysql(c.DataSourceFollow)),
-		FollowCountModel: model.NewFollowCountModel(sqlx.NewMysql(c.DataSourceFollow)),
-		ArticleModel:     model.NewArticleModel(sqlx.NewMysql(c.DataSourceArticle), c.CacheRedis),
-		BizRedis:         rds,
+		Config:                c,
+		UserInBoxModel:        model.NewUserInboxModel(sqlx.NewMysql(c.DataSourceFollowingFeed)),
+		UserInboxArchiveModel: model.NewUserInboxArchiveModel(sqlx.NewMysql(c.DataSourceFollowingFeed)),
+		AuthorFeedModeModel:   model.NewAuthorFeedModeModel(sqlx.NewMysql(c.DataSourceFollowingFeed)),
+		FollowModel:           model.NewFollowModel(sqlx.NewMysql(c.DataSourceFollow)),
+		FollowCountModel:      model.NewFollowCountModel(sqlx.NewMysql(c.DataSourceFollow)),
+		ArticleModel:          model.NewArticleModel(sqlx.NewMysql(c.DataSourceArticle), c.CacheRedis),
+		BizRedis:              rds,
+		PushPusherClient:      kq.NewPusher(c.PushKqPusherConf.Brokers, c.PushKqPusherConf.Topic),
 	}
 }
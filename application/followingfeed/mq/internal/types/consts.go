@@ -1,8 +1,21 @@
 package types
 
 const (
-	BigUpFansThreshold = 50000
-	BatchSize          = 1000 //小批量插入的数目
+	BatchSize = 1000 //小批量插入的数目
+	// BackfillLimit 关注小up或作者切回推模式时，补进收信箱的最近文章数
+	BackfillLimit = 20
+	// PullSinceExpire 不活跃粉丝漏推标记的过期时间，超过这个时间没回来的粉丝不再补拉
+	PullSinceExpire = 3600 * 24 * 180
+)
+
+const (
+	FeedModePush = iota + 1 // 推模式：发布时写入粉丝收信箱
+	FeedModePull            // 拉模式：粉丝读取时查询作者发件箱
+)
+
+const (
+	MigrateStatusNone      = iota // 无迁移
+	MigrateStatusMigrating        // 切换模式后正在迁移粉丝收信箱
 )
 
 const (
@@ -17,9 +17,12 @@ type (
 	GetFollowingItem     = pb.GetFollowingItem
 	GetFollowingRequest  = pb.GetFollowingRequest
 	GetFollowingResponse = pb.GetFollowingResponse
+	NewFeedCountRequest  = pb.NewFeedCountRequest
+	NewFeedCountResponse = pb.NewFeedCountResponse
 
 	FollowingFeed interface {
 		GetFollowingFeed(ctx context.Context, in *GetFollowingRequest, opts ...grpc.CallOption) (*GetFollowingResponse, error)
+		NewFeedCount(ctx context.Context, in *NewFeedCountRequest, opts ...grpc.CallOption) (*NewFeedCountResponse, error)
 	}
 
 	defaultFollowingFeed struct {
@@ -37,3 +40,8 @@ func (m *defaultFollowingFeed) GetFollowingFeed(ctx context.Context, in *GetFoll
 	client := pb.NewFollowingFeedClient(m.cli.Conn())
 	return client.GetFollowingFeed(ctx, in, opts...)
 }
+
+func (m *defaultFollowingFeed) NewFeedCount(ctx context.Context, in *NewFeedCountRequest, opts ...grpc.CallOption) (*NewFeedCountResponse, error) {
+	client := pb.NewFollowingFeedClient(m.cli.Conn())
+	return client.NewFeedCount(ctx, in, opts...)
+}
@@ -0,0 +1,8 @@
+package code
+
+import "posta/pkg/xcode"
+
+var (
+	UserIdInvalid    = xcode.New(130001, "用户ID无效")
+	PageTokenInvalid = xcode.New(130002, "分页游标无效")
+)
@@ -13,4 +13,11 @@ type Config struct {
 	DataSourceFollowingFeed string
 	CacheRedis              cache.CacheConf
 	BizRedis                redis.RedisConf
+	FollowRPC               zrpc.RpcClientConf
+	// CursorSecret 分页游标的签名密钥
+	CursorSecret string
+	// 还没有分发模式记录的作者，粉丝数达到FansThreshold时按大UP处理，需要和followingfeed-mq的配置一致
+	BigUp struct {
+		FansThreshold int64 `json:",default=50000"`
+	}
 }
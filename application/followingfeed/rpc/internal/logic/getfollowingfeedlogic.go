@@ -5,10 +5,16 @@ import (
 	"fmt"
 	"github.com/zeromicro/go-zero/core/mr"
 	"github.com/zeromicro/go-zero/core/threading"
+	"math"
+	"posta/application/follow/rpc/follow"
+	"posta/application/followingfeed/rpc/internal/code"
 	"posta/application/followingfeed/rpc/internal/model"
 	"posta/application/followingfeed/rpc/internal/types"
+	"posta/pkg/cursor"
+	"slices"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"posta/application/followingfeed/rpc/internal/svc"
@@ -20,8 +26,50 @@ import (
 const (
 	prefixInbox = "biz#inbox#%d"
 	inboxExpire = 3600 * 24 * 2
+
+	// 用户关注的大UP集合，由followingfeed-mq在关注事件和作者切换模式时增量更新，
+	// 每次更新都会给版本号加1，集合不存在时也一样
+	prefixBigUpIds        = "biz#feed#bigup#%d"
+	prefixBigUpIdsVersion = "biz#feed#bigup#%d#version"
+	bigUpIdsExpire        = 3600 * 24 * 3
+	bigUpIdsPlaceholder   = "0"
+
+	// 不活跃期间没有推送到收信箱的第一篇小UP文章的发布时间，由followingfeed-mq写入
+	prefixPullSince = "biz#feed#pull#since#%d"
+
+	// 收信箱归档的最新发布时间，followingfeed-mq归档后删除
+	prefixInboxArchived = "biz#inbox#archived#%d"
+	inboxArchivedExpire = 3600 * 24
+)
+
+// 分页游标中收信箱和发件箱两路各自的子游标，Sort是发布时间，Id分别是收信箱id和文章id
+const (
+	subCursorInbox  = "inbox"
+	subCursorOutbox = "outbox"
 )
 
+// 补拉完成后删除漏推标记，标记被更新过时不删除
+const delPullSinceScript = `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`
+
+// 从数据库加载后写入大UP集合。集合已经存在，或者版本号和查询前不一致时不写入，
+// 说明查询期间followingfeed-mq更新过，查到的结果可能缺了这次更新
+const fillBigUpIdsScript = `
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return 0
+end
+if (redis.call("GET", KEYS[2]) or "") ~= ARGV[1] then
+	return 0
+end
+for i = 3, #ARGV do
+	redis.call("SADD", KEYS[1], ARGV[i])
+end
+redis.call("EXPIRE", KEYS[1], ARGV[2])
+return 1`
+
 type GetFollowingFeedLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -37,15 +85,27 @@ func NewGetFollowingFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 }
 
 func (l *GetFollowingFeedLogic) GetFollowingFeed(in *pb.GetFollowingRequest) (*pb.GetFollowingResponse, error) {
-	if in.PageSize == 0 {
+	if in.PageSize <= 0 {
 		in.PageSize = types.DefaultPageSize
 	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
 
-	if in.CursorSmallUp == 0 {
-		in.CursorSmallUp = time.Now().Unix()
+	scope := followingCursorScope(in)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	inboxCursor, outboxCursor := pageCursor.Sub(subCursorInbox), pageCursor.Sub(subCursorOutbox)
+	if inboxCursor.Sort == 0 {
+		inboxCursor.Sort = time.Now().Unix()
 	}
-	if in.CursorBigUp == 0 {
-		in.CursorBigUp = time.Now().Unix()
+	if outboxCursor.Sort == 0 {
+		// 发件箱按(发布时间, 文章id)翻页，第一页包含当前这一秒发布的所有文章
+		outboxCursor = cursor.Cursor{Sort: time.Now().Unix(), Id: math.MaxInt64}
+	}
+
+	if in.GroupId > 0 {
+		return l.groupFeed(in, scope, outboxCursor)
 	}
 
 	// 1. 获取用户的关注列表，分离大UP
@@ -55,50 +115,317 @@ func (l *GetFollowingFeedLogic) GetFollowingFeed(in *pb.GetFollowingRequest) (*p
 		return nil, err
 	}
 
-	// 2. 从缓存或数据库获取PageSize条小UP收信箱id（这部分类似于articleslogic）
-	smallUpFeedLites, _, cursorSmallUp, lastIdSmallUp, err := l.fetchInbox(l.ctx, in.UserId, in.CursorSmallUp, in.InboxId, in.PageSize)
+	// 拉黑和屏蔽的作者不出现在关注流中，大UP直接不查发件箱，小UP的收信箱在合并时过滤。
+	// 屏蔽就是关注流的免打扰名单，保留关注关系，通过follow-rpc的Block管理
+	hiddenAuthorIds := l.hiddenAuthorIds(in.UserId)
+	bigUpIds = slices.DeleteFunc(bigUpIds, func(id int64) bool {
+		_, ok := hiddenAuthorIds[id]
+		return ok
+	})
+	filter := types.FeedFilter{
+		OnlyWithCover:   in.OnlyWithCover,
+		TagId:           in.TagId,
+		HiddenAuthorIds: hiddenAuthorIds,
+	}
+
+	// 不活跃期间漏推的小UP文章，从发件箱补拉进收信箱
+	if err = l.pullMissedInbox(in.UserId, bigUpIds); err != nil {
+		l.Logger.Errorf("pullMissedInbox userId: %d error: %v", in.UserId, err)
+	}
+
+	// 2. 从缓存或数据库获取PageSize条小UP收信箱id（这部分类似于articleslogic）。
+	// 收信箱中没有封面和标签，按封面和标签筛选时逐条查文章过滤，凑满一页再和发件箱合并
+	var (
+		smallUpFeedLites             []types.InboxItemLite
+		inboxIsEnd                   bool
+		cursorSmallUp, lastIdSmallUp int64
+	)
+	if in.OnlyWithCover || in.TagId > 0 {
+		smallUpFeedLites, inboxIsEnd, cursorSmallUp, lastIdSmallUp, err = l.fetchFilteredInbox(in.UserId, inboxCursor.Sort, inboxCursor.Id, in.PageSize, filter)
+	} else {
+		smallUpFeedLites, inboxIsEnd, cursorSmallUp, lastIdSmallUp, err = l.fetchInbox(l.ctx, in.UserId, inboxCursor.Sort, inboxCursor.Id, in.PageSize)
+	}
 	if err != nil {
 		l.Logger.Errorf("fetchInbox - error: %v", err)
+		cursorSmallUp, lastIdSmallUp = inboxCursor.Sort, inboxCursor.Id
 	}
 
-	// 3. 获取大UP动态（直接查缓存或数据库），
-	bigUpFeedLites, _, cursorBigUp, lastIdBigUp, err := l.fetchOutboxForBigUps(bigUpIds, in.CursorBigUp, in.ArticleId, in.PageSize)
+	// 3. 获取大UP动态（直接查缓存或数据库），收信箱归档的部分也从小UP的发件箱中拉取
+	archivedBefore, archivedUpIds := l.archivedSmallUps(in.UserId, bigUpIds, hiddenAuthorIds)
+	bigUpFeedLites, outboxIsEnd, cursorBigUp, lastIdBigUp, err := l.fetchOutboxForBigUps(types.OutboxQuery{
+		UserIds:         bigUpIds,
+		ArchivedUserIds: archivedUpIds,
+		ArchivedBefore:  archivedBefore,
+		OnlyWithCover:   in.OnlyWithCover,
+		TagId:           in.TagId,
+	}, outboxCursor.Sort, outboxCursor.Id, in.PageSize)
 	if err != nil {
 		logx.Errorf("failed to fetch big UP outbox: %v", err)
+		cursorBigUp, lastIdBigUp = outboxCursor.Sort, outboxCursor.Id
 	}
 
 	// 4. 合并排序大小UP的动态，并获取排序后的前pagesize条数据
-	finalFeed, isEnd := l.mergeAndFetchDetails(
-		smallUpFeedLites, bigUpFeedLites, in.PageSize)
+	merged := l.mergeAndFetchDetails(smallUpFeedLites, bigUpFeedLites, inboxCursor, outboxCursor, in.PageSize, filter)
+
+	// 封装返回结果。两路都到底并且都被选完才算到底，和过滤后的条数无关
+	inboxNext := cursor.Cursor{Sort: cursorSmallUp, Id: lastIdSmallUp}
+	if merged.inboxRest {
+		inboxNext, inboxIsEnd = merged.inboxLast, false
+	}
+	outboxNext := cursor.Cursor{Sort: cursorBigUp, Id: lastIdBigUp}
+	if merged.outboxRest {
+		outboxNext, outboxIsEnd = merged.outboxLast, false
+	}
+	finalFeed := merged.items
 
-	// 封装返回结果
+	var nextCursor cursor.Cursor
+	nextCursor.SetSub(subCursorInbox, inboxNext)
+	nextCursor.SetSub(subCursorOutbox, outboxNext)
 	resp := &pb.GetFollowingResponse{
 		FollowingItems: finalFeed,
-		IsEnd:          isEnd,
-		CursorSmallUp:  cursorSmallUp,
-		InboxId:        lastIdSmallUp,
-		CursorBigUp:    cursorBigUp,
-		ArticleId:      lastIdBigUp,
+		IsEnd:          inboxIsEnd && outboxIsEnd,
+		NextPageToken:  l.svcCtx.CursorCodec.Encode(scope, nextCursor),
 	}
+	l.markRead(in.UserId, smallUpFeedLites, finalFeed, pageCursor.IsZero())
+
 	return resp, nil
 }
 
-func (l *GetFollowingFeedLogic) getBigUpIds(ctx context.Context, userId int64) (bigUpIds []int64, err error) {
+// markRead 把这一页中来自收信箱的动态标记为已读，不阻塞返回。
+// 只有第一页推进看过的最新动态标记，翻到后面的页说明第一页已经看过了
+func (l *GetFollowingFeedLogic) markRead(userId int64, smallUpFeed []types.InboxItemLite, items []*pb.GetFollowingItem, firstPage bool) {
+	if len(items) == 0 {
+		return
+	}
+	served := make(map[int64]struct{}, len(items))
+	var latest int64
+	for _, item := range items {
+		served[item.Id] = struct{}{}
+		latest = max(latest, item.PublishTime)
+	}
+	var inboxIds []int64
+	for _, item := range smallUpFeed {
+		if _, ok := served[item.ArticleId]; ok {
+			inboxIds = append(inboxIds, item.Id)
+		}
+	}
+
+	threading.GoSafe(func() {
+		ctx := context.Background()
+		if err := l.svcCtx.UserInBoxModel.MarkRead(ctx, userId, inboxIds); err != nil {
+			logx.Errorf("UserInBoxModel.MarkRead userId: %d error: %v", userId, err)
+		}
+		if !firstPage {
+			return
+		}
+		if err := advanceFeedMarker(ctx, l.svcCtx, userId, latest); err != nil {
+			logx.Errorf("advanceFeedMarker userId: %d error: %v", userId, err)
+		}
+	})
+}
+
+// groupFeed 分组关注流。分组成员数量有上限，不区分大小UP，直接按作者查文章，和大UP发件箱的查询方式一样
+func (l *GetFollowingFeedLogic) groupFeed(in *pb.GetFollowingRequest, scope string, outboxCursor cursor.Cursor) (*pb.GetFollowingResponse, error) {
+	ret, err := l.svcCtx.FollowRPC.GroupMemberIds(l.ctx, &follow.GroupMemberIdsRequest{
+		UserId:  in.UserId,
+		GroupId: in.GroupId,
+	})
+	if err != nil {
+		l.Logger.Errorf("FollowRPC.GroupMemberIds userId: %d groupId: %d error: %v", in.UserId, in.GroupId, err)
+		return nil, err
+	}
+
+	hiddenAuthorIds := l.hiddenAuthorIds(in.UserId)
+	authorIds := slices.DeleteFunc(ret.MemberUserIds, func(id int64) bool {
+		_, ok := hiddenAuthorIds[id]
+		return ok
+	})
+	if len(authorIds) == 0 {
+		return &pb.GetFollowingResponse{IsEnd: true}, nil
+	}
+
+	articleLites, isEnd, nextSort, articleId, err := l.fetchOutboxForBigUps(types.OutboxQuery{
+		UserIds:       authorIds,
+		OnlyWithCover: in.OnlyWithCover,
+		TagId:         in.TagId,
+	}, outboxCursor.Sort, outboxCursor.Id, in.PageSize)
+	if err != nil {
+		l.Logger.Errorf("fetchOutboxForBigUps groupId: %d error: %v", in.GroupId, err)
+		return nil, err
+	}
+	merged := l.mergeAndFetchDetails(nil, articleLites, cursor.Cursor{}, outboxCursor, in.PageSize, types.FeedFilter{
+		OnlyWithCover:   in.OnlyWithCover,
+		TagId:           in.TagId,
+		HiddenAuthorIds: hiddenAuthorIds,
+	})
+
+	next := cursor.Cursor{Sort: nextSort, Id: articleId}
+	if merged.outboxRest {
+		next, isEnd = merged.outboxLast, false
+	}
+	var nextCursor cursor.Cursor
+	nextCursor.SetSub(subCursorOutbox, next)
+	return &pb.GetFollowingResponse{
+		FollowingItems: merged.items,
+		IsEnd:          isEnd,
+		NextPageToken:  l.svcCtx.CursorCodec.Encode(scope, nextCursor),
+	}, nil
+}
+
+// followingCursorScope 分页游标绑定用户和筛选条件，条件变了要从第一页开始
+func followingCursorScope(in *pb.GetFollowingRequest) string {
+	return fmt.Sprintf("following#%d#%d#%t#%d", in.UserId, in.GroupId, in.OnlyWithCover, in.TagId)
+}
+
+// getBigUpIds 查询关注的作者中需要读发件箱的大UP，优先读缓存的大UP集合
+func (l *GetFollowingFeedLogic) getBigUpIds(ctx context.Context, userId int64) ([]int64, error) {
+	key := fmt.Sprintf(prefixBigUpIds, userId)
+	members, err := l.svcCtx.BizRedis.SmembersCtx(ctx, key)
+	if err != nil {
+		l.Logger.Errorf("SmembersCtx key: %s error: %v", key, err)
+	}
+	if len(members) > 0 {
+		var bigUpIds []int64
+		for _, member := range members {
+			if member == bigUpIdsPlaceholder {
+				continue
+			}
+			id, err := strconv.ParseInt(member, 10, 64)
+			if err != nil {
+				continue
+			}
+			bigUpIds = append(bigUpIds, id)
+		}
+		return bigUpIds, nil
+	}
+
+	// 查询前取版本号，查询期间有更新时不用这次的结果写缓存
+	versionKey := fmt.Sprintf(prefixBigUpIdsVersion, userId)
+	version, verErr := l.svcCtx.BizRedis.GetCtx(ctx, versionKey)
+	if verErr != nil {
+		l.Logger.Errorf("GetCtx key: %s error: %v", versionKey, verErr)
+	}
+	bigUpIds, err := l.bigUpIdsFromDB(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if verErr != nil {
+		return bigUpIds, nil
+	}
+	// 没有关注大UP时也要缓存占位符，避免每次都查数据库
+	args := []any{version, bigUpIdsExpire, bigUpIdsPlaceholder}
+	for _, id := range bigUpIds {
+		args = append(args, strconv.FormatInt(id, 10))
+	}
+	if _, err = l.svcCtx.BizRedis.EvalCtx(ctx, fillBigUpIdsScript, []string{key, versionKey}, args...); err != nil {
+		l.Logger.Errorf("fillBigUpIdsScript key: %s error: %v", key, err)
+	}
+
+	return bigUpIds, nil
+}
+
+// bigUpIdsFromDB 从数据库查询关注的作者中需要读发件箱的大UP。
+// 迁移中的作者收信箱还不完整，也按大UP读发件箱，和收信箱重复的文章在合并时去重。
+func (l *GetFollowingFeedLogic) bigUpIdsFromDB(ctx context.Context, userId int64) (bigUpIds []int64, err error) {
 	followedIds, err := l.svcCtx.FollowModel.GetFollowedIds(l.ctx, userId)
 	if err != nil {
 		l.Logger.Errorf("getBigUpIds - error: %v", err)
 		return nil, err
 	}
 
+	modes, err := l.svcCtx.AuthorFeedModeModel.FindByUserIds(ctx, followedIds)
+	if err != nil {
+		l.Logger.Errorf("AuthorFeedModeModel.FindByUserIds userId: %d error: %v", userId, err)
+		return nil, err
+	}
+	known := make(map[int64]struct{}, len(modes))
+	for _, mode := range modes {
+		known[mode.UserId] = struct{}{}
+		if mode.Mode == types.FeedModePull || mode.MigrateStatus == types.MigrateStatusMigrating {
+			bigUpIds = append(bigUpIds, mode.UserId)
+		}
+	}
+
+	// 还没有分发模式记录的作者，按粉丝数判断
 	for _, followedId := range followedIds {
+		if _, ok := known[followedId]; ok {
+			continue
+		}
 		fansCount, _ := l.svcCtx.FollowCountModel.GetFansCount(l.ctx, followedId)
-		if fansCount >= types.BigUpFansThreshold {
+		if fansCount >= l.svcCtx.Config.BigUp.FansThreshold {
 			bigUpIds = append(bigUpIds, followedId)
 		}
 	}
 	return bigUpIds, nil
 }
 
+// pullMissedInbox 小UP发布文章时不会推送给不活跃的粉丝，粉丝回来后第一次读关注流时，
+// 把漏推标记之后小UP发布的文章补进收信箱，之后的读取和推送的收信箱完全一致
+func (l *GetFollowingFeedLogic) pullMissedInbox(userId int64, bigUpIds []int64) error {
+	key := fmt.Sprintf(prefixPullSince, userId)
+	val, err := l.svcCtx.BizRedis.GetCtx(l.ctx, key)
+	if err != nil || val == "" {
+		return err
+	}
+	since, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	followedIds, err := l.svcCtx.FollowModel.GetFollowedIds(l.ctx, userId)
+	if err != nil {
+		return err
+	}
+	smallUpIds := slices.DeleteFunc(followedIds, func(id int64) bool {
+		return slices.Contains(bigUpIds, id)
+	})
+	// 收信箱只会读到最近的DefaultLimit条，更早的不用补
+	articleLites, err := l.svcCtx.ArticleModel.ArticlesLiteSince(l.ctx, smallUpIds, since, types.DefaultLimit)
+	if err != nil {
+		return err
+	}
+
+	inboxes := make([]*model.UserInbox, 0, len(articleLites))
+	for _, articleLite := range articleLites {
+		inboxes = append(inboxes, &model.UserInbox{
+			UserId:      userId,
+			SenderId:    articleLite.AuthorId,
+			ArticleId:   articleLite.ArticleId,
+			Status:      types.ArticleStatusVisible,
+			PublishTime: time.Unix(articleLite.PublishTime, 0),
+		})
+	}
+	if _, err = l.svcCtx.UserInBoxModel.BatchInsert(l.ctx, inboxes); err != nil {
+		return err
+	}
+	if len(inboxes) > 0 {
+		if _, err = l.svcCtx.BizRedis.DelCtx(l.ctx, inboxKey(userId)); err != nil {
+			return err
+		}
+	}
+
+	_, err = l.svcCtx.BizRedis.EvalCtx(l.ctx, delPullSinceScript, []string{key}, val)
+	return err
+}
+
+// hiddenAuthorIds 查询用户拉黑和屏蔽的作者，查询失败时不过滤
+func (l *GetFollowingFeedLogic) hiddenAuthorIds(userId int64) map[int64]struct{} {
+	ret, err := l.svcCtx.FollowRPC.BlockedIds(l.ctx, &follow.BlockedIdsRequest{UserId: userId})
+	if err != nil {
+		l.Logger.Errorf("FollowRPC.BlockedIds userId: %d error: %v", userId, err)
+		return nil
+	}
+	hiddenAuthorIds := make(map[int64]struct{}, len(ret.BlockedIds)+len(ret.MutedIds))
+	for _, id := range ret.BlockedIds {
+		hiddenAuthorIds[id] = struct{}{}
+	}
+	for _, id := range ret.MutedIds {
+		hiddenAuthorIds[id] = struct{}{}
+	}
+
+	return hiddenAuthorIds
+}
+
 // 输出是articleid，而不是完整的article信息。
 // 缓存的是userinboxid，而不是articleid。
 func (l *GetFollowingFeedLogic) fetchInbox(ctx context.Context, userId, incursor, inboxId, pageSize int64) ([]types.InboxItemLite, bool, int64, int64, error) {
@@ -110,6 +437,8 @@ func (l *GetFollowingFeedLogic) fetchInbox(ctx context.Context, userId, incursor
 		curPage           []types.InboxItemLite
 	)
 
+	// 这一页没有收信箱动态时游标保持不变，避免下一页从头开始读
+	outcursor, lastId = incursor, inboxId
 	publishTime := time.Unix(incursor, 0).Format("2006-01-02 15:04:05")
 
 	smallUpFeedIds, err := l.cacheInbox(l.ctx, userId, incursor, pageSize)
@@ -131,6 +460,7 @@ func (l *GetFollowingFeedLogic) fetchInbox(ctx context.Context, userId, incursor
 				Id:          userInbox.Id,
 				ArticleId:   userInbox.ArticleId,
 				PublishTime: userInbox.PublishTime.Unix(),
+				SenderId:    userInbox.SenderId,
 			})
 		}
 
@@ -154,6 +484,7 @@ func (l *GetFollowingFeedLogic) fetchInbox(ctx context.Context, userId, incursor
 				Id:          userInbox.Id,
 				ArticleId:   userInbox.ArticleId,
 				PublishTime: userInbox.PublishTime.Unix(),
+				SenderId:    userInbox.SenderId,
 			})
 		}
 
@@ -189,6 +520,69 @@ func (l *GetFollowingFeedLogic) fetchInbox(ctx context.Context, userId, incursor
 	return curPage, isEnd, outcursor, lastId, nil
 }
 
+// fetchFilteredInbox 按封面和标签筛选收信箱。收信箱和文章不在一个库，不能联表，
+// 按(发布时间, id)倒序分批读收信箱，每批的文章一次查出来过滤，直到凑满一页或者收信箱到底。
+// 筛选条件很少命中时最多扫描MaxInboxFilterScan条，返回不满一页的结果，下一页从扫描到的位置继续
+func (l *GetFollowingFeedLogic) fetchFilteredInbox(userId, incursor, inboxId, pageSize int64, filter types.FeedFilter) ([]types.InboxItemLite, bool, int64, int64, error) {
+	var (
+		curPage []types.InboxItemLite
+		scanned int
+	)
+	outcursor, lastId := incursor, inboxId
+	if lastId == 0 {
+		// 第一页包含当前这一秒的所有动态
+		lastId = math.MaxInt64
+	}
+	for scanned < types.MaxInboxFilterScan {
+		publishTime := time.Unix(outcursor, 0).Format("2006-01-02 15:04:05")
+		userInboxs, err := l.svcCtx.UserInBoxModel.UserInboxsAfter(l.ctx, userId, publishTime, lastId, types.DefaultLimit)
+		if err != nil {
+			logx.Errorf("UserInboxsAfter userId: %d error: %v", userId, err)
+			return nil, false, 0, 0, err
+		}
+
+		articleIds := make([]int64, 0, len(userInboxs))
+		for _, userInbox := range userInboxs {
+			if _, ok := filter.HiddenAuthorIds[userInbox.SenderId]; !ok {
+				articleIds = append(articleIds, userInbox.ArticleId)
+			}
+		}
+		articles, err := l.svcCtx.ArticleModel.FindByIds(l.ctx, articleIds)
+		if err != nil {
+			l.Logger.Errorf("ArticleModel.FindByIds articleIds: %v error: %v", articleIds, err)
+			return nil, false, 0, 0, err
+		}
+		articleById := make(map[int64]*model.Article, len(articles))
+		for _, article := range articles {
+			articleById[article.Id] = article
+		}
+
+		for _, userInbox := range userInboxs {
+			scanned++
+			outcursor, lastId = userInbox.PublishTime.Unix(), userInbox.Id
+			article, ok := articleById[userInbox.ArticleId]
+			if !ok || !matchFeedFilter(article, filter) {
+				continue
+			}
+			curPage = append(curPage, types.InboxItemLite{
+				Id:          userInbox.Id,
+				ArticleId:   userInbox.ArticleId,
+				PublishTime: userInbox.PublishTime.Unix(),
+				SenderId:    userInbox.SenderId,
+			})
+			if len(curPage) == int(pageSize) {
+				return curPage, false, outcursor, lastId, nil
+			}
+		}
+
+		if len(userInboxs) < types.DefaultLimit {
+			return curPage, true, outcursor, lastId, nil
+		}
+	}
+
+	return curPage, false, outcursor, lastId, nil
+}
+
 func (l *GetFollowingFeedLogic) cacheInbox(ctx context.Context, userId, cursor, pageSize int64) ([]int64, error) {
 	key := inboxKey(userId)
 	b, err := l.svcCtx.BizRedis.ExistsCtx(ctx, key)
@@ -277,7 +671,45 @@ func (l *GetFollowingFeedLogic) addCacheUserInbox(ctx context.Context, userinbox
 	return l.svcCtx.BizRedis.ExpireCtx(ctx, key, inboxExpire)
 }
 
-func (l *GetFollowingFeedLogic) fetchOutboxForBigUps(userIds []int64, inCursor, articleId, pageSize int64) ([]types.ArticleLite, bool, int64, int64, error) {
+// archivedSmallUps 收信箱超出保留窗口的记录会被归档，返回归档的最新发布时间和需要改为拉发件箱的小UP。
+// 收信箱保留的记录都晚于这个时间，所以小UP在这之前的文章从发件箱拉取不会和收信箱重复
+func (l *GetFollowingFeedLogic) archivedSmallUps(userId int64, bigUpIds []int64, hiddenAuthorIds map[int64]struct{}) (int64, []int64) {
+	key := fmt.Sprintf(prefixInboxArchived, userId)
+	val, err := l.svcCtx.BizRedis.GetCtx(l.ctx, key)
+	if err != nil {
+		l.Logger.Errorf("GetCtx key: %s error: %v", key, err)
+	}
+	archivedBefore, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		archivedBefore, err = l.svcCtx.UserInboxArchiveModel.LatestPublishTime(l.ctx, userId)
+		if err != nil {
+			l.Logger.Errorf("UserInboxArchiveModel.LatestPublishTime userId: %d error: %v", userId, err)
+			return 0, nil
+		}
+		if err = l.svcCtx.BizRedis.SetexCtx(l.ctx, key, strconv.FormatInt(archivedBefore, 10), inboxArchivedExpire); err != nil {
+			l.Logger.Errorf("SetexCtx key: %s error: %v", key, err)
+		}
+	}
+	if archivedBefore == 0 {
+		return 0, nil
+	}
+
+	// 归档边界之后才关注的作者，之前的文章从来没有推送进收信箱，不用从发件箱补
+	followedIds, err := l.svcCtx.FollowModel.GetFollowedIdsBefore(l.ctx, userId, archivedBefore)
+	if err != nil {
+		l.Logger.Errorf("GetFollowedIdsBefore userId: %d error: %v", userId, err)
+		return 0, nil
+	}
+	smallUpIds := slices.DeleteFunc(followedIds, func(id int64) bool {
+		if _, ok := hiddenAuthorIds[id]; ok {
+			return true
+		}
+		return slices.Contains(bigUpIds, id)
+	})
+	return archivedBefore, smallUpIds
+}
+
+func (l *GetFollowingFeedLogic) fetchOutboxForBigUps(q types.OutboxQuery, inCursor, articleId, pageSize int64) ([]types.ArticleLite, bool, int64, int64, error) {
 	// 初始化变量
 	var (
 		articleLites  []types.ArticleLite // 用于存储最终的大UP文章列表
@@ -286,7 +718,7 @@ func (l *GetFollowingFeedLogic) fetchOutboxForBigUps(userIds []int64, inCursor,
 		nextArticleId int64               // 下一页游标对应的文章ID（tie-breaking）
 	)
 
-	articleLites, err := l.svcCtx.ArticleModel.ArticlesLiteByUserIds(l.ctx, userIds, inCursor, pageSize)
+	articleLites, err := l.svcCtx.ArticleModel.ArticlesLiteByOutbox(l.ctx, q, inCursor, articleId, pageSize)
 
 	if err != nil {
 		return nil, false, 0, 0, err
@@ -297,12 +729,8 @@ func (l *GetFollowingFeedLogic) fetchOutboxForBigUps(userIds []int64, inCursor,
 		isEnd = true
 	}
 
-	// cursor相同时，利用ArticleId去重
-	for k, articleLite := range articleLites {
-		if articleLite.PublishTime == inCursor && articleLite.ArticleId == articleId {
-			articleLites = articleLites[k:]
-			break
-		}
+	if len(articleLites) == 0 {
+		return nil, true, inCursor, articleId, nil
 	}
 
 	// 下一页游标：取最后一条的发布信息（包括时间和文章ID）
@@ -313,17 +741,29 @@ func (l *GetFollowingFeedLogic) fetchOutboxForBigUps(userIds []int64, inCursor,
 	return articleLites, isEnd, nextCursor, nextArticleId, nil
 }
 
-func (l *GetFollowingFeedLogic) mergeAndFetchDetails(smallUpFeed []types.InboxItemLite, bigUpFeed []types.ArticleLite, pageSize int64) ([]*pb.GetFollowingItem, bool) {
+// mergedFeed 合并后的一页。某一路还有动态没有选进这一页时，这一路的游标退回到这一页中它的最后一条
+type mergedFeed struct {
+	items                 []*pb.GetFollowingItem
+	inboxRest, outboxRest bool
+	inboxLast, outboxLast cursor.Cursor
+}
+
+// mergeAndFetchDetails 合并收信箱和发件箱两路动态，截取一页并查询文章详情。
+// 封面和标签在两路读取时已经过滤，这里不再按条件丢弃，保证一页的条数和到底的判断准确
+func (l *GetFollowingFeedLogic) mergeAndFetchDetails(smallUpFeed []types.InboxItemLite, bigUpFeed []types.ArticleLite, inboxCursor, outboxCursor cursor.Cursor, pageSize int64, filter types.FeedFilter) mergedFeed {
 	type FeedItem struct {
 		ArticleId   int64
 		PublishTime int64
 		IsSmallUp   bool  // 区分数据来源，true 表示来自小UP，false 表示来自大UP
 		Id          int64 // 针对小UP，需要 InboxId 时用，针对大UP为 0
 	}
-	// 1. 合并大小UP的动态，并按时间排序
+	// 1. 合并大小UP的动态，并按时间排序。拉黑和屏蔽的作者在截取一页之前过滤，避免一页的条数变少
 	allFeed := make([]FeedItem, 0, len(smallUpFeed)+len(bigUpFeed))
 
 	for _, item := range smallUpFeed {
+		if _, ok := filter.HiddenAuthorIds[item.SenderId]; ok {
+			continue
+		}
 		allFeed = append(allFeed, FeedItem{
 			ArticleId:   item.ArticleId,
 			PublishTime: item.PublishTime,
@@ -333,6 +773,9 @@ func (l *GetFollowingFeedLogic) mergeAndFetchDetails(smallUpFeed []types.InboxIt
 	}
 
 	for _, item := range bigUpFeed {
+		if _, ok := filter.HiddenAuthorIds[item.AuthorId]; ok {
+			continue
+		}
 		allFeed = append(allFeed, FeedItem{
 			ArticleId:   item.ArticleId,
 			PublishTime: item.PublishTime,
@@ -341,6 +784,16 @@ func (l *GetFollowingFeedLogic) mergeAndFetchDetails(smallUpFeed []types.InboxIt
 		})
 	}
 
+	// 作者切换分发模式的迁移期间，同一篇文章可能同时出现在收信箱和发件箱中
+	seen := make(map[int64]struct{}, len(allFeed))
+	allFeed = slices.DeleteFunc(allFeed, func(item FeedItem) bool {
+		if _, ok := seen[item.ArticleId]; ok {
+			return true
+		}
+		seen[item.ArticleId] = struct{}{}
+		return false
+	})
+
 	sort.Slice(allFeed, func(i, j int) bool {
 		// 首先按发布时间降序排序
 		if allFeed[i].PublishTime == allFeed[j].PublishTime {
@@ -350,13 +803,25 @@ func (l *GetFollowingFeedLogic) mergeAndFetchDetails(smallUpFeed []types.InboxIt
 		return allFeed[i].PublishTime > allFeed[j].PublishTime
 	})
 
-	// 2. 截取前 pageSize 条动态
+	// 2. 截取前 pageSize 条动态，记录两路各自选到的位置
+	merged := mergedFeed{inboxLast: inboxCursor, outboxLast: outboxCursor}
 	selectedFeed := allFeed
-	isEnd := false
-	if len(allFeed) < int(pageSize) {
-		isEnd = true
-	} else {
+	if len(allFeed) > int(pageSize) {
 		selectedFeed = allFeed[:pageSize]
+		for _, item := range allFeed[pageSize:] {
+			if item.IsSmallUp {
+				merged.inboxRest = true
+			} else {
+				merged.outboxRest = true
+			}
+		}
+	}
+	for _, item := range selectedFeed {
+		if item.IsSmallUp {
+			merged.inboxLast = cursor.Cursor{Sort: item.PublishTime, Id: item.Id}
+		} else {
+			merged.outboxLast = cursor.Cursor{Sort: item.PublishTime, Id: item.ArticleId}
+		}
 	}
 
 	// 3. 根据文章ID获取文章详细信息
@@ -371,9 +836,9 @@ func (l *GetFollowingFeedLogic) mergeAndFetchDetails(smallUpFeed []types.InboxIt
 	}
 
 	// 4. 将最终的数据封装成 GetFollowingItem
-	finalFeed := make([]*pb.GetFollowingItem, 0, len(articles))
+	merged.items = make([]*pb.GetFollowingItem, 0, len(articles))
 	for _, article := range articles {
-		finalFeed = append(finalFeed, &pb.GetFollowingItem{
+		merged.items = append(merged.items, &pb.GetFollowingItem{
 			Id:           article.Id,
 			Title:        article.Title,
 			Content:      article.Content,
@@ -386,5 +851,19 @@ func (l *GetFollowingFeedLogic) mergeAndFetchDetails(smallUpFeed []types.InboxIt
 		})
 	}
 
-	return finalFeed, isEnd
+	return merged
+}
+
+// matchFeedFilter 大UP发件箱的过滤条件已经在查询时带上，这里用于筛选收信箱中的动态
+func matchFeedFilter(article *model.Article, filter types.FeedFilter) bool {
+	if _, ok := filter.HiddenAuthorIds[article.AuthorId]; ok {
+		return false
+	}
+	if filter.OnlyWithCover && article.Cover == "" {
+		return false
+	}
+	if filter.TagId > 0 && !slices.Contains(strings.Split(article.TagIds, ","), strconv.FormatInt(filter.TagId, 10)) {
+		return false
+	}
+	return true
 }
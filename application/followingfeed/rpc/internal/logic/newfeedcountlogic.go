@@ -0,0 +1,156 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"posta/application/followingfeed/rpc/internal/code"
+	"posta/application/followingfeed/rpc/internal/svc"
+	"posta/application/followingfeed/rpc/internal/types"
+	"posta/application/followingfeed/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// 用户看过的最新一条动态的发布时间，关注流返回第一页时更新
+	prefixFeedMarker = "biz#feed#marker#%d"
+	feedMarkerExpire = 3600 * 24 * 180
+)
+
+// 只往后推进标记，比标记更早的第一页不会把标记拉回去
+const advanceMarkerScript = `
+local cur = redis.call("GET", KEYS[1])
+if not cur or tonumber(ARGV[1]) > tonumber(cur) then
+	redis.call("SET", KEYS[1], ARGV[1], "EX", ARGV[2])
+end
+return 1`
+
+type NewFeedCountLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewNewFeedCountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NewFeedCountLogic {
+	return &NewFeedCountLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// NewFeedCount 标记之后小UP收信箱和大UP发件箱中的新动态数之和
+func (l *NewFeedCountLogic) NewFeedCount(in *pb.NewFeedCountRequest) (*pb.NewFeedCountResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+
+	marker, err := l.feedMarker(in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := NewGetFollowingFeedLogic(l.ctx, l.svcCtx)
+	bigUpIds, err := feed.getBigUpIds(l.ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	hiddenAuthorIds := feed.hiddenAuthorIds(in.UserId)
+	hiddenIds := make([]int64, 0, len(hiddenAuthorIds))
+	for id := range hiddenAuthorIds {
+		hiddenIds = append(hiddenIds, id)
+	}
+	visibleBigUpIds := make([]int64, 0, len(bigUpIds))
+	for _, id := range bigUpIds {
+		if _, ok := hiddenAuthorIds[id]; !ok {
+			visibleBigUpIds = append(visibleBigUpIds, id)
+		}
+	}
+
+	inboxCount, err := l.svcCtx.UserInBoxModel.CountSince(l.ctx, in.UserId, marker, hiddenIds, types.MaxNewFeedCount)
+	if err != nil {
+		l.Logger.Errorf("UserInBoxModel.CountSince userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+	outboxCount, err := l.svcCtx.ArticleModel.CountSince(l.ctx, visibleBigUpIds, marker, types.MaxNewFeedCount)
+	if err != nil {
+		l.Logger.Errorf("ArticleModel.CountSince userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+
+	// 不活跃期间漏推的动态要到打开关注流时才补进收信箱，这里只数不写，否则刚回来的用户看不到红点
+	missedCount, err := l.missedCount(in.UserId, marker, bigUpIds, hiddenAuthorIds)
+	if err != nil {
+		l.Logger.Errorf("missedCount userId: %d error: %v", in.UserId, err)
+	}
+
+	return &pb.NewFeedCountResponse{
+		Count: min(inboxCount+outboxCount+missedCount, types.MaxNewFeedCount),
+	}, nil
+}
+
+// missedCount 漏推标记之后、看过的位置之后小UP发布的文章数，已经推送进收信箱的不重复计算
+func (l *NewFeedCountLogic) missedCount(userId, marker int64, bigUpIds []int64, hiddenAuthorIds map[int64]struct{}) (int64, error) {
+	val, err := l.svcCtx.BizRedis.GetCtx(l.ctx, fmt.Sprintf(prefixPullSince, userId))
+	if err != nil || val == "" {
+		return 0, err
+	}
+	since, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	followedIds, err := l.svcCtx.FollowModel.GetFollowedIds(l.ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+	smallUpIds := slices.DeleteFunc(followedIds, func(id int64) bool {
+		_, hidden := hiddenAuthorIds[id]
+		return hidden || slices.Contains(bigUpIds, id)
+	})
+	// ArticlesLiteSince包含since这一秒，CountSince不包含marker这一秒
+	articleLites, err := l.svcCtx.ArticleModel.ArticlesLiteSince(l.ctx, smallUpIds, max(since, marker+1), types.MaxNewFeedCount)
+	if err != nil || len(articleLites) == 0 {
+		return 0, err
+	}
+	articleIds := make([]int64, 0, len(articleLites))
+	for _, articleLite := range articleLites {
+		articleIds = append(articleIds, articleLite.ArticleId)
+	}
+	existing, err := l.svcCtx.UserInBoxModel.ExistingArticleIds(l.ctx, userId, articleIds)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(articleIds) - len(existing)), nil
+}
+
+// feedMarker 标记不存在时用收信箱中已读的最新动态代替
+func (l *NewFeedCountLogic) feedMarker(userId int64) (int64, error) {
+	key := fmt.Sprintf(prefixFeedMarker, userId)
+	val, err := l.svcCtx.BizRedis.GetCtx(l.ctx, key)
+	if err != nil {
+		l.Logger.Errorf("GetCtx key: %s error: %v", key, err)
+	}
+	if val != "" {
+		if marker, err := strconv.ParseInt(val, 10, 64); err == nil {
+			return marker, nil
+		}
+	}
+
+	marker, err := l.svcCtx.UserInBoxModel.LatestReadTime(l.ctx, userId)
+	if err != nil {
+		l.Logger.Errorf("UserInBoxModel.LatestReadTime userId: %d error: %v", userId, err)
+		return 0, err
+	}
+	return marker, nil
+}
+
+// advanceFeedMarker 返回关注流第一页后，把这一页中最新的发布时间记为看过的位置
+func advanceFeedMarker(ctx context.Context, svcCtx *svc.ServiceContext, userId, publishTime int64) error {
+	key := fmt.Sprintf(prefixFeedMarker, userId)
+	_, err := svcCtx.BizRedis.EvalCtx(ctx, advanceMarkerScript, []string{key}, publishTime, feedMarkerExpire)
+	return err
+}
@@ -16,7 +16,10 @@ type (
 	// and implement the added methods in customArticleModel.
 	ArticleModel interface {
 		articleModel
-		ArticlesLiteByUserIds(ctx context.Context, userIds []int64, inCursor int64, pageSize int64) ([]types.ArticleLite, error)
+		ArticlesLiteByOutbox(ctx context.Context, q types.OutboxQuery, inCursor, lastId int64, pageSize int64) ([]types.ArticleLite, error)
+		ArticlesLiteSince(ctx context.Context, userIds []int64, since int64, limit int) ([]types.ArticleLite, error)
+		CountSince(ctx context.Context, userIds []int64, since int64, limit int) (int64, error)
+		FindByIds(ctx context.Context, ids []int64) ([]*Article, error)
 	}
 
 	customArticleModel struct {
@@ -31,42 +34,124 @@ func NewArticleModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option)
 	}
 }
 
-func (m *customArticleModel) ArticlesLiteByUserIds(ctx context.Context, userIds []int64, inCursor int64, pageSize int64) ([]types.ArticleLite, error) {
-	// 定义结果切片
-	var articlesLite []types.ArticleLite
-
-	// 检查输入参数
-	if len(userIds) == 0 || pageSize <= 0 {
-		return nil, fmt.Errorf("invalid input: userIds or pageSize must be greater than 0")
+// ArticlesLiteSince 查询一批作者在since之后发布的文章，按发布时间倒序
+func (m *customArticleModel) ArticlesLiteSince(ctx context.Context, userIds []int64, since int64, limit int) ([]types.ArticleLite, error) {
+	if len(userIds) == 0 {
+		return nil, nil
 	}
-
-	// 动态拼接 SQL
-	query := `SELECT id, UNIX_TIMESTAMP(publish_time) AS publish_time
-			  FROM article
-			  WHERE publish_time < FROM_UNIXTIME(?) 
-			  AND status = 2
-			  AND author_id IN (` // 开始构造 IN 子句
 	placeholders := make([]string, len(userIds))
 	args := make([]interface{}, 0, len(userIds)+2)
-	args = append(args, inCursor) // 第一个参数是分页游标 inCursor
-
-	// 动态生成 IN 子句占位符和参数
+	args = append(args, since)
 	for i, id := range userIds {
-		placeholders[i] = "?"   // 为每个 userId 插入一个占位符
-		args = append(args, id) // 添加实际的 userId 参数
+		placeholders[i] = "?"
+		args = append(args, id)
 	}
-	query += strings.Join(placeholders, ",") + `)
+	args = append(args, limit)
+	query := `SELECT id, author_id, UNIX_TIMESTAMP(publish_time) AS publish_time
+			  FROM article
+			  WHERE publish_time >= FROM_UNIXTIME(?)
+			  AND status = 2
+			  AND author_id IN (` + strings.Join(placeholders, ",") + `)
 			  ORDER BY publish_time DESC
 			  LIMIT ?`
 
-	// 添加分页大小参数
+	var articlesLite []types.ArticleLite
+	err := m.QueryRowsPartialNoCacheCtx(ctx, &articlesLite, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	return articlesLite, nil
+}
+
+// CountSince 一批作者在since之后发布的可见文章数，最多数到limit篇
+func (m *customArticleModel) CountSince(ctx context.Context, userIds []int64, since int64, limit int) (int64, error) {
+	if len(userIds) == 0 {
+		return 0, nil
+	}
+	args := make([]interface{}, 0, len(userIds)+2)
+	args = append(args, since)
+	for _, id := range userIds {
+		args = append(args, id)
+	}
+	args = append(args, limit)
+	query := `SELECT count(*) FROM (
+			  SELECT id FROM article
+			  WHERE publish_time > FROM_UNIXTIME(?)
+			  AND status = 2
+			  AND author_id IN (` + strings.TrimSuffix(strings.Repeat("?,", len(userIds)), ",") + `)
+			  LIMIT ?) t`
+
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindByIds 批量查询文章，不走行缓存，返回的顺序和ids无关
+func (m *customArticleModel) FindByIds(ctx context.Context, ids []int64) ([]*Article, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	query := fmt.Sprintf("select %s from %s where `id` in (%s)", articleRows, m.table, strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","))
+
+	var articles []*Article
+	err := m.QueryRowsNoCacheCtx(ctx, &articles, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
+
+// ArticlesLiteByOutbox 按(发布时间, id)倒序查询一批作者发件箱中游标(inCursor, lastId)之后的文章
+func (m *customArticleModel) ArticlesLiteByOutbox(ctx context.Context, q types.OutboxQuery, inCursor, lastId int64, pageSize int64) ([]types.ArticleLite, error) {
+	if len(q.UserIds) == 0 && len(q.ArchivedUserIds) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(q.UserIds)+len(q.ArchivedUserIds)+6)
+	args = append(args, inCursor, inCursor, lastId)
+
+	// 动态拼接作者条件，归档部分的小UP只查ArchivedBefore及之前的文章
+	var authorConds []string
+	if len(q.UserIds) > 0 {
+		authorConds = append(authorConds, "author_id IN ("+strings.TrimSuffix(strings.Repeat("?,", len(q.UserIds)), ",")+")")
+		for _, id := range q.UserIds {
+			args = append(args, id)
+		}
+	}
+	if len(q.ArchivedUserIds) > 0 {
+		authorConds = append(authorConds, "(author_id IN ("+strings.TrimSuffix(strings.Repeat("?,", len(q.ArchivedUserIds)), ",")+") AND publish_time <= FROM_UNIXTIME(?))")
+		for _, id := range q.ArchivedUserIds {
+			args = append(args, id)
+		}
+		args = append(args, q.ArchivedBefore)
+	}
+
+	query := `SELECT id, author_id, UNIX_TIMESTAMP(publish_time) AS publish_time
+			  FROM article
+			  WHERE (publish_time < FROM_UNIXTIME(?) OR (publish_time = FROM_UNIXTIME(?) AND id < ?))
+			  AND status = 2
+			  AND (` + strings.Join(authorConds, " OR ") + `)`
+	if q.OnlyWithCover {
+		query += " AND cover != ''"
+	}
+	if q.TagId > 0 {
+		query += " AND FIND_IN_SET(?, tag_ids)"
+		args = append(args, q.TagId)
+	}
+	query += " ORDER BY publish_time DESC, id DESC LIMIT ?"
 	args = append(args, pageSize)
 
-	// 使用 QueryRowsPartialCtx 实现逐行查询
+	var articlesLite []types.ArticleLite
 	err := m.QueryRowsPartialNoCacheCtx(ctx, &articlesLite, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
-
 	return articlesLite, nil
 }
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ AuthorFeedModeModel = (*customAuthorFeedModeModel)(nil)
+
+type (
+	// AuthorFeedModeModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customAuthorFeedModeModel.
+	AuthorFeedModeModel interface {
+		authorFeedModeModel
+		withSession(session sqlx.Session) AuthorFeedModeModel
+		FindByUserIds(ctx context.Context, userIds []int64) ([]*AuthorFeedMode, error)
+	}
+
+	customAuthorFeedModeModel struct {
+		*defaultAuthorFeedModeModel
+	}
+)
+
+// NewAuthorFeedModeModel returns a model for the database table.
+func NewAuthorFeedModeModel(conn sqlx.SqlConn) AuthorFeedModeModel {
+	return &customAuthorFeedModeModel{
+		defaultAuthorFeedModeModel: newAuthorFeedModeModel(conn),
+	}
+}
+
+func (m *customAuthorFeedModeModel) withSession(session sqlx.Session) AuthorFeedModeModel {
+	return NewAuthorFeedModeModel(sqlx.NewSqlConnFromSession(session))
+}
+
+func (m *customAuthorFeedModeModel) FindByUserIds(ctx context.Context, userIds []int64) ([]*AuthorFeedMode, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(userIds)), ",")
+	args := make([]any, 0, len(userIds))
+	for _, id := range userIds {
+		args = append(args, id)
+	}
+	query := fmt.Sprintf("select %s from %s where `user_id` in (%s)", authorFeedModeRows, m.table, placeholders)
+	var modes []*AuthorFeedMode
+	err := m.conn.QueryRowsCtx(ctx, &modes, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return modes, nil
+}
@@ -16,6 +16,7 @@ type (
 		followModel
 		withSession(session sqlx.Session) FollowModel
 		GetFollowedIds(ctx context.Context, userId int64) ([]int64, error)
+		GetFollowedIdsBefore(ctx context.Context, userId, before int64) ([]int64, error)
 	}
 
 	customFollowModel struct {
@@ -46,3 +47,15 @@ func (m *customFollowModel) GetFollowedIds(ctx context.Context, userId int64) ([
 	}
 	return followedIds, nil
 }
+
+// GetFollowedIdsBefore before及之前就已经关注的用户。关注状态只在关注和取消关注时更新，
+// 正在关注的记录update_time就是最近一次关注的时间，取消后重新关注的从重新关注时算起
+func (m *customFollowModel) GetFollowedIdsBefore(ctx context.Context, userId, before int64) ([]int64, error) {
+	query := "select followed_user_id from " + m.table + " where user_id = ? and follow_status = ? and update_time <= FROM_UNIXTIME(?)"
+	var followedIds []int64
+	err := m.conn.QueryRowsCtx(ctx, &followedIds, query, userId, types.FollowStatusFollow, before)
+	if err != nil {
+		return nil, err
+	}
+	return followedIds, nil
+}
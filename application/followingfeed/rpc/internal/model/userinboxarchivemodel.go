@@ -0,0 +1,46 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ UserInboxArchiveModel = (*customUserInboxArchiveModel)(nil)
+
+type (
+	// UserInboxArchiveModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserInboxArchiveModel.
+	UserInboxArchiveModel interface {
+		userInboxArchiveModel
+		withSession(session sqlx.Session) UserInboxArchiveModel
+		LatestPublishTime(ctx context.Context, userId int64) (int64, error)
+	}
+
+	customUserInboxArchiveModel struct {
+		*defaultUserInboxArchiveModel
+	}
+)
+
+// NewUserInboxArchiveModel returns a model for the database table.
+func NewUserInboxArchiveModel(conn sqlx.SqlConn) UserInboxArchiveModel {
+	return &customUserInboxArchiveModel{
+		defaultUserInboxArchiveModel: newUserInboxArchiveModel(conn),
+	}
+}
+
+func (m *customUserInboxArchiveModel) withSession(session sqlx.Session) UserInboxArchiveModel {
+	return NewUserInboxArchiveModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// LatestPublishTime 用户归档的最新一条动态的发布时间，没有归档时返回0
+func (m *customUserInboxArchiveModel) LatestPublishTime(ctx context.Context, userId int64) (int64, error) {
+	query := fmt.Sprintf("select ifnull(unix_timestamp(max(publish_time)), 0) from %s where user_id = ?", m.table)
+	var latest int64
+	err := m.conn.QueryRowCtx(ctx, &latest, query, userId)
+	if err != nil {
+		return 0, err
+	}
+	return latest, nil
+}
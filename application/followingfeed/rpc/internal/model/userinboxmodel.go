@@ -2,8 +2,10 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"strings"
 )
 
 var _ UserInboxModel = (*customUserInboxModel)(nil)
@@ -15,6 +17,12 @@ type (
 		userInboxModel
 		withSession(session sqlx.Session) UserInboxModel
 		UserInboxsByUserId(ctx context.Context, userId int64, pubTime string, limit int) ([]*UserInbox, error)
+		UserInboxsAfter(ctx context.Context, userId int64, pubTime string, lastId int64, limit int) ([]*UserInbox, error)
+		BatchInsert(ctx context.Context, data []*UserInbox) (sql.Result, error)
+		MarkRead(ctx context.Context, userId int64, ids []int64) error
+		CountSince(ctx context.Context, userId, since int64, excludeSenderIds []int64, limit int) (int64, error)
+		LatestReadTime(ctx context.Context, userId int64) (int64, error)
+		ExistingArticleIds(ctx context.Context, userId int64, articleIds []int64) ([]int64, error)
 	}
 
 	customUserInboxModel struct {
@@ -43,3 +51,104 @@ func (m *customUserInboxModel) UserInboxsByUserId(ctx context.Context, userId in
 	}
 	return userInboxs, nil
 }
+
+// UserInboxsAfter 按(publish_time, id)倒序查询游标之后的收信箱动态，同一秒发布的动态用id区分先后
+func (m *customUserInboxModel) UserInboxsAfter(ctx context.Context, userId int64, pubTime string, lastId int64, limit int) ([]*UserInbox, error) {
+	var userInboxs []*UserInbox
+	sql := "select " + userInboxRows + " from " + m.table + " where user_id=? and (publish_time < ? or (publish_time = ? and id < ?)) and status=2 order by publish_time desc, id desc limit ?"
+	err := m.conn.QueryRowsCtx(ctx, &userInboxs, sql, userId, pubTime, pubTime, lastId, limit)
+	if err != nil {
+		return nil, err
+	}
+	return userInboxs, nil
+}
+
+// 批量插入，和followingfeed-mq中的一致，已经在收信箱中的动态忽略
+func (m *customUserInboxModel) BatchInsert(ctx context.Context, data []*UserInbox) (sql.Result, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	query := "insert ignore into " + m.table + " (user_id, article_id, sender_id, publish_time, status, is_read) values "
+	valueStrings := make([]string, 0, len(data))
+	valueArgs := make([]interface{}, 0, len(data)*6)
+	for _, row := range data {
+		valueStrings = append(valueStrings, "(?, ?, ?, ?, ?, ?)")
+		valueArgs = append(valueArgs,
+			row.UserId,
+			row.ArticleId,
+			row.SenderId,
+			row.PublishTime,
+			row.Status,
+			row.IsRead,
+		)
+	}
+	query += strings.Join(valueStrings, ",")
+	return m.conn.ExecCtx(ctx, query, valueArgs...)
+}
+
+// MarkRead 把一页中来自收信箱的动态标记为已读
+func (m *customUserInboxModel) MarkRead(ctx context.Context, userId int64, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, len(ids)+1)
+	args = append(args, userId)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	query := fmt.Sprintf("update %s set is_read=1 where user_id=? and id in (%s) and is_read=0", m.table, placeholders)
+	_, err := m.conn.ExecCtx(ctx, query, args...)
+	return err
+}
+
+// CountSince 收信箱中since之后的可见动态数，最多数到limit条
+func (m *customUserInboxModel) CountSince(ctx context.Context, userId, since int64, excludeSenderIds []int64, limit int) (int64, error) {
+	args := []interface{}{userId, since}
+	query := "select id from " + m.table + " where user_id=? and publish_time > FROM_UNIXTIME(?) and status=2"
+	if len(excludeSenderIds) > 0 {
+		query += " and sender_id not in (" + strings.TrimSuffix(strings.Repeat("?,", len(excludeSenderIds)), ",") + ")"
+		for _, id := range excludeSenderIds {
+			args = append(args, id)
+		}
+	}
+	args = append(args, limit)
+
+	var count int64
+	err := m.conn.QueryRowCtx(ctx, &count, "select count(*) from ("+query+" limit ?) t", args...)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// LatestReadTime 已读的最新一条动态的发布时间，没有已读动态时返回0
+func (m *customUserInboxModel) LatestReadTime(ctx context.Context, userId int64) (int64, error) {
+	query := fmt.Sprintf("select ifnull(unix_timestamp(max(publish_time)), 0) from %s where user_id=? and is_read=1", m.table)
+	var latest int64
+	err := m.conn.QueryRowCtx(ctx, &latest, query, userId)
+	if err != nil {
+		return 0, err
+	}
+	return latest, nil
+}
+
+// ExistingArticleIds articleIds中已经在用户收信箱里的文章
+func (m *customUserInboxModel) ExistingArticleIds(ctx context.Context, userId int64, articleIds []int64) ([]int64, error) {
+	if len(articleIds) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, 0, len(articleIds)+1)
+	args = append(args, userId)
+	for _, id := range articleIds {
+		args = append(args, id)
+	}
+	query := fmt.Sprintf("select article_id from %s where user_id=? and article_id in (%s)",
+		m.table, strings.TrimSuffix(strings.Repeat("?,", len(articleIds)), ","))
+	var ids []int64
+	err := m.conn.QueryRowsCtx(ctx, &ids, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
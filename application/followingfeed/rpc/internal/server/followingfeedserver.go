@@ -27,3 +27,8 @@ func (s *FollowingFeedServer) GetFollowingFeed(ctx context.Context, in *pb.GetFo
 	l := logic.NewGetFollowingFeedLogic(ctx, s.svcCtx)
 	return l.GetFollowingFeed(in)
 }
+
+func (s *FollowingFeedServer) NewFeedCount(ctx context.Context, in *pb.NewFeedCountRequest) (*pb.NewFeedCountResponse, error) {
+	l := logic.NewNewFeedCountLogic(ctx, s.svcCtx)
+	return l.NewFeedCount(in)
+}
@@ -3,19 +3,26 @@ package svc
 import (
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
 	"golang.org/x/sync/singleflight"
+	"posta/application/follow/rpc/follow"
 	"posta/application/followingfeed/rpc/internal/config"
 	"posta/application/followingfeed/rpc/internal/model"
+	"posta/pkg/cursor"
 )
 
 type ServiceContext struct {
-	Config            config.Config
-	UserInBoxModel    model.UserInboxModel
-	FollowModel       model.FollowModel
-	ArticleModel      model.ArticleModel
-	FollowCountModel  model.FollowCountModel
-	BizRedis          *redis.Redis
-	SingleFlightGroup singleflight.Group
+	Config                config.Config
+	UserInBoxModel        model.UserInboxModel
+	UserInboxArchiveModel model.UserInboxArchiveModel
+	AuthorFeedModeModel   model.AuthorFeedModeModel
+	FollowModel           model.FollowModel
+	ArticleModel          model.ArticleModel
+	FollowCountModel      model.FollowCountModel
+	BizRedis              *redis.Redis
+	SingleFlightGroup     singleflight.Group
+	FollowRPC             follow.Follow
+	CursorCodec           *cursor.Codec
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -27,11 +34,15 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	})
 
 	return &ServiceContext{
-		Config:           c,
-		UserInBoxModel:   model.NewUserInboxModel(sqlx.NewMysql(c.DataSourceFollowingFeed)),
-		FollowModel:      model.NewFollowModel(sqlx.NewMysql(c.DataSourceFollow)),
-		FollowCountModel: model.NewFollowCountModel(sqlx.NewMysql(c.DataSourceFollow)),
-		ArticleModel:     model.NewArticleModel(sqlx.NewMysql(c.DataSourceArticle), c.CacheRedis),
-		BizRedis:         rds,
+		Config:                c,
+		UserInBoxModel:        model.NewUserInboxModel(sqlx.NewMysql(c.DataSourceFollowingFeed)),
+		UserInboxArchiveModel: model.NewUserInboxArchiveModel(sqlx.NewMysql(c.DataSourceFollowingFeed)),
+		AuthorFeedModeModel:   model.NewAuthorFeedModeModel(sqlx.NewMysql(c.DataSourceFollowingFeed)),
+		FollowModel:           model.NewFollowModel(sqlx.NewMysql(c.DataSourceFollow)),
+		FollowCountModel:      model.NewFollowCountModel(sqlx.NewMysql(c.DataSourceFollow)),
+		ArticleModel:          model.NewArticleModel(sqlx.NewMysql(c.DataSourceArticle), c.CacheRedis),
+		BizRedis:              rds,
+		FollowRPC:             follow.NewFollow(zrpc.MustNewClient(c.FollowRPC)),
+		CursorCodec:           cursor.NewCodec(c.CursorSecret),
 	}
 }
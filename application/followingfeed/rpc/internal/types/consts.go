@@ -2,7 +2,15 @@ package types
 
 const (
 	DefaultPageSize = 20
-	DefaultLimit    = 200
+	// MaxPageSize 每页最多的条数，第一页回源时多查的条数要比它大，否则判断不了是否到底
+	MaxPageSize  = 50
+	DefaultLimit = 200
+
+	// MaxInboxFilterScan 按封面和标签筛选收信箱时，一次请求最多扫描的收信箱条数
+	MaxInboxFilterScan = 1000
+
+	// MaxNewFeedCount 新动态数的上限，超过时客户端显示99+
+	MaxNewFeedCount = 99
 )
 
 const (
@@ -11,5 +19,16 @@ const (
 )
 
 const (
-	BigUpFansThreshold = 50000
+	// ArticleStatusVisible 可见
+	ArticleStatusVisible = 2
+)
+
+const (
+	FeedModePush = iota + 1 // 推模式：发布时写入粉丝收信箱
+	FeedModePull            // 拉模式：粉丝读取时查询作者发件箱
+)
+
+const (
+	MigrateStatusNone      = iota // 无迁移
+	MigrateStatusMigrating        // 切换模式后正在迁移粉丝收信箱
 )
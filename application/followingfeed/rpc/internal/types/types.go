@@ -4,9 +4,27 @@ type InboxItemLite struct {
 	Id          int64
 	ArticleId   int64
 	PublishTime int64
+	SenderId    int64
 }
 
 type ArticleLite struct {
 	ArticleId   int64 `db:"id"`
 	PublishTime int64 `db:"publish_time"`
+	AuthorId    int64 `db:"author_id"`
+}
+
+// OutboxQuery 查询作者发件箱的条件
+type OutboxQuery struct {
+	UserIds         []int64 // 查询全部文章的作者
+	ArchivedUserIds []int64 // 只查询ArchivedBefore及之前文章的作者，用于收信箱归档的部分
+	ArchivedBefore  int64
+	OnlyWithCover   bool
+	TagId           int64
+}
+
+// FeedFilter 关注流的过滤条件
+type FeedFilter struct {
+	OnlyWithCover   bool
+	TagId           int64
+	HiddenAuthorIds map[int64]struct{} // 拉黑和屏蔽的作者
 }
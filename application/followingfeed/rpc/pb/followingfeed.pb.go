@@ -24,11 +24,11 @@ const (
 type GetFollowingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	CursorSmallUp int64                  `protobuf:"varint,2,opt,name=cursorSmallUp,proto3" json:"cursorSmallUp,omitempty"` // 存储的是时间，用于游标分页
-	InboxId       int64                  `protobuf:"varint,3,opt,name=inboxId,proto3" json:"inboxId,omitempty"`             // 用于当cursor重复时即进一步区分
-	CursorBigUp   int64                  `protobuf:"varint,4,opt,name=cursorBigUp,proto3" json:"cursorBigUp,omitempty"`
-	ArticleId     int64                  `protobuf:"varint,5,opt,name=articleId,proto3" json:"articleId,omitempty"`
 	PageSize      int64                  `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	GroupId       int64                  `protobuf:"varint,7,opt,name=groupId,proto3" json:"groupId,omitempty"`             // 大于0时只看该关注分组中作者的动态
+	OnlyWithCover bool                   `protobuf:"varint,8,opt,name=onlyWithCover,proto3" json:"onlyWithCover,omitempty"` // 只看有封面的文章
+	TagId         int64                  `protobuf:"varint,9,opt,name=tagId,proto3" json:"tagId,omitempty"`                 // 大于0时只看带这个标签的文章
+	PageToken     string                 `protobuf:"bytes,10,opt,name=pageToken,proto3" json:"pageToken,omitempty"`         // 上一页返回的nextPageToken，第一页传空，翻页时其他条件要和第一页一致
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -70,39 +70,39 @@ func (x *GetFollowingRequest) GetUserId() int64 {
 	return 0
 }
 
-func (x *GetFollowingRequest) GetCursorSmallUp() int64 {
+func (x *GetFollowingRequest) GetPageSize() int64 {
 	if x != nil {
-		return x.CursorSmallUp
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *GetFollowingRequest) GetInboxId() int64 {
+func (x *GetFollowingRequest) GetGroupId() int64 {
 	if x != nil {
-		return x.InboxId
+		return x.GroupId
 	}
 	return 0
 }
 
-func (x *GetFollowingRequest) GetCursorBigUp() int64 {
+func (x *GetFollowingRequest) GetOnlyWithCover() bool {
 	if x != nil {
-		return x.CursorBigUp
+		return x.OnlyWithCover
 	}
-	return 0
+	return false
 }
 
-func (x *GetFollowingRequest) GetArticleId() int64 {
+func (x *GetFollowingRequest) GetTagId() int64 {
 	if x != nil {
-		return x.ArticleId
+		return x.TagId
 	}
 	return 0
 }
 
-func (x *GetFollowingRequest) GetPageSize() int64 {
+func (x *GetFollowingRequest) GetPageToken() string {
 	if x != nil {
-		return x.PageSize
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
 type GetFollowingItem struct {
@@ -217,10 +217,7 @@ type GetFollowingResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	FollowingItems []*GetFollowingItem    `protobuf:"bytes,1,rep,name=followingItems,proto3" json:"followingItems,omitempty"`
 	IsEnd          bool                   `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
-	CursorSmallUp  int64                  `protobuf:"varint,3,opt,name=cursorSmallUp,proto3" json:"cursorSmallUp,omitempty"`
-	InboxId        int64                  `protobuf:"varint,4,opt,name=inboxId,proto3" json:"inboxId,omitempty"`
-	CursorBigUp    int64                  `protobuf:"varint,5,opt,name=cursorBigUp,proto3" json:"cursorBigUp,omitempty"`
-	ArticleId      int64                  `protobuf:"varint,6,opt,name=articleId,proto3" json:"articleId,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,7,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -269,30 +266,98 @@ func (x *GetFollowingResponse) GetIsEnd() bool {
 	return false
 }
 
-func (x *GetFollowingResponse) GetCursorSmallUp() int64 {
+func (x *GetFollowingResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.CursorSmallUp
+		return x.NextPageToken
 	}
-	return 0
+	return ""
+}
+
+// NewFeedCountRequest 上次看过的最新动态之后又有多少条新动态，用于关注流的红点
+type NewFeedCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewFeedCountRequest) Reset() {
+	*x = NewFeedCountRequest{}
+	mi := &file_followingfeed_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewFeedCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetFollowingResponse) GetInboxId() int64 {
+func (*NewFeedCountRequest) ProtoMessage() {}
+
+func (x *NewFeedCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_followingfeed_proto_msgTypes[3]
 	if x != nil {
-		return x.InboxId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewFeedCountRequest.ProtoReflect.Descriptor instead.
+func (*NewFeedCountRequest) Descriptor() ([]byte, []int) {
+	return file_followingfeed_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetFollowingResponse) GetCursorBigUp() int64 {
+func (x *NewFeedCountRequest) GetUserId() int64 {
 	if x != nil {
-		return x.CursorBigUp
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetFollowingResponse) GetArticleId() int64 {
+type NewFeedCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // 最多返回99，客户端显示为99+
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewFeedCountResponse) Reset() {
+	*x = NewFeedCountResponse{}
+	mi := &file_followingfeed_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewFeedCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewFeedCountResponse) ProtoMessage() {}
+
+func (x *NewFeedCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_followingfeed_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewFeedCountResponse.ProtoReflect.Descriptor instead.
+func (*NewFeedCountResponse) Descriptor() ([]byte, []int) {
+	return file_followingfeed_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NewFeedCountResponse) GetCount() int64 {
 	if x != nil {
-		return x.ArticleId
+		return x.Count
 	}
 	return 0
 }
@@ -301,14 +366,15 @@ var File_followingfeed_proto protoreflect.FileDescriptor
 
 const file_followingfeed_proto_rawDesc = "" +
 	"\n" +
-	"\x13followingfeed.proto\x12\x02pb\"\xc9\x01\n" +
+	"\x13followingfeed.proto\x12\x02pb\"\x85\x02\n" +
 	"\x13GetFollowingRequest\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12$\n" +
-	"\rcursorSmallUp\x18\x02 \x01(\x03R\rcursorSmallUp\x12\x18\n" +
-	"\ainboxId\x18\x03 \x01(\x03R\ainboxId\x12 \n" +
-	"\vcursorBigUp\x18\x04 \x01(\x03R\vcursorBigUp\x12\x1c\n" +
-	"\tarticleId\x18\x05 \x01(\x03R\tarticleId\x12\x1a\n" +
-	"\bpageSize\x18\x06 \x01(\x03R\bpageSize\"\x8a\x02\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bpageSize\x18\x06 \x01(\x03R\bpageSize\x12\x18\n" +
+	"\agroupId\x18\a \x01(\x03R\agroupId\x12$\n" +
+	"\ronlyWithCover\x18\b \x01(\bR\ronlyWithCover\x12\x14\n" +
+	"\x05tagId\x18\t \x01(\x03R\x05tagId\x12\x1c\n" +
+	"\tpageToken\x18\n" +
+	" \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\rcursorSmallUpR\ainboxIdR\vcursorBigUpR\tarticleId\"\x8a\x02\n" +
 	"\x10GetFollowingItem\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -318,16 +384,18 @@ const file_followingfeed_proto_rawDesc = "" +
 	"\fcommentCount\x18\x06 \x01(\x03R\fcommentCount\x12\x1c\n" +
 	"\tlikeCount\x18\a \x01(\x03R\tlikeCount\x12 \n" +
 	"\vpublishTime\x18\b \x01(\x03R\vpublishTime\x12\x1a\n" +
-	"\bauthorId\x18\t \x01(\x03R\bauthorId\"\xea\x01\n" +
+	"\bauthorId\x18\t \x01(\x03R\bauthorId\"\xd8\x01\n" +
 	"\x14GetFollowingResponse\x12<\n" +
 	"\x0efollowingItems\x18\x01 \x03(\v2\x14.pb.GetFollowingItemR\x0efollowingItems\x12\x14\n" +
 	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12$\n" +
-	"\rcursorSmallUp\x18\x03 \x01(\x03R\rcursorSmallUp\x12\x18\n" +
-	"\ainboxId\x18\x04 \x01(\x03R\ainboxId\x12 \n" +
-	"\vcursorBigUp\x18\x05 \x01(\x03R\vcursorBigUp\x12\x1c\n" +
-	"\tarticleId\x18\x06 \x01(\x03R\tarticleId2V\n" +
+	"\rnextPageToken\x18\a \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\rcursorSmallUpR\ainboxIdR\vcursorBigUpR\tarticleId\"-\n" +
+	"\x13NewFeedCountRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\",\n" +
+	"\x14NewFeedCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count2\x99\x01\n" +
 	"\rFollowingFeed\x12E\n" +
-	"\x10GetFollowingFeed\x12\x17.pb.GetFollowingRequest\x1a\x18.pb.GetFollowingResponseB\x06Z\x04./pbb\x06proto3"
+	"\x10GetFollowingFeed\x12\x17.pb.GetFollowingRequest\x1a\x18.pb.GetFollowingResponse\x12A\n" +
+	"\fNewFeedCount\x12\x17.pb.NewFeedCountRequest\x1a\x18.pb.NewFeedCountResponseB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_followingfeed_proto_rawDescOnce sync.Once
@@ -341,18 +409,22 @@ func file_followingfeed_proto_rawDescGZIP() []byte {
 	return file_followingfeed_proto_rawDescData
 }
 
-var file_followingfeed_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_followingfeed_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_followingfeed_proto_goTypes = []any{
 	(*GetFollowingRequest)(nil),  // 0: pb.GetFollowingRequest
 	(*GetFollowingItem)(nil),     // 1: pb.GetFollowingItem
 	(*GetFollowingResponse)(nil), // 2: pb.GetFollowingResponse
+	(*NewFeedCountRequest)(nil),  // 3: pb.NewFeedCountRequest
+	(*NewFeedCountResponse)(nil), // 4: pb.NewFeedCountResponse
 }
 var file_followingfeed_proto_depIdxs = []int32{
 	1, // 0: pb.GetFollowingResponse.followingItems:type_name -> pb.GetFollowingItem
 	0, // 1: pb.FollowingFeed.GetFollowingFeed:input_type -> pb.GetFollowingRequest
-	2, // 2: pb.FollowingFeed.GetFollowingFeed:output_type -> pb.GetFollowingResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
+	3, // 2: pb.FollowingFeed.NewFeedCount:input_type -> pb.NewFeedCountRequest
+	2, // 3: pb.FollowingFeed.GetFollowingFeed:output_type -> pb.GetFollowingResponse
+	4, // 4: pb.FollowingFeed.NewFeedCount:output_type -> pb.NewFeedCountResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
 	1, // [1:1] is the sub-list for extension extendee
 	0, // [0:1] is the sub-list for field type_name
@@ -369,7 +441,7 @@ func file_followingfeed_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_followingfeed_proto_rawDesc), len(file_followingfeed_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	FollowingFeed_GetFollowingFeed_FullMethodName = "/pb.FollowingFeed/GetFollowingFeed"
+	FollowingFeed_NewFeedCount_FullMethodName     = "/pb.FollowingFeed/NewFeedCount"
 )
 
 // FollowingFeedClient is the client API for FollowingFeed service.
@@ -27,6 +28,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FollowingFeedClient interface {
 	GetFollowingFeed(ctx context.Context, in *GetFollowingRequest, opts ...grpc.CallOption) (*GetFollowingResponse, error)
+	NewFeedCount(ctx context.Context, in *NewFeedCountRequest, opts ...grpc.CallOption) (*NewFeedCountResponse, error)
 }
 
 type followingFeedClient struct {
@@ -47,11 +49,22 @@ func (c *followingFeedClient) GetFollowingFeed(ctx context.Context, in *GetFollo
 	return out, nil
 }
 
+func (c *followingFeedClient) NewFeedCount(ctx context.Context, in *NewFeedCountRequest, opts ...grpc.CallOption) (*NewFeedCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NewFeedCountResponse)
+	err := c.cc.Invoke(ctx, FollowingFeed_NewFeedCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FollowingFeedServer is the server API for FollowingFeed service.
 // All implementations must embed UnimplementedFollowingFeedServer
 // for forward compatibility.
 type FollowingFeedServer interface {
 	GetFollowingFeed(context.Context, *GetFollowingRequest) (*GetFollowingResponse, error)
+	NewFeedCount(context.Context, *NewFeedCountRequest) (*NewFeedCountResponse, error)
 	mustEmbedUnimplementedFollowingFeedServer()
 }
 
@@ -65,6 +78,9 @@ type UnimplementedFollowingFeedServer struct{}
 func (UnimplementedFollowingFeedServer) GetFollowingFeed(context.Context, *GetFollowingRequest) (*GetFollowingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFollowingFeed not implemented")
 }
+func (UnimplementedFollowingFeedServer) NewFeedCount(context.Context, *NewFeedCountRequest) (*NewFeedCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewFeedCount not implemented")
+}
 func (UnimplementedFollowingFeedServer) mustEmbedUnimplementedFollowingFeedServer() {}
 func (UnimplementedFollowingFeedServer) testEmbeddedByValue()                       {}
 
@@ -104,6 +120,24 @@ func _FollowingFeed_GetFollowingFeed_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FollowingFeed_NewFeedCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewFeedCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowingFeedServer).NewFeedCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowingFeed_NewFeedCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowingFeedServer).NewFeedCount(ctx, req.(*NewFeedCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FollowingFeed_ServiceDesc is the grpc.ServiceDesc for FollowingFeed service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +149,10 @@ var FollowingFeed_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFollowingFeed",
 			Handler:    _FollowingFeed_GetFollowingFeed_Handler,
 		},
+		{
+			MethodName: "NewFeedCount",
+			Handler:    _FollowingFeed_NewFeedCount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "followingfeed.proto",
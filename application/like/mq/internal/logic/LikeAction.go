@@ -55,9 +55,9 @@ func (l *LikeActionLogic) Consume(ctx context.Context, key, val string) error {
 
 func (l *LikeActionLogic) hanleLikeAction(ctx context.Context, svcCtx *svc.ServiceContext, msg *types.LikeActionMsg) error {
 	if msg.LikeAction == 0 {
-		_, err := svcCtx.LikeModel.InsertIgnore(ctx, msg.BizId, msg.ObjId, msg.UserId)
+		_, err := svcCtx.LikeModel.InsertOrUpdateReaction(ctx, msg.BizId, msg.ObjId, msg.UserId, int64(msg.ReactionType))
 		if err != nil {
-			l.Logger.Errorf("LikeActionMsg InsertIgnore err:%v", err)
+			l.Logger.Errorf("LikeActionMsg InsertOrUpdateReaction err:%v", err)
 			return err
 		}
 	} else {
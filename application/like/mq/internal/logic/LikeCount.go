@@ -25,7 +25,14 @@ func NewLikeCountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LikeCou
 	}
 }
 
-const dirtyKeysSetKey = "like:dirty_keys" // 存储redis中有变化的点赞数key名
+const (
+	dirtyKeysSetKey          = "like:dirty_keys"     // 存储redis中有变化的点赞数key名
+	likeCountKeyType         = "like_count"          // biz#like_count#[bizid]#[targetid] -> string(count)
+	likeReactionCountKeyType = "like_reaction_count" // biz#like_reaction_count#[bizid]#[targetid] -> hash(表态类型 -> count)
+
+	// 赞的表态类型，数量由like-rpc用总数减去其他类型得到，不落库
+	reactionLike = 0
+)
 
 func (l *LikeCountLogic) StartLikeCountFlusher(ctx context.Context) {
 	threading.GoSafe(func() {
@@ -55,50 +62,93 @@ func (l *LikeCountLogic) flushLikeCounts(ctx context.Context) error {
 		return err
 	}
 	for _, key := range dirtyKeys {
-		bizId, objId, err := l.parseLikeCountKey(key)
+		keyType, bizId, objId, err := l.parseLikeCountKey(key)
 		if err != nil {
 			l.Logger.Errorf("parseLikeCountKey err:%v", err)
 			continue
 		}
 
-		countStr, err := l.svcCtx.BizRedis.GetCtx(ctx, key)
-		if err != nil || countStr == "" {
-			l.Logger.Errorf("[Flusher] redis GET %s error: %v", key, err)
+		if keyType == likeReactionCountKeyType {
+			err = l.flushReactionCounts(ctx, key, bizId, objId)
+		} else {
+			err = l.flushLikeCount(ctx, key, bizId, objId)
+		}
+		if err != nil {
 			continue
 		}
 
-		count, err := strconv.ParseInt(countStr, 10, 64)
+		// 落库成功后移除脏 key
+		_, _ = l.svcCtx.BizRedis.SremCtx(ctx, dirtyKeysSetKey, key)
+	}
+	return nil
+}
+
+func (l *LikeCountLogic) flushLikeCount(ctx context.Context, key string, bizId, objId int64) error {
+	countStr, err := l.svcCtx.BizRedis.GetCtx(ctx, key)
+	if err != nil || countStr == "" {
+		l.Logger.Errorf("[Flusher] redis GET %s error: %v", key, err)
+		return fmt.Errorf("redis GET %s empty", key)
+	}
+
+	count, err := strconv.ParseInt(countStr, 10, 64)
+	if err != nil {
+		l.Logger.Errorf("[Flusher] parse count error for %s: %v", key, err)
+		return err
+	}
+
+	err = l.svcCtx.LikeCountModel.InsertOrUpdateCount(ctx, bizId, objId, count)
+	if err != nil {
+		l.Logger.Errorf("[Flusher] db write error for %s: %v", key, err)
+		return err
+	}
+	return nil
+}
+
+func (l *LikeCountLogic) flushReactionCounts(ctx context.Context, key string, bizId, objId int64) error {
+	fields, err := l.svcCtx.BizRedis.HgetallCtx(ctx, key)
+	if err != nil || len(fields) == 0 {
+		l.Logger.Errorf("[Flusher] redis HGETALL %s error: %v", key, err)
+		return fmt.Errorf("redis HGETALL %s empty", key)
+	}
+
+	for field, value := range fields {
+		reactionType, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			l.Logger.Errorf("[Flusher] parse reaction type error for %s: %v", key, err)
+			continue
+		}
+		if reactionType == reactionLike {
+			// 旧版本写入缓存的赞的数量
+			continue
+		}
+		count, err := strconv.ParseInt(value, 10, 64)
 		if err != nil {
 			l.Logger.Errorf("[Flusher] parse count error for %s: %v", key, err)
 			continue
 		}
-
-		err = l.svcCtx.LikeCountModel.InsertOrUpdateCount(ctx, bizId, objId, count)
+		err = l.svcCtx.ReactionCountModel.InsertOrUpdateCount(ctx, bizId, objId, reactionType, count)
 		if err != nil {
 			l.Logger.Errorf("[Flusher] db write error for %s: %v", key, err)
-			continue
+			return err
 		}
-
-		// 落库成功后移除脏 key
-		_, _ = l.svcCtx.BizRedis.SremCtx(ctx, dirtyKeysSetKey, key)
 	}
 	return nil
 }
 
-func (l *LikeCountLogic) parseLikeCountKey(key string) (bizId, targetId int64, err error) {
-	// 格式："biz#like_count#[bizid]#[targetid]"
+func (l *LikeCountLogic) parseLikeCountKey(key string) (keyType string, bizId, targetId int64, err error) {
+	// 格式："biz#like_count#[bizid]#[targetid]" 或 "biz#like_reaction_count#[bizid]#[targetid]"
 	parts := strings.Split(key, "#")
-	if len(parts) != 4 {
+	if len(parts) != 4 || (parts[1] != likeCountKeyType && parts[1] != likeReactionCountKeyType) {
 		err := fmt.Errorf("invalid likeCountKey format: %s", key)
-		return 0, 0, err
+		return "", 0, 0, err
 	}
 	bizId, err = strconv.ParseInt(parts[2], 10, 64)
 	if err != nil {
-		return 0, 0, err
+		return "", 0, 0, err
 	}
 	targetId, err = strconv.ParseInt(parts[3], 10, 64)
 	if err != nil {
-		return 0, 0, err
+		return "", 0, 0, err
 	}
-	return bizId, targetId, nil
+	return parts[1], bizId, targetId, nil
 }
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LikeReactionCountModel = (*customLikeReactionCountModel)(nil)
+
+type (
+	// LikeReactionCountModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLikeReactionCountModel.
+	LikeReactionCountModel interface {
+		likeReactionCountModel
+		withSession(session sqlx.Session) LikeReactionCountModel
+		InsertOrUpdateCount(ctx context.Context, bizId, objId, reactionType, count int64) error
+	}
+
+	customLikeReactionCountModel struct {
+		*defaultLikeReactionCountModel
+	}
+)
+
+// NewLikeReactionCountModel returns a model for the database table.
+func NewLikeReactionCountModel(conn sqlx.SqlConn) LikeReactionCountModel {
+	return &customLikeReactionCountModel{
+		defaultLikeReactionCountModel: newLikeReactionCountModel(conn),
+	}
+}
+
+func (m *customLikeReactionCountModel) withSession(session sqlx.Session) LikeReactionCountModel {
+	return NewLikeReactionCountModel(sqlx.NewSqlConnFromSession(session))
+}
+
+func (m *customLikeReactionCountModel) InsertOrUpdateCount(ctx context.Context, bizId, objId, reactionType, count int64) error {
+	// 如果插入时发生唯一约束冲突，则改为执行后面的更新语句
+	query := fmt.Sprintf("INSERT INTO %s (biz_id, obj_id, reaction_type, reaction_num) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE reaction_num = VALUES(reaction_num)", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, bizId, objId, reactionType, count)
+	return err
+}
@@ -16,6 +16,7 @@ type (
 		likeRecordModel
 		withSession(session sqlx.Session) LikeRecordModel
 		InsertIgnore(ctx context.Context, bizId, objId, userId int64) (sql.Result, error)
+		InsertOrUpdateReaction(ctx context.Context, bizId, objId, userId, reactionType int64) (sql.Result, error)
 		DeleteByBizObjUser(ctx context.Context, bizId, objId, userId int64) error
 	}
 
@@ -40,6 +41,12 @@ func (m *customLikeRecordModel) InsertIgnore(ctx context.Context, bizId, objId,
 	return m.conn.ExecCtx(ctx, query, bizId, objId, userId)
 }
 
+// InsertOrUpdateReaction 每个用户对同一个对象只保留一个表态，已存在时切换表态类型，点赞时间不变
+func (m *customLikeRecordModel) InsertOrUpdateReaction(ctx context.Context, bizId, objId, userId, reactionType int64) (sql.Result, error) {
+	query := fmt.Sprintf("INSERT INTO %s (biz_id, obj_id, user_id, reaction_type) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE reaction_type = VALUES(reaction_type)", m.table)
+	return m.conn.ExecCtx(ctx, query, bizId, objId, userId, reactionType)
+}
+
 func (m *customLikeRecordModel) DeleteByBizObjUser(ctx context.Context, bizId, objId, userId int64) error {
 	query := fmt.Sprintf("DELETE FROM" + m.table + "WHERE biz_id = ? AND obj_id = ? AND user_id = ?")
 	_, err := m.conn.ExecCtx(ctx, query, bizId, objId, userId)
@@ -38,12 +38,13 @@ type (
 	}
 
 	LikeRecord struct {
-		Id         int64     `db:"id"`          // 主键ID
-		BizId      int64     `db:"biz_id"`      // 业务ID
-		ObjId      int64     `db:"obj_id"`      // 点赞对象id
-		UserId     int64     `db:"user_id"`     // 用户ID
-		CreateTime time.Time `db:"create_time"` // 创建时间
-		UpdateTime time.Time `db:"update_time"` // 最后修改时间
+		Id           int64     `db:"id"`            // 主键ID
+		BizId        int64     `db:"biz_id"`        // 业务ID
+		ObjId        int64     `db:"obj_id"`        // 点赞对象id
+		UserId       int64     `db:"user_id"`       // 用户ID
+		ReactionType int64     `db:"reaction_type"` // 表态类型 0:赞 1:爱心 2:笑 3:哇 4:难过
+		CreateTime   time.Time `db:"create_time"`   // 创建时间
+		UpdateTime   time.Time `db:"update_time"`   // 最后修改时间
 	}
 )
 
@@ -89,14 +90,14 @@ func (m *defaultLikeRecordModel) FindOneByBizIdObjIdUserId(ctx context.Context,
 }
 
 func (m *defaultLikeRecordModel) Insert(ctx context.Context, data *LikeRecord) (sql.Result, error) {
-	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, likeRecordRowsExpectAutoSet)
-	ret, err := m.conn.ExecCtx(ctx, query, data.BizId, data.ObjId, data.UserId)
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?)", m.table, likeRecordRowsExpectAutoSet)
+	ret, err := m.conn.ExecCtx(ctx, query, data.BizId, data.ObjId, data.UserId, data.ReactionType)
 	return ret, err
 }
 
 func (m *defaultLikeRecordModel) Update(ctx context.Context, newData *LikeRecord) error {
 	query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, likeRecordRowsWithPlaceHolder)
-	_, err := m.conn.ExecCtx(ctx, query, newData.BizId, newData.ObjId, newData.UserId, newData.Id)
+	_, err := m.conn.ExecCtx(ctx, query, newData.BizId, newData.ObjId, newData.UserId, newData.ReactionType, newData.Id)
 	return err
 }
 
@@ -8,10 +8,11 @@ import (
 )
 
 type ServiceContext struct {
-	Config         config.Config
-	LikeModel      model.LikeRecordModel
-	LikeCountModel model.LikeCountModel
-	BizRedis       *redis.Redis
+	Config             config.Config
+	LikeModel          model.LikeRecordModel
+	LikeCountModel     model.LikeCountModel
+	ReactionCountModel model.LikeReactionCountModel
+	BizRedis           *redis.Redis
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -22,9 +23,10 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		NonBlock: true,
 	})
 	return &ServiceContext{
-		Config:         c,
-		BizRedis:       rds,
-		LikeModel:      model.NewLikeRecordModel(sqlx.NewMysql(c.DataSource)),
-		LikeCountModel: model.NewLikeCountModel(sqlx.NewMysql(c.DataSource)),
+		Config:             c,
+		BizRedis:           rds,
+		LikeModel:          model.NewLikeRecordModel(sqlx.NewMysql(c.DataSource)),
+		LikeCountModel:     model.NewLikeCountModel(sqlx.NewMysql(c.DataSource)),
+		ReactionCountModel: model.NewLikeReactionCountModel(sqlx.NewMysql(c.DataSource)),
 	}
 }
@@ -6,4 +6,6 @@ type LikeActionMsg struct {
 	ObjId      int64 ` json:"objId,omitempty"`    // 点赞对象id
 	UserId     int64 ` json:"userId,omitempty"`   // 用户id
 	LikeAction int32 ` json:"likeType,omitempty"` // 类型
+	// 表态类型
+	ReactionType int32 ` json:"reactionType,omitempty"`
 }
@@ -0,0 +1,12 @@
+package code
+
+import "posta/pkg/xcode"
+
+var (
+	ObjIdInvalid        = xcode.New(80001, "点赞对象ID无效")
+	UserIdInvalid       = xcode.New(80002, "用户ID无效")
+	ReactionTypeInvalid = xcode.New(80003, "表态类型无效")
+	LikeBlocked         = xcode.New(80004, "对方已将你拉黑，不能点赞")
+	PageTokenInvalid    = xcode.New(80005, "分页游标无效")
+	PageSizeInvalid     = xcode.New(80006, "每页数量超出范围")
+)
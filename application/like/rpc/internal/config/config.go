@@ -1,6 +1,7 @@
 package config
 
 import (
+	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -13,4 +14,11 @@ type Config struct {
 		Brokers []string
 		Topic   string
 	}
+	// 点赞前查询内容作者，校验是否被作者拉黑
+	DataSourceReply string
+	CacheRedis      cache.CacheConf
+	ArticleRPC      zrpc.RpcClientConf
+	FollowRPC       zrpc.RpcClientConf
+	// CursorSecret 分页游标的签名密钥
+	CursorSecret string
 }
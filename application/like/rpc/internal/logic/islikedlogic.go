@@ -5,9 +5,12 @@ import (
 	"errors"
 	"posta/application/like/rpc/internal/model"
 	"posta/application/like/rpc/internal/svc"
+	"posta/application/like/rpc/internal/types"
 	"posta/application/like/rpc/pb"
+	"strconv"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
 )
 
 type IsLikedLogic struct {
@@ -29,38 +32,46 @@ func (l *IsLikedLogic) IsLiked(in *pb.IsLikedRequest) (*pb.IsLikedResponse, erro
 
 	likeRecordKey := LikeRecordKey(in.BizId, in.UserId)
 
-	// 查询缓存中是否存在
-	exist, err := l.svcCtx.BizRedis.SismemberCtx(l.ctx, likeRecordKey, in.ObjId)
-	if err != nil {
-		l.Logger.Error("sismember %s Sismember error: %v", likeRecordKey, err)
+	// 查询缓存中是否存在，value是表态类型
+	reaction, err := l.svcCtx.BizRedis.HgetCtx(l.ctx, likeRecordKey, strconv.FormatInt(in.ObjId, 10))
+	if err != nil && !errors.Is(err, redis.Nil) {
+		l.Logger.Errorf("redis %s Hget error: %v", likeRecordKey, err)
 		return nil, err
 	}
 
-	// 如果缓存中能找到
-	if exist {
-		return &pb.IsLikedResponse{Liked: true}, nil
+	// 如果缓存中能找到，墓碑值表示已经取消，数据库可能还没更新，不能再查数据库
+	if reaction != "" {
+		reactionType, err := strconv.ParseInt(reaction, 10, 32)
+		if err != nil {
+			l.Logger.Errorf("redis %s strconv.ParseInt error: %v", likeRecordKey, err)
+			return nil, err
+		}
+		if reactionType == types.ReactionNone {
+			return &pb.IsLikedResponse{Liked: false}, nil
+		}
+		return &pb.IsLikedResponse{Liked: true, ReactionType: int32(reactionType)}, nil
 	}
 
 	// 否则，再从数据库中找
-	_, err = l.svcCtx.LikeModel.FindOneByBizIdObjIdUserId(l.ctx, in.BizId, in.ObjId, in.UserId)
+	record, err := l.svcCtx.LikeModel.FindOneByBizIdObjIdUserId(l.ctx, in.BizId, in.ObjId, in.UserId)
 	if err != nil {
 		// 没有找到也是error
 		if errors.Is(err, model.ErrNotFound) {
 			return &pb.IsLikedResponse{Liked: false}, nil
 		}
-		l.Logger.Error("sismember %s LikeModel error: %v", likeRecordKey, err)
+		l.Logger.Errorf("redis %s LikeModel error: %v", likeRecordKey, err)
 		return nil, err
 	}
 
-	// 从数据库中读到点赞记录，写入缓存中
-	_, err = l.svcCtx.BizRedis.SaddCtx(l.ctx, likeRecordKey, in.UserId)
+	// 从数据库中读到点赞记录，写入缓存中。查数据库期间可能有新的点赞或取消写入了缓存，不能覆盖
+	_, err = l.svcCtx.BizRedis.HsetnxCtx(l.ctx, likeRecordKey, strconv.FormatInt(in.ObjId, 10), strconv.FormatInt(record.ReactionType, 10))
 	if err != nil {
-		l.Logger.Errorf("redis %v Sadd error: %v", likeRecordKey, err)
+		l.Logger.Errorf("redis %v Hsetnx error: %v", likeRecordKey, err)
 	}
 	err = l.svcCtx.BizRedis.ExpireCtx(l.ctx, likeRecordKey, LikesExpire)
 	if err != nil {
 		l.Logger.Errorf("redis %v Expire error: %v", likeRecordKey, err)
 	}
 
-	return &pb.IsLikedResponse{Liked: true}, nil
+	return &pb.IsLikedResponse{Liked: true, ReactionType: int32(record.ReactionType)}, nil
 }
@@ -4,22 +4,36 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
+
 	"github.com/zeromicro/go-zero/core/threading"
 
+	"posta/application/article/rpc/article"
+	"posta/application/follow/rpc/follow"
+	"posta/application/like/rpc/internal/code"
+	"posta/application/like/rpc/internal/model"
 	"posta/application/like/rpc/internal/svc"
 	"posta/application/like/rpc/internal/types"
 	"posta/application/like/rpc/pb"
+	"posta/pkg/zsetcache"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
 const (
-	prefixLikes     = "biz#like#%d#%d"       // bizid & userid -> set(targetid)
-	prefixLikeCount = "biz#like_count#%d#%d" // bizid & targetid -> string(count)
-	dirtyKeysSetKey = "like:dirty_keys"      // 存储redis中有变化的点赞数key名
-	LikesExpire     = 3600 * 24
+	prefixLikes             = "biz#like_reaction#%d#%d"       // bizid & userid -> hash(targetid -> 表态类型)
+	prefixLikeCount         = "biz#like_count#%d#%d"          // bizid & targetid -> string(count)
+	prefixLikeReactionCount = "biz#like_reaction_count#%d#%d" // bizid & targetid -> hash(表态类型 -> count)
+	dirtyKeysSetKey         = "like:dirty_keys"               // 存储redis中有变化的点赞数key名
+	LikesExpire             = 3600 * 24
 )
 
+// 互斥的业务，对同一个对象点了其中一个就取消另一个，比如评论的点赞和点踩
+var exclusiveBiz = map[int64]int64{
+	types.BizReply:        types.BizReplyDislike,
+	types.BizReplyDislike: types.BizReply,
+}
+
 type LikeActionLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -36,11 +50,32 @@ func NewLikeActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LikeAc
 
 // 用户对某个对象点赞或取消点赞
 func (l *LikeActionLogic) LikeAction(in *pb.LikeActionRequest) (*pb.LikeActionResponse, error) {
+	if in.Action == 0 && (in.ReactionType < 0 || in.ReactionType >= types.ReactionTypeCount) {
+		return nil, code.ReactionTypeInvalid
+	}
+	// 取消点赞不需要校验，被拉黑之前点的赞也可以取消
+	if in.Action == 0 {
+		if err := l.checkBlocked(in); err != nil {
+			return nil, err
+		}
+	}
+
 	likeRecordKey := LikeRecordKey(in.BizId, in.UserId)
 	likeCountKey := LikeCountKey(in.BizId, in.ObjId)
+	reactionCountKey := LikeReactionCountKey(in.BizId, in.ObjId)
+
+	// 先保证计数和用户表态都已经从数据库加载到缓存中，否则缓存过期后lua脚本会在0的基础上计数
+	countLogic := NewLikeCountLogic(l.ctx, l.svcCtx)
+	if _, err := countLogic.LikeCount(&pb.LikeCountRequest{BizId: in.BizId, ObjId: in.ObjId}); err != nil {
+		return nil, err
+	}
+	if _, err := NewIsLikedLogic(l.ctx, l.svcCtx).IsLiked(&pb.IsLikedRequest{BizId: in.BizId, ObjId: in.ObjId, UserId: in.UserId}); err != nil {
+		return nil, err
+	}
 
 	var (
-		ret *pb.LikeActionResponse
+		ret                *pb.LikeActionResponse
+		changed, isNewLike bool
 	)
 	//// 检查是否点过赞，这里只查询redis即可。因为前端页面打开是查询过点赞记录并放到redis中了。
 	//// Sismember: Set is member
@@ -53,17 +88,34 @@ func (l *LikeActionLogic) LikeAction(in *pb.LikeActionRequest) (*pb.LikeActionRe
 	// 如果是点赞操作
 	if in.Action == 0 {
 
-		// lua脚本保证原子性
+		// lua脚本保证原子性，每个用户对同一个对象只有一个表态，已有其他表态时切换表态，总数不变。
+		// 墓碑值表示已经取消了表态。赞的数量由总数减去其他类型得到，不单独计数
 		likeScript := `
-		local exists = redis.call("SISMEMBER", KEYS[1], ARGV[1])
-		if exists == 1 then
+		local old = redis.call("HGET", KEYS[1], ARGV[1])
+		if old == ARGV[5] then
+			old = false
+		end
+		if old == ARGV[2] then
 			return 0
 		end
-		redis.call("SADD", KEYS[1], ARGV[1])
-		redis.call("INCR", KEYS[2])
-		redis.call("SADD", KEYS[3], KEYS[2])
-		redis.call("EXPIRE", KEYS[1], ARGV[2])
-		redis.call("EXPIRE", KEYS[2], ARGV[3])
+		redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+		if old then
+			if old ~= ARGV[6] then
+				redis.call("HINCRBY", KEYS[3], old, -1)
+			end
+		else
+			redis.call("INCR", KEYS[2])
+		end
+		if ARGV[2] ~= ARGV[6] then
+			redis.call("HINCRBY", KEYS[3], ARGV[2], 1)
+		end
+		redis.call("SADD", KEYS[4], KEYS[2], KEYS[3])
+		redis.call("EXPIRE", KEYS[1], ARGV[3])
+		redis.call("EXPIRE", KEYS[2], ARGV[4])
+		redis.call("EXPIRE", KEYS[3], ARGV[4])
+		if old then
+			return 2
+		end
 		return 1`
 
 		res, err := l.svcCtx.BizRedis.EvalCtx(
@@ -72,11 +124,15 @@ func (l *LikeActionLogic) LikeAction(in *pb.LikeActionRequest) (*pb.LikeActionRe
 			[]string{
 				likeRecordKey,
 				likeCountKey,
+				reactionCountKey,
 				dirtyKeysSetKey,
 			},
 			in.ObjId,
+			in.ReactionType,
 			int(LikesExpire),   // record key 过期时间
 			int(7*LikesExpire), // count key 过期时间
+			types.ReactionNone,
+			types.ReactionLike,
 		)
 
 		if err != nil {
@@ -84,18 +140,14 @@ func (l *LikeActionLogic) LikeAction(in *pb.LikeActionRequest) (*pb.LikeActionRe
 			return nil, err
 		}
 
-		if res == int64(0) {
-			// 已点赞，直接返回当前状态
-			return &pb.LikeActionResponse{
-				Success: true,
-				Liked:   true,
-			}, nil
-		} else {
-			ret = &pb.LikeActionResponse{
-				Success: true,
-				Liked:   true,
-			}
+		ret = &pb.LikeActionResponse{
+			Success:      true,
+			Liked:        true,
+			ReactionType: in.ReactionType,
 		}
+		// 0表示已经是这个表态了，2表示切换了表态，点赞用户列表不需要变化
+		changed = res != int64(0)
+		isNewLike = res == int64(1)
 
 		//// 添加点赞记录
 		//// _是成功加入的数量，如果已经存在，则返回0，不存在则返回1
@@ -121,17 +173,22 @@ func (l *LikeActionLogic) LikeAction(in *pb.LikeActionRequest) (*pb.LikeActionRe
 		//	Liked:   true,
 		//}
 	} else {
-		// 使用lua脚本保证原子性
+		// 使用lua脚本保证原子性。取消时不删除表态，而是写入墓碑值，
+		// 这样在like-mq更新数据库之前再点赞，不会从数据库重新加载到取消前的表态
 		cancelScript := `
-		local exists = redis.call("SISMEMBER", KEYS[1], ARGV[1])
-		if exists == 0 then
+		local old = redis.call("HGET", KEYS[1], ARGV[1])
+		if not old or old == ARGV[4] then
 			return 0
 		end
-		redis.call("SREM", KEYS[1], ARGV[1])
+		redis.call("HSET", KEYS[1], ARGV[1], ARGV[4])
 		redis.call("DECR", KEYS[2])
-		redis.call("SADD", KEYS[3], KEYS[2])
+		if old ~= ARGV[5] then
+			redis.call("HINCRBY", KEYS[3], old, -1)
+		end
+		redis.call("SADD", KEYS[4], KEYS[2], KEYS[3])
 		redis.call("EXPIRE", KEYS[1], ARGV[2])
 		redis.call("EXPIRE", KEYS[2], ARGV[3])
+		redis.call("EXPIRE", KEYS[3], ARGV[3])
 		return 1
 		`
 
@@ -141,11 +198,14 @@ func (l *LikeActionLogic) LikeAction(in *pb.LikeActionRequest) (*pb.LikeActionRe
 			[]string{
 				likeRecordKey,
 				likeCountKey,
+				reactionCountKey,
 				dirtyKeysSetKey,
 			},
 			in.ObjId,
 			int(LikesExpire),
 			int(7*LikesExpire),
+			types.ReactionNone,
+			types.ReactionLike,
 		)
 
 		if err != nil {
@@ -153,17 +213,11 @@ func (l *LikeActionLogic) LikeAction(in *pb.LikeActionRequest) (*pb.LikeActionRe
 			return nil, err
 		}
 
-		if res == int64(0) {
-			return &pb.LikeActionResponse{
-				Success: true,
-				Liked:   false,
-			}, nil
-		} else {
-			ret = &pb.LikeActionResponse{
-				Success: true,
-				Liked:   false,
-			}
+		ret = &pb.LikeActionResponse{
+			Success: true,
+			Liked:   false,
 		}
+		changed = res != int64(0)
 
 		//// 没有点赞过还要取消
 		//if !exist {
@@ -197,11 +251,28 @@ func (l *LikeActionLogic) LikeAction(in *pb.LikeActionRequest) (*pb.LikeActionRe
 		//}
 	}
 
+	reactionCounts, err := countLogic.ReactionCounts(in.BizId, in.ObjId)
+	if err != nil {
+		return nil, err
+	}
+	ret.ReactionCounts = reactionCounts
+
+	if !changed {
+		return ret, nil
+	}
+
+	// 最近点赞用户列表只在缓存存在时更新，不存在时由LikedUsers回源数据库后回填
+	if isNewLike || in.Action != 0 {
+		l.updateCacheLikers(in)
+	}
+
 	msg := &types.LikeActionMsg{
-		BizId:      in.BizId,
-		ObjId:      in.ObjId,
-		UserId:     in.UserId,
-		LikeAction: in.Action,
+		BizId:          in.BizId,
+		ObjId:          in.ObjId,
+		UserId:         in.UserId,
+		LikeAction:     in.Action,
+		ReactionType:   in.ReactionType,
+		ReactionSwitch: in.Action == 0 && !isNewLike,
 	}
 
 	// 发送kafka消息，异步
@@ -218,9 +289,37 @@ func (l *LikeActionLogic) LikeAction(in *pb.LikeActionRequest) (*pb.LikeActionRe
 		}
 	})
 
+	if opposite, ok := exclusiveBiz[in.BizId]; ok && isNewLike {
+		_, err = l.LikeAction(&pb.LikeActionRequest{
+			BizId:  opposite,
+			ObjId:  in.ObjId,
+			UserId: in.UserId,
+			Action: 1,
+		})
+		if err != nil {
+			l.Logger.Errorf("[LikeAction] cancel exclusive biz: %d error: %v", opposite, err)
+		}
+	}
+
 	return ret, nil
 }
 
+func (l *LikeActionLogic) updateCacheLikers(in *pb.LikeActionRequest) {
+	key := LikersKey(in.BizId, in.ObjId)
+	if in.Action != 0 {
+		err := l.svcCtx.LikersCache.Remove(l.ctx, key, in.UserId)
+		if err != nil {
+			l.Logger.Errorf("[LikeAction] LikersCache.Remove error: %v", err)
+		}
+		return
+	}
+	// 缓存只保留最新的CacheMaxLikersCount个点赞用户，裁剪在zsetcache中完成
+	err := l.svcCtx.LikersCache.Add(l.ctx, key, zsetcache.Item{Id: in.UserId, Score: time.Now().Unix()})
+	if err != nil {
+		l.Logger.Errorf("[LikeAction] LikersCache.Add error: %v", err)
+	}
+}
+
 func LikeRecordKey(bizId int64, userId int64) string {
 	return fmt.Sprintf(prefixLikes, bizId, userId)
 }
@@ -228,3 +327,60 @@ func LikeRecordKey(bizId int64, userId int64) string {
 func LikeCountKey(bizId int64, targetId int64) string {
 	return fmt.Sprintf(prefixLikeCount, bizId, targetId)
 }
+
+func LikeReactionCountKey(bizId int64, targetId int64) string {
+	return fmt.Sprintf(prefixLikeReactionCount, bizId, targetId)
+}
+
+// checkBlocked 内容作者拉黑了点赞的用户时不能点赞
+func (l *LikeActionLogic) checkBlocked(in *pb.LikeActionRequest) error {
+	ownerId, err := l.objOwner(in.BizId, in.ObjId)
+	if err != nil {
+		return err
+	}
+	if ownerId == 0 || ownerId == in.UserId {
+		return nil
+	}
+
+	ret, err := l.svcCtx.FollowRPC.CheckBlocked(l.ctx, &follow.CheckBlockedRequest{
+		UserId:   in.UserId,
+		OwnerIds: []int64{ownerId},
+	})
+	if err != nil {
+		l.Logger.Errorf("FollowRPC.CheckBlocked userId: %d ownerId: %d error: %v", in.UserId, ownerId, err)
+		return err
+	}
+	if len(ret.BlockerIds) > 0 {
+		return code.LikeBlocked
+	}
+
+	return nil
+}
+
+// objOwner 查询点赞对象的作者，文章走article-rpc，评论直接查评论表
+func (l *LikeActionLogic) objOwner(bizId, objId int64) (int64, error) {
+	switch bizId {
+	case types.BizArticle:
+		detail, err := l.svcCtx.ArticleRPC.ArticleDetail(l.ctx, &article.ArticleDetailRequest{ArticleId: objId})
+		if err != nil {
+			l.Logger.Errorf("ArticleRPC.ArticleDetail articleId: %d error: %v", objId, err)
+			return 0, err
+		}
+		if detail.Article == nil {
+			return 0, code.ObjIdInvalid
+		}
+		return detail.Article.AuthorId, nil
+	case types.BizReply, types.BizReplyDislike:
+		reply, err := l.svcCtx.ReplyModel.FindOne(l.ctx, objId)
+		if err == model.ErrNotFound {
+			return 0, code.ObjIdInvalid
+		}
+		if err != nil {
+			l.Logger.Errorf("ReplyModel.FindOne replyId: %d error: %v", objId, err)
+			return 0, err
+		}
+		return reply.ReplyUserId, nil
+	}
+
+	return 0, nil
+}
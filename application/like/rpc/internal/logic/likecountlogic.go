@@ -5,6 +5,7 @@ import (
 	"errors"
 	"posta/application/like/rpc/internal/model"
 	"posta/application/like/rpc/internal/svc"
+	"posta/application/like/rpc/internal/types"
 	"posta/application/like/rpc/pb"
 	"strconv"
 
@@ -28,13 +29,26 @@ func NewLikeCountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LikeCou
 // 查询点赞数（单个）
 func (l *LikeCountLogic) LikeCount(in *pb.LikeCountRequest) (*pb.LikeCountResponse, error) {
 
-	likeCountKey := LikeCountKey(in.BizId, in.ObjId)
+	count, err := l.totalCount(in.BizId, in.ObjId)
+	if err != nil {
+		return nil, err
+	}
+	reactionCounts, err := l.ReactionCounts(in.BizId, in.ObjId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LikeCountResponse{Count: count, ReactionCounts: reactionCounts}, nil
+}
+
+func (l *LikeCountLogic) totalCount(bizId, objId int64) (int64, error) {
+	likeCountKey := LikeCountKey(bizId, objId)
 
 	// 查询缓存，存储的count是string
 	count, err := l.svcCtx.BizRedis.GetCtx(l.ctx, likeCountKey)
 	if err != nil {
 		l.Logger.Errorf("redis %v GetCtx error: %v", likeCountKey, err)
-		return nil, err
+		return 0, err
 	}
 	// value == "" && err == nil表示key不存在，但操作是正常的
 	if count != "" {
@@ -42,24 +56,94 @@ func (l *LikeCountLogic) LikeCount(in *pb.LikeCountRequest) (*pb.LikeCountRespon
 		if err != nil {
 			l.Logger.Errorf("redis %v strconv.ParseInt error: %v", likeCountKey, err)
 		}
-		return &pb.LikeCountResponse{Count: countInt}, nil
+		return countInt, nil
 	}
 
 	// 再查询数据库
-	record, err := l.svcCtx.LikeCountModel.FindOneByBizIdObjId(l.ctx, in.BizId, in.ObjId)
+	record, err := l.svcCtx.LikeCountModel.FindOneByBizIdObjId(l.ctx, bizId, objId)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			// 没有数据视为 0
 			// 防止缓存穿透
 			_ = l.svcCtx.BizRedis.SetexCtx(l.ctx, likeCountKey, strconv.FormatInt(int64(0), 10), 7*LikesExpire)
-			return &pb.LikeCountResponse{Count: 0}, nil
+			return 0, nil
 		}
 		l.Logger.Errorf("mysql like_count query error: %v", err)
-		return nil, err
+		return 0, err
 	}
 
 	// 写入缓存
 	_ = l.svcCtx.BizRedis.SetexCtx(l.ctx, likeCountKey, strconv.FormatInt(int64(record.LikeNum), 10), 7*LikesExpire)
 
-	return &pb.LikeCountResponse{Count: record.LikeNum}, nil
+	return record.LikeNum, nil
+}
+
+// ReactionCounts 查询各表态类型的数量，缓存不存在时从数据库加载，所有类型都会返回（没有的为0）
+func (l *LikeCountLogic) ReactionCounts(bizId, objId int64) ([]*pb.ReactionCount, error) {
+	reactionCountKey := LikeReactionCountKey(bizId, objId)
+
+	counts := make(map[int64]int64, types.ReactionTypeCount)
+	fields, err := l.svcCtx.BizRedis.HgetallCtx(l.ctx, reactionCountKey)
+	if err != nil {
+		l.Logger.Errorf("redis %v HgetallCtx error: %v", reactionCountKey, err)
+		return nil, err
+	}
+	if len(fields) > 0 {
+		for field, value := range fields {
+			reactionType, err := strconv.ParseInt(field, 10, 64)
+			if err != nil {
+				l.Logger.Errorf("redis %v strconv.ParseInt field: %s error: %v", reactionCountKey, field, err)
+				continue
+			}
+			counts[reactionType], _ = strconv.ParseInt(value, 10, 64)
+		}
+		if err = l.deriveLikeCount(bizId, objId, counts); err != nil {
+			return nil, err
+		}
+	} else {
+		records, err := l.svcCtx.ReactionCountModel.FindByBizIdObjId(l.ctx, bizId, objId)
+		if err != nil {
+			l.Logger.Errorf("mysql like_reaction_count query error: %v", err)
+			return nil, err
+		}
+		for _, record := range records {
+			counts[record.ReactionType] = record.ReactionNum
+		}
+		if err = l.deriveLikeCount(bizId, objId, counts); err != nil {
+			return nil, err
+		}
+		// 赞以外的所有类型都写入缓存，数量为0的也写，防止缓存穿透
+		values := make(map[string]string, types.ReactionTypeCount-1)
+		for reactionType := int64(types.ReactionLike + 1); reactionType < types.ReactionTypeCount; reactionType++ {
+			values[strconv.FormatInt(reactionType, 10)] = strconv.FormatInt(counts[reactionType], 10)
+		}
+		if err = l.svcCtx.BizRedis.HmsetCtx(l.ctx, reactionCountKey, values); err != nil {
+			l.Logger.Errorf("redis %v HmsetCtx error: %v", reactionCountKey, err)
+		}
+		_ = l.svcCtx.BizRedis.ExpireCtx(l.ctx, reactionCountKey, 7*LikesExpire)
+	}
+
+	reactionCounts := make([]*pb.ReactionCount, 0, types.ReactionTypeCount)
+	for reactionType := int64(0); reactionType < types.ReactionTypeCount; reactionType++ {
+		reactionCounts = append(reactionCounts, &pb.ReactionCount{
+			ReactionType: int32(reactionType),
+			Count:        max(counts[reactionType], 0),
+		})
+	}
+	return reactionCounts, nil
+}
+
+// deriveLikeCount 支持表态之前的点赞都是赞，没有分类型的计数，取消这些旧的赞会把赞的计数减成负数。
+// 所以赞不单独计数，缓存和数据库中都不保存，数量用总数减去其他类型的数量得到
+func (l *LikeCountLogic) deriveLikeCount(bizId, objId int64, counts map[int64]int64) error {
+	total, err := l.totalCount(bizId, objId)
+	if err != nil {
+		return err
+	}
+	var others int64
+	for reactionType := int64(types.ReactionLike + 1); reactionType < types.ReactionTypeCount; reactionType++ {
+		others += counts[reactionType]
+	}
+	counts[types.ReactionLike] = max(total-others, 0)
+	return nil
 }
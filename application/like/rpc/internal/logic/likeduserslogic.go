@@ -0,0 +1,145 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"posta/application/like/rpc/internal/code"
+	"posta/application/like/rpc/internal/svc"
+	"posta/application/like/rpc/internal/types"
+	"posta/application/like/rpc/pb"
+	"posta/pkg/cursor"
+	"posta/pkg/zsetcache"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+type LikedUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLikedUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LikedUsersLogic {
+	return &LikedUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询给某个对象点过赞的用户列表（按点赞时间倒序）
+func (l *LikedUsersLogic) LikedUsers(in *pb.LikedUsersRequest) (*pb.LikedUsersResponse, error) {
+	if in.ObjId <= 0 {
+		return nil, code.ObjIdInvalid
+	}
+	if in.PageSize < 0 || in.PageSize > types.MaxPageSize {
+		return nil, code.PageSizeInvalid
+	}
+	if in.PageSize == 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	scope := fmt.Sprintf("likers#%d#%d", in.BizId, in.ObjId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	// Sort是上一页最后一条的点赞时间，Id是这条的用户ID，第一页时为零值
+	var after *zsetcache.Item
+	if !pageCursor.IsZero() {
+		after = &zsetcache.Item{Id: pageCursor.Id, Score: pageCursor.Sort}
+	}
+
+	// 缓存中的score就是点赞时间
+	var (
+		isEnd  bool
+		likers []zsetcache.Item
+	)
+	page, err := l.svcCtx.LikersCache.Read(l.ctx, LikersKey(in.BizId, in.ObjId), after, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[LikedUsers] LikersCache.Read error: %v req: %v", err, in)
+	}
+	if page != nil {
+		likers, isEnd = page.Items, page.IsEnd
+	} else {
+		likers, isEnd, err = l.likersFromDB(in, after)
+		if err != nil {
+			l.Logger.Errorf("[LikedUsers] LikeModel.LikedUsersByBizObj error: %v req: %v", err, in)
+			return nil, err
+		}
+	}
+
+	curPage := make([]*pb.LikedUserItem, 0, len(likers))
+	for _, liker := range likers {
+		curPage = append(curPage, &pb.LikedUserItem{
+			UserId:   liker.Id,
+			LikeTime: liker.Score,
+		})
+	}
+
+	ret := &pb.LikedUsersResponse{
+		Items: curPage,
+		IsEnd: isEnd,
+	}
+	if len(curPage) > 0 {
+		pageLast := curPage[len(curPage)-1]
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: pageLast.LikeTime, Id: pageLast.UserId})
+	}
+
+	return ret, nil
+}
+
+// likersFromDB 缓存没有命中时查数据库。第一页多查CacheMaxLikersCount条用于写缓存，之后的页只查一页，
+// 不能用中间的一段写缓存，否则缓存中的列表前面会缺一段
+func (l *LikedUsersLogic) likersFromDB(in *pb.LikedUsersRequest, after *zsetcache.Item) ([]zsetcache.Item, bool, error) {
+	limit := types.CacheMaxLikersCount
+	lastTime, lastUserId := time.Now(), int64(math.MaxInt64)
+	if after != nil {
+		// 多查一条用于判断是否还有下一页
+		limit = int(in.PageSize) + 1
+		lastTime, lastUserId = time.Unix(after.Score, 0), after.Id
+	}
+	// 第一页查询前取缓存的版本号，查询期间有点赞或取消时不用这次的结果写缓存
+	var (
+		version int64
+		verErr  error
+	)
+	if after == nil {
+		version, verErr = l.svcCtx.LikersCache.Version(l.ctx, LikersKey(in.BizId, in.ObjId))
+		if verErr != nil {
+			l.Logger.Errorf("[LikedUsers] LikersCache.Version error: %v bizId: %d objId: %d", verErr, in.BizId, in.ObjId)
+		}
+	}
+	records, err := l.svcCtx.LikeModel.LikedUsersByBizObj(l.ctx, in.BizId, in.ObjId,
+		lastTime.Format("2006-01-02 15:04:05"), lastUserId, limit)
+	if err != nil {
+		return nil, false, err
+	}
+	likers := make([]zsetcache.Item, 0, len(records))
+	for _, record := range records {
+		likers = append(likers, zsetcache.Item{Id: record.UserId, Score: record.CreateTime.Unix()})
+	}
+
+	if after == nil && verErr == nil {
+		threading.GoSafe(func() {
+			// 不到CacheMaxLikersCount条说明已经查到了全部点赞用户
+			err := l.svcCtx.LikersCache.Fill(context.Background(), LikersKey(in.BizId, in.ObjId), version, likers, len(likers) < types.CacheMaxLikersCount)
+			if err != nil {
+				logx.Errorf("[LikedUsers] LikersCache.Fill error: %v bizId: %d objId: %d", err, in.BizId, in.ObjId)
+			}
+		})
+	}
+
+	if len(likers) > int(in.PageSize) {
+		return likers[:in.PageSize], false, nil
+	}
+	return likers, true, nil
+}
+
+// 缓存改用zsetcache的格式后换了key，旧格式的缓存不再读取
+func LikersKey(bizId int64, objId int64) string {
+	return fmt.Sprintf("biz#likers#v2#%d#%d", bizId, objId)
+}
@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"posta/application/like/rpc/internal/code"
+	"posta/application/like/rpc/internal/svc"
+	"posta/application/like/rpc/internal/types"
+	"posta/application/like/rpc/pb"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UserLikedItemsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUserLikedItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UserLikedItemsLogic {
+	return &UserLikedItemsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询用户点赞过的对象列表（按点赞时间倒序）
+func (l *UserLikedItemsLogic) UserLikedItems(in *pb.UserLikedItemsRequest) (*pb.UserLikedItemsResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.PageSize < 0 || in.PageSize > types.MaxPageSize {
+		return nil, code.PageSizeInvalid
+	}
+	if in.PageSize == 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	scope := fmt.Sprintf("likedItems#%d#%d", in.UserId, in.BizId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	// Sort是上一页最后一条的点赞时间，Id是这条点赞记录的ID
+	if pageCursor.IsZero() {
+		pageCursor.Sort = time.Now().Unix()
+		pageCursor.Id = math.MaxInt64
+	}
+
+	records, err := l.svcCtx.LikeModel.LikedItemsByUserBiz(l.ctx, in.UserId, in.BizId,
+		time.Unix(pageCursor.Sort, 0).Format("2006-01-02 15:04:05"), pageCursor.Id, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[UserLikedItems] LikeModel.LikedItemsByUserBiz error: %v req: %v", err, in)
+		return nil, err
+	}
+
+	ret := &pb.UserLikedItemsResponse{
+		IsEnd: len(records) < int(in.PageSize),
+	}
+	for _, record := range records {
+		ret.Items = append(ret.Items, &pb.UserLikedItem{
+			Id:           record.Id,
+			ObjId:        record.ObjId,
+			LikeTime:     record.CreateTime.Unix(),
+			ReactionType: int32(record.ReactionType),
+		})
+	}
+	if len(records) > 0 {
+		last := records[len(records)-1]
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: last.CreateTime.Unix(), Id: last.Id})
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LikeReactionCountModel = (*customLikeReactionCountModel)(nil)
+
+type (
+	// LikeReactionCountModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLikeReactionCountModel.
+	LikeReactionCountModel interface {
+		likeReactionCountModel
+		withSession(session sqlx.Session) LikeReactionCountModel
+		FindByBizIdObjId(ctx context.Context, bizId, objId int64) ([]*LikeReactionCount, error)
+	}
+
+	customLikeReactionCountModel struct {
+		*defaultLikeReactionCountModel
+	}
+)
+
+// NewLikeReactionCountModel returns a model for the database table.
+func NewLikeReactionCountModel(conn sqlx.SqlConn) LikeReactionCountModel {
+	return &customLikeReactionCountModel{
+		defaultLikeReactionCountModel: newLikeReactionCountModel(conn),
+	}
+}
+
+func (m *customLikeReactionCountModel) withSession(session sqlx.Session) LikeReactionCountModel {
+	return NewLikeReactionCountModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// FindByBizIdObjId 查询某个对象各个表态类型的计数
+func (m *customLikeReactionCountModel) FindByBizIdObjId(ctx context.Context, bizId, objId int64) ([]*LikeReactionCount, error) {
+	var counts []*LikeReactionCount
+	query := fmt.Sprintf("select %s from %s where `biz_id` = ? and `obj_id` = ?", likeReactionCountRows, m.table)
+	err := m.conn.QueryRowsCtx(ctx, &counts, query, bizId, objId)
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
@@ -1,6 +1,11 @@
 package model
 
-import "github.com/zeromicro/go-zero/core/stores/sqlx"
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
 
 var _ LikeRecordModel = (*customLikeRecordModel)(nil)
 
@@ -10,6 +15,8 @@ type (
 	LikeRecordModel interface {
 		likeRecordModel
 		withSession(session sqlx.Session) LikeRecordModel
+		LikedUsersByBizObj(ctx context.Context, bizId, objId int64, createTime string, lastUserId int64, limit int) ([]*LikeRecord, error)
+		LikedItemsByUserBiz(ctx context.Context, userId, bizId int64, createTime string, lastId int64, limit int) ([]*LikeRecord, error)
 	}
 
 	customLikeRecordModel struct {
@@ -27,3 +34,27 @@ func NewLikeRecordModel(conn sqlx.SqlConn) LikeRecordModel {
 func (m *customLikeRecordModel) withSession(session sqlx.Session) LikeRecordModel {
 	return NewLikeRecordModel(sqlx.NewSqlConnFromSession(session))
 }
+
+// LikedUsersByBizObj 按 (create_time, user_id) 倒序查询给某个对象点赞的记录，走 ix_biz_obj_ctime_uid 索引
+func (m *customLikeRecordModel) LikedUsersByBizObj(ctx context.Context, bizId, objId int64, createTime string, lastUserId int64, limit int) ([]*LikeRecord, error) {
+	var records []*LikeRecord
+	sql := fmt.Sprintf("select %s from %s where `biz_id` = ? and `obj_id` = ? and (`create_time` < ? or (`create_time` = ? and `user_id` < ?)) order by `create_time` desc, `user_id` desc limit ?", likeRecordRows, m.table)
+	err := m.conn.QueryRowsCtx(ctx, &records, sql, bizId, objId, createTime, createTime, lastUserId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// LikedItemsByUserBiz 按 (create_time, id) 倒序查询用户在某个业务下的点赞记录，走 ix_user_biz_ctime 索引
+func (m *customLikeRecordModel) LikedItemsByUserBiz(ctx context.Context, userId, bizId int64, createTime string, lastId int64, limit int) ([]*LikeRecord, error) {
+	var records []*LikeRecord
+	sql := fmt.Sprintf("select %s from %s where `user_id` = ? and `biz_id` = ? and (`create_time` < ? or (`create_time` = ? and `id` < ?)) order by `create_time` desc, `id` desc limit ?", likeRecordRows, m.table)
+	err := m.conn.QueryRowsCtx(ctx, &records, sql, userId, bizId, createTime, createTime, lastId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ReplyModel = (*customReplyModel)(nil)
+
+type (
+	// ReplyModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customReplyModel.
+	// 注意：这里只用来查询评论作者校验拉黑关系，和reply-rpc共用同一份行记录缓存
+	ReplyModel interface {
+		replyModel
+	}
+
+	customReplyModel struct {
+		*defaultReplyModel
+	}
+)
+
+// NewReplyModel returns a model for the database table.
+func NewReplyModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ReplyModel {
+	return &customReplyModel{
+		defaultReplyModel: newReplyModel(conn, c, opts...),
+	}
+}
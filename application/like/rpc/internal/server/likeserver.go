@@ -40,3 +40,15 @@ func (s *LikeServer) LikeCount(ctx context.Context, in *pb.LikeCountRequest) (*p
 	l := logic.NewLikeCountLogic(ctx, s.svcCtx)
 	return l.LikeCount(in)
 }
+
+// 查询给某个对象点过赞的用户列表（按点赞时间倒序）
+func (s *LikeServer) LikedUsers(ctx context.Context, in *pb.LikedUsersRequest) (*pb.LikedUsersResponse, error) {
+	l := logic.NewLikedUsersLogic(ctx, s.svcCtx)
+	return l.LikedUsers(in)
+}
+
+// 查询用户点赞过的对象列表（按点赞时间倒序）
+func (s *LikeServer) UserLikedItems(ctx context.Context, in *pb.UserLikedItemsRequest) (*pb.UserLikedItemsResponse, error) {
+	l := logic.NewUserLikedItemsLogic(ctx, s.svcCtx)
+	return l.UserLikedItems(in)
+}
@@ -4,16 +4,28 @@ import (
 	"github.com/zeromicro/go-queue/kq"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
+	"posta/application/article/rpc/article"
+	"posta/application/follow/rpc/follow"
 	"posta/application/like/rpc/internal/config"
 	"posta/application/like/rpc/internal/model"
+	"posta/application/like/rpc/internal/types"
+	"posta/pkg/cursor"
+	"posta/pkg/zsetcache"
 )
 
 type ServiceContext struct {
-	Config         config.Config
-	LikeModel      model.LikeRecordModel
-	LikeCountModel model.LikeCountModel
-	BizRedis       *redis.Redis
-	KqPusherClient *kq.Pusher
+	Config             config.Config
+	LikeModel          model.LikeRecordModel
+	LikeCountModel     model.LikeCountModel
+	ReactionCountModel model.LikeReactionCountModel
+	BizRedis           *redis.Redis
+	KqPusherClient     *kq.Pusher
+	ReplyModel         model.ReplyModel
+	ArticleRPC         article.Article
+	FollowRPC          follow.Follow
+	CursorCodec        *cursor.Codec
+	LikersCache        *zsetcache.Cache
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -25,10 +37,20 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	})
 
 	return &ServiceContext{
-		Config:         c,
-		LikeModel:      model.NewLikeRecordModel(sqlx.NewMysql(c.DataSource)),
-		LikeCountModel: model.NewLikeCountModel(sqlx.NewMysql(c.DataSource)),
-		BizRedis:       rds,
-		KqPusherClient: kq.NewPusher(c.KqPusherConf.Brokers, c.KqPusherConf.Topic),
+		Config:             c,
+		LikeModel:          model.NewLikeRecordModel(sqlx.NewMysql(c.DataSource)),
+		LikeCountModel:     model.NewLikeCountModel(sqlx.NewMysql(c.DataSource)),
+		ReactionCountModel: model.NewLikeReactionCountModel(sqlx.NewMysql(c.DataSource)),
+		BizRedis:           rds,
+		KqPusherClient:     kq.NewPusher(c.KqPusherConf.Brokers, c.KqPusherConf.Topic),
+		ReplyModel:         model.NewReplyModel(sqlx.NewMysql(c.DataSourceReply), c.CacheRedis),
+		ArticleRPC:         article.NewArticle(zrpc.MustNewClient(c.ArticleRPC)),
+		FollowRPC:          follow.NewFollow(zrpc.MustNewClient(c.FollowRPC)),
+		CursorCodec:        cursor.NewCodec(c.CursorSecret),
+		LikersCache: zsetcache.New(rds, zsetcache.Conf{
+			Order:   zsetcache.Desc,
+			MaxSize: types.CacheMaxLikersCount,
+			Expire:  types.LikersCacheExpire,
+		}),
 	}
 }
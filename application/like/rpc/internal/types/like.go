@@ -6,9 +6,40 @@ type LikeActionMsg struct {
 	ObjId      int64 ` json:"objId,omitempty"`    // 点赞对象id
 	UserId     int64 ` json:"userId,omitempty"`   // 用户id
 	LikeAction int32 ` json:"likeType,omitempty"` // 类型
+	// 表态类型
+	ReactionType int32 ` json:"reactionType,omitempty"`
+	// 已经点过赞，只是切换了表态类型，不是新的点赞
+	ReactionSwitch bool ` json:"reactionSwitch,omitempty"`
 }
 
 const (
 	BizArticle = iota
 	BizReply
+	// BizReplyDislike 评论点踩，和BizReply互斥
+	BizReplyDislike
+)
+
+// 表态类型，每个用户对同一个对象只能有一个表态
+const (
+	ReactionLike  = iota // 赞
+	ReactionLove         // 爱心
+	ReactionLaugh        // 笑
+	ReactionWow          // 哇
+	ReactionSad          // 难过
+
+	ReactionTypeCount // 表态类型数量
+)
+
+// ReactionNone 取消表态后写在用户表态缓存中的墓碑值。取消后数据库要等like-mq消费完才更新，
+// 如果直接删掉缓存中的表态，这期间再点赞会从数据库加载到旧的表态，误判为已经点过赞
+const ReactionNone = -1
+
+const (
+	DefaultPageSize = 20
+	// 分页查询每页最多返回的条数
+	MaxPageSize = 50
+	// 最近点赞用户zset缓存的最大长度
+	CacheMaxLikersCount = 1000
+	// 最近点赞用户缓存的过期时间，单位秒
+	LikersCacheExpire = 3600 * 24 * 2
 )
@@ -14,12 +14,19 @@ import (
 )
 
 type (
-	IsLikedRequest     = pb.IsLikedRequest
-	IsLikedResponse    = pb.IsLikedResponse
-	LikeActionRequest  = pb.LikeActionRequest
-	LikeActionResponse = pb.LikeActionResponse
-	LikeCountRequest   = pb.LikeCountRequest
-	LikeCountResponse  = pb.LikeCountResponse
+	IsLikedRequest         = pb.IsLikedRequest
+	IsLikedResponse        = pb.IsLikedResponse
+	LikeActionRequest      = pb.LikeActionRequest
+	LikeActionResponse     = pb.LikeActionResponse
+	LikeCountRequest       = pb.LikeCountRequest
+	LikeCountResponse      = pb.LikeCountResponse
+	LikedUserItem          = pb.LikedUserItem
+	LikedUsersRequest      = pb.LikedUsersRequest
+	LikedUsersResponse     = pb.LikedUsersResponse
+	ReactionCount          = pb.ReactionCount
+	UserLikedItem          = pb.UserLikedItem
+	UserLikedItemsRequest  = pb.UserLikedItemsRequest
+	UserLikedItemsResponse = pb.UserLikedItemsResponse
 
 	Like interface {
 		// 用户对某个对象点赞或取消点赞
@@ -28,6 +35,10 @@ type (
 		IsLiked(ctx context.Context, in *IsLikedRequest, opts ...grpc.CallOption) (*IsLikedResponse, error)
 		// 查询点赞数（单个）
 		LikeCount(ctx context.Context, in *LikeCountRequest, opts ...grpc.CallOption) (*LikeCountResponse, error)
+		// 查询给某个对象点过赞的用户列表（按点赞时间倒序）
+		LikedUsers(ctx context.Context, in *LikedUsersRequest, opts ...grpc.CallOption) (*LikedUsersResponse, error)
+		// 查询用户点赞过的对象列表（按点赞时间倒序）
+		UserLikedItems(ctx context.Context, in *UserLikedItemsRequest, opts ...grpc.CallOption) (*UserLikedItemsResponse, error)
 	}
 
 	defaultLike struct {
@@ -58,3 +69,15 @@ func (m *defaultLike) LikeCount(ctx context.Context, in *LikeCountRequest, opts
 	client := pb.NewLikeClient(m.cli.Conn())
 	return client.LikeCount(ctx, in, opts...)
 }
+
+// 查询给某个对象点过赞的用户列表（按点赞时间倒序）
+func (m *defaultLike) LikedUsers(ctx context.Context, in *LikedUsersRequest, opts ...grpc.CallOption) (*LikedUsersResponse, error) {
+	client := pb.NewLikeClient(m.cli.Conn())
+	return client.LikedUsers(ctx, in, opts...)
+}
+
+// 查询用户点赞过的对象列表（按点赞时间倒序）
+func (m *defaultLike) UserLikedItems(ctx context.Context, in *UserLikedItemsRequest, opts ...grpc.CallOption) (*UserLikedItemsResponse, error) {
+	client := pb.NewLikeClient(m.cli.Conn())
+	return client.UserLikedItems(ctx, in, opts...)
+}
@@ -24,10 +24,11 @@ const (
 // 点赞或取消点赞请求
 type LikeActionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`    // 业务类型，这里的话可以是 "article"或"reply"
-	ObjId         int64                  `protobuf:"varint,2,opt,name=obj_id,json=objId,proto3" json:"obj_id,omitempty"`    // 点赞目标ID
-	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
-	Action        int32                  `protobuf:"varint,4,opt,name=action,proto3" json:"action,omitempty"`               // 操作类型：0点赞，1取消点赞
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`                      // 业务类型：0文章，1评论，2评论点踩（和评论点赞互斥）
+	ObjId         int64                  `protobuf:"varint,2,opt,name=obj_id,json=objId,proto3" json:"obj_id,omitempty"`                      // 点赞目标ID
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // 用户ID
+	Action        int32                  `protobuf:"varint,4,opt,name=action,proto3" json:"action,omitempty"`                                 // 操作类型：0点赞（表态），1取消点赞
+	ReactionType  int32                  `protobuf:"varint,5,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"` // 表态类型：0赞，1爱心，2笑，3哇，4难过。已有其他表态时会切换成这个表态
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,13 +91,22 @@ func (x *LikeActionRequest) GetAction() int32 {
 	return 0
 }
 
+func (x *LikeActionRequest) GetReactionType() int32 {
+	if x != nil {
+		return x.ReactionType
+	}
+	return 0
+}
+
 // 点赞响应
 type LikeActionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 返回操作是否成功
-	Liked         bool                   `protobuf:"varint,2,opt,name=liked,proto3" json:"liked,omitempty"`     // 当前操作后是否处于点赞状态（true=已点赞，false=未点赞）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                    // 返回操作是否成功
+	Liked          bool                   `protobuf:"varint,2,opt,name=liked,proto3" json:"liked,omitempty"`                                        // 当前操作后是否处于点赞状态（true=已点赞，false=未点赞）
+	ReactionType   int32                  `protobuf:"varint,3,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`      // 当前操作后的表态类型，liked为false时无意义
+	ReactionCounts []*ReactionCount       `protobuf:"bytes,4,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty"` // 操作后各表态类型的数量
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *LikeActionResponse) Reset() {
@@ -143,6 +153,73 @@ func (x *LikeActionResponse) GetLiked() bool {
 	return false
 }
 
+func (x *LikeActionResponse) GetReactionType() int32 {
+	if x != nil {
+		return x.ReactionType
+	}
+	return 0
+}
+
+func (x *LikeActionResponse) GetReactionCounts() []*ReactionCount {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+// 某个表态类型的数量
+type ReactionCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReactionType  int32                  `protobuf:"varint,1,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	mi := &file_like_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionCount) ProtoMessage() {}
+
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReactionCount) GetReactionType() int32 {
+	if x != nil {
+		return x.ReactionType
+	}
+	return 0
+}
+
+func (x *ReactionCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type IsLikedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
@@ -154,7 +231,7 @@ type IsLikedRequest struct {
 
 func (x *IsLikedRequest) Reset() {
 	*x = IsLikedRequest{}
-	mi := &file_like_proto_msgTypes[2]
+	mi := &file_like_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -166,7 +243,7 @@ func (x *IsLikedRequest) String() string {
 func (*IsLikedRequest) ProtoMessage() {}
 
 func (x *IsLikedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_like_proto_msgTypes[2]
+	mi := &file_like_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -179,7 +256,7 @@ func (x *IsLikedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsLikedRequest.ProtoReflect.Descriptor instead.
 func (*IsLikedRequest) Descriptor() ([]byte, []int) {
-	return file_like_proto_rawDescGZIP(), []int{2}
+	return file_like_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *IsLikedRequest) GetBizId() int64 {
@@ -206,13 +283,14 @@ func (x *IsLikedRequest) GetUserId() int64 {
 type IsLikedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Liked         bool                   `protobuf:"varint,1,opt,name=liked,proto3" json:"liked,omitempty"`
+	ReactionType  int32                  `protobuf:"varint,2,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"` // 用户当前的表态类型，liked为false时无意义
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IsLikedResponse) Reset() {
 	*x = IsLikedResponse{}
-	mi := &file_like_proto_msgTypes[3]
+	mi := &file_like_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -224,7 +302,7 @@ func (x *IsLikedResponse) String() string {
 func (*IsLikedResponse) ProtoMessage() {}
 
 func (x *IsLikedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_like_proto_msgTypes[3]
+	mi := &file_like_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +315,7 @@ func (x *IsLikedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsLikedResponse.ProtoReflect.Descriptor instead.
 func (*IsLikedResponse) Descriptor() ([]byte, []int) {
-	return file_like_proto_rawDescGZIP(), []int{3}
+	return file_like_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *IsLikedResponse) GetLiked() bool {
@@ -247,6 +325,13 @@ func (x *IsLikedResponse) GetLiked() bool {
 	return false
 }
 
+func (x *IsLikedResponse) GetReactionType() int32 {
+	if x != nil {
+		return x.ReactionType
+	}
+	return 0
+}
+
 type LikeCountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
@@ -257,7 +342,7 @@ type LikeCountRequest struct {
 
 func (x *LikeCountRequest) Reset() {
 	*x = LikeCountRequest{}
-	mi := &file_like_proto_msgTypes[4]
+	mi := &file_like_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -269,7 +354,7 @@ func (x *LikeCountRequest) String() string {
 func (*LikeCountRequest) ProtoMessage() {}
 
 func (x *LikeCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_like_proto_msgTypes[4]
+	mi := &file_like_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +367,7 @@ func (x *LikeCountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeCountRequest.ProtoReflect.Descriptor instead.
 func (*LikeCountRequest) Descriptor() ([]byte, []int) {
-	return file_like_proto_rawDescGZIP(), []int{4}
+	return file_like_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LikeCountRequest) GetBizId() int64 {
@@ -300,15 +385,16 @@ func (x *LikeCountRequest) GetObjId() int64 {
 }
 
 type LikeCountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Count          int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // 所有表态类型的总数
+	ReactionCounts []*ReactionCount       `protobuf:"bytes,2,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *LikeCountResponse) Reset() {
 	*x = LikeCountResponse{}
-	mi := &file_like_proto_msgTypes[5]
+	mi := &file_like_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -320,7 +406,7 @@ func (x *LikeCountResponse) String() string {
 func (*LikeCountResponse) ProtoMessage() {}
 
 func (x *LikeCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_like_proto_msgTypes[5]
+	mi := &file_like_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,7 +419,7 @@ func (x *LikeCountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeCountResponse.ProtoReflect.Descriptor instead.
 func (*LikeCountResponse) Descriptor() ([]byte, []int) {
-	return file_like_proto_rawDescGZIP(), []int{5}
+	return file_like_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LikeCountResponse) GetCount() int64 {
@@ -343,36 +429,458 @@ func (x *LikeCountResponse) GetCount() int64 {
 	return 0
 }
 
+func (x *LikeCountResponse) GetReactionCounts() []*ReactionCount {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+type LikedUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	ObjId         int64                  `protobuf:"varint,2,opt,name=obj_id,json=objId,proto3" json:"obj_id,omitempty"`
+	PageSize      int64                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 上一页返回的next_page_token，第一页传空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikedUsersRequest) Reset() {
+	*x = LikedUsersRequest{}
+	mi := &file_like_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikedUsersRequest) ProtoMessage() {}
+
+func (x *LikedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikedUsersRequest.ProtoReflect.Descriptor instead.
+func (*LikedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LikedUsersRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *LikedUsersRequest) GetObjId() int64 {
+	if x != nil {
+		return x.ObjId
+	}
+	return 0
+}
+
+func (x *LikedUsersRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *LikedUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type LikedUserItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LikeTime      int64                  `protobuf:"varint,2,opt,name=like_time,json=likeTime,proto3" json:"like_time,omitempty"` // 点赞时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikedUserItem) Reset() {
+	*x = LikedUserItem{}
+	mi := &file_like_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikedUserItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikedUserItem) ProtoMessage() {}
+
+func (x *LikedUserItem) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikedUserItem.ProtoReflect.Descriptor instead.
+func (*LikedUserItem) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LikedUserItem) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LikedUserItem) GetLikeTime() int64 {
+	if x != nil {
+		return x.LikeTime
+	}
+	return 0
+}
+
+type LikedUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*LikedUserItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	IsEnd         bool                   `protobuf:"varint,2,opt,name=is_end,json=isEnd,proto3" json:"is_end,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 不透明的分页游标，原样传给下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikedUsersResponse) Reset() {
+	*x = LikedUsersResponse{}
+	mi := &file_like_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikedUsersResponse) ProtoMessage() {}
+
+func (x *LikedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikedUsersResponse.ProtoReflect.Descriptor instead.
+func (*LikedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LikedUsersResponse) GetItems() []*LikedUserItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *LikedUsersResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *LikedUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UserLikedItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	PageSize      int64                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 上一页返回的next_page_token，第一页传空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserLikedItemsRequest) Reset() {
+	*x = UserLikedItemsRequest{}
+	mi := &file_like_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserLikedItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLikedItemsRequest) ProtoMessage() {}
+
+func (x *UserLikedItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLikedItemsRequest.ProtoReflect.Descriptor instead.
+func (*UserLikedItemsRequest) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UserLikedItemsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserLikedItemsRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *UserLikedItemsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *UserLikedItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type UserLikedItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 点赞记录ID
+	ObjId         int64                  `protobuf:"varint,2,opt,name=obj_id,json=objId,proto3" json:"obj_id,omitempty"`
+	LikeTime      int64                  `protobuf:"varint,3,opt,name=like_time,json=likeTime,proto3" json:"like_time,omitempty"`
+	ReactionType  int32                  `protobuf:"varint,4,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserLikedItem) Reset() {
+	*x = UserLikedItem{}
+	mi := &file_like_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserLikedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLikedItem) ProtoMessage() {}
+
+func (x *UserLikedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLikedItem.ProtoReflect.Descriptor instead.
+func (*UserLikedItem) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UserLikedItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserLikedItem) GetObjId() int64 {
+	if x != nil {
+		return x.ObjId
+	}
+	return 0
+}
+
+func (x *UserLikedItem) GetLikeTime() int64 {
+	if x != nil {
+		return x.LikeTime
+	}
+	return 0
+}
+
+func (x *UserLikedItem) GetReactionType() int32 {
+	if x != nil {
+		return x.ReactionType
+	}
+	return 0
+}
+
+type UserLikedItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*UserLikedItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	IsEnd         bool                   `protobuf:"varint,2,opt,name=is_end,json=isEnd,proto3" json:"is_end,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 不透明的分页游标，原样传给下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserLikedItemsResponse) Reset() {
+	*x = UserLikedItemsResponse{}
+	mi := &file_like_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserLikedItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLikedItemsResponse) ProtoMessage() {}
+
+func (x *UserLikedItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLikedItemsResponse.ProtoReflect.Descriptor instead.
+func (*UserLikedItemsResponse) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UserLikedItemsResponse) GetItems() []*UserLikedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *UserLikedItemsResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *UserLikedItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_like_proto protoreflect.FileDescriptor
 
 const file_like_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"like.proto\x12\x02pb\"r\n" +
+	"like.proto\x12\x02pb\"\x97\x01\n" +
 	"\x11LikeActionRequest\x12\x15\n" +
 	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x15\n" +
 	"\x06obj_id\x18\x02 \x01(\x03R\x05objId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
-	"\x06action\x18\x04 \x01(\x05R\x06action\"D\n" +
+	"\x06action\x18\x04 \x01(\x05R\x06action\x12#\n" +
+	"\rreaction_type\x18\x05 \x01(\x05R\freactionType\"\xa5\x01\n" +
 	"\x12LikeActionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05liked\x18\x02 \x01(\bR\x05liked\"W\n" +
+	"\x05liked\x18\x02 \x01(\bR\x05liked\x12#\n" +
+	"\rreaction_type\x18\x03 \x01(\x05R\freactionType\x12:\n" +
+	"\x0freaction_counts\x18\x04 \x03(\v2\x11.pb.ReactionCountR\x0ereactionCounts\"J\n" +
+	"\rReactionCount\x12#\n" +
+	"\rreaction_type\x18\x01 \x01(\x05R\freactionType\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"W\n" +
 	"\x0eIsLikedRequest\x12\x15\n" +
 	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x15\n" +
 	"\x06obj_id\x18\x02 \x01(\x03R\x05objId\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\x03R\x06userId\"'\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\"L\n" +
 	"\x0fIsLikedResponse\x12\x14\n" +
-	"\x05liked\x18\x01 \x01(\bR\x05liked\"@\n" +
+	"\x05liked\x18\x01 \x01(\bR\x05liked\x12#\n" +
+	"\rreaction_type\x18\x02 \x01(\x05R\freactionType\"@\n" +
 	"\x10LikeCountRequest\x12\x15\n" +
 	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x15\n" +
-	"\x06obj_id\x18\x02 \x01(\x03R\x05objId\")\n" +
+	"\x06obj_id\x18\x02 \x01(\x03R\x05objId\"e\n" +
 	"\x11LikeCountResponse\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x03R\x05count2\xb1\x01\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12:\n" +
+	"\x0freaction_counts\x18\x02 \x03(\v2\x11.pb.ReactionCountR\x0ereactionCounts\"\x9a\x01\n" +
+	"\x11LikedUsersRequest\x12\x15\n" +
+	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x15\n" +
+	"\x06obj_id\x18\x02 \x01(\x03R\x05objId\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x03R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x06cursorR\auser_id\"E\n" +
+	"\rLikedUserItem\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tlike_time\x18\x02 \x01(\x03R\blikeTime\"\x99\x01\n" +
+	"\x12LikedUsersResponse\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.pb.LikedUserItemR\x05items\x12\x15\n" +
+	"\x06is_end\x18\x02 \x01(\bR\x05isEnd\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x06cursorR\auser_id\"\x9b\x01\n" +
+	"\x15UserLikedItemsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x03R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x06cursorR\x02id\"x\n" +
+	"\rUserLikedItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06obj_id\x18\x02 \x01(\x03R\x05objId\x12\x1b\n" +
+	"\tlike_time\x18\x03 \x01(\x03R\blikeTime\x12#\n" +
+	"\rreaction_type\x18\x04 \x01(\x05R\freactionType\"\x98\x01\n" +
+	"\x16UserLikedItemsResponse\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.pb.UserLikedItemR\x05items\x12\x15\n" +
+	"\x06is_end\x18\x02 \x01(\bR\x05isEnd\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x06cursorR\x02id2\xb7\x02\n" +
 	"\x04Like\x12;\n" +
 	"\n" +
 	"LikeAction\x12\x15.pb.LikeActionRequest\x1a\x16.pb.LikeActionResponse\x122\n" +
 	"\aIsLiked\x12\x12.pb.IsLikedRequest\x1a\x13.pb.IsLikedResponse\x128\n" +
-	"\tLikeCount\x12\x14.pb.LikeCountRequest\x1a\x15.pb.LikeCountResponseB\x06Z\x04./pbb\x06proto3"
+	"\tLikeCount\x12\x14.pb.LikeCountRequest\x1a\x15.pb.LikeCountResponse\x12;\n" +
+	"\n" +
+	"LikedUsers\x12\x15.pb.LikedUsersRequest\x1a\x16.pb.LikedUsersResponse\x12G\n" +
+	"\x0eUserLikedItems\x12\x19.pb.UserLikedItemsRequest\x1a\x1a.pb.UserLikedItemsResponseB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_like_proto_rawDescOnce sync.Once
@@ -386,27 +894,42 @@ func file_like_proto_rawDescGZIP() []byte {
 	return file_like_proto_rawDescData
 }
 
-var file_like_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_like_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_like_proto_goTypes = []any{
-	(*LikeActionRequest)(nil),  // 0: pb.LikeActionRequest
-	(*LikeActionResponse)(nil), // 1: pb.LikeActionResponse
-	(*IsLikedRequest)(nil),     // 2: pb.IsLikedRequest
-	(*IsLikedResponse)(nil),    // 3: pb.IsLikedResponse
-	(*LikeCountRequest)(nil),   // 4: pb.LikeCountRequest
-	(*LikeCountResponse)(nil),  // 5: pb.LikeCountResponse
+	(*LikeActionRequest)(nil),      // 0: pb.LikeActionRequest
+	(*LikeActionResponse)(nil),     // 1: pb.LikeActionResponse
+	(*ReactionCount)(nil),          // 2: pb.ReactionCount
+	(*IsLikedRequest)(nil),         // 3: pb.IsLikedRequest
+	(*IsLikedResponse)(nil),        // 4: pb.IsLikedResponse
+	(*LikeCountRequest)(nil),       // 5: pb.LikeCountRequest
+	(*LikeCountResponse)(nil),      // 6: pb.LikeCountResponse
+	(*LikedUsersRequest)(nil),      // 7: pb.LikedUsersRequest
+	(*LikedUserItem)(nil),          // 8: pb.LikedUserItem
+	(*LikedUsersResponse)(nil),     // 9: pb.LikedUsersResponse
+	(*UserLikedItemsRequest)(nil),  // 10: pb.UserLikedItemsRequest
+	(*UserLikedItem)(nil),          // 11: pb.UserLikedItem
+	(*UserLikedItemsResponse)(nil), // 12: pb.UserLikedItemsResponse
 }
 var file_like_proto_depIdxs = []int32{
-	0, // 0: pb.Like.LikeAction:input_type -> pb.LikeActionRequest
-	2, // 1: pb.Like.IsLiked:input_type -> pb.IsLikedRequest
-	4, // 2: pb.Like.LikeCount:input_type -> pb.LikeCountRequest
-	1, // 3: pb.Like.LikeAction:output_type -> pb.LikeActionResponse
-	3, // 4: pb.Like.IsLiked:output_type -> pb.IsLikedResponse
-	5, // 5: pb.Like.LikeCount:output_type -> pb.LikeCountResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2,  // 0: pb.LikeActionResponse.reaction_counts:type_name -> pb.ReactionCount
+	2,  // 1: pb.LikeCountResponse.reaction_counts:type_name -> pb.ReactionCount
+	8,  // 2: pb.LikedUsersResponse.items:type_name -> pb.LikedUserItem
+	11, // 3: pb.UserLikedItemsResponse.items:type_name -> pb.UserLikedItem
+	0,  // 4: pb.Like.LikeAction:input_type -> pb.LikeActionRequest
+	3,  // 5: pb.Like.IsLiked:input_type -> pb.IsLikedRequest
+	5,  // 6: pb.Like.LikeCount:input_type -> pb.LikeCountRequest
+	7,  // 7: pb.Like.LikedUsers:input_type -> pb.LikedUsersRequest
+	10, // 8: pb.Like.UserLikedItems:input_type -> pb.UserLikedItemsRequest
+	1,  // 9: pb.Like.LikeAction:output_type -> pb.LikeActionResponse
+	4,  // 10: pb.Like.IsLiked:output_type -> pb.IsLikedResponse
+	6,  // 11: pb.Like.LikeCount:output_type -> pb.LikeCountResponse
+	9,  // 12: pb.Like.LikedUsers:output_type -> pb.LikedUsersResponse
+	12, // 13: pb.Like.UserLikedItems:output_type -> pb.UserLikedItemsResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_like_proto_init() }
@@ -420,7 +943,7 @@ func file_like_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_like_proto_rawDesc), len(file_like_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
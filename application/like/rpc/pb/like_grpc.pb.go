@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Like_LikeAction_FullMethodName = "/pb.Like/LikeAction"
-	Like_IsLiked_FullMethodName    = "/pb.Like/IsLiked"
-	Like_LikeCount_FullMethodName  = "/pb.Like/LikeCount"
+	Like_LikeAction_FullMethodName     = "/pb.Like/LikeAction"
+	Like_IsLiked_FullMethodName        = "/pb.Like/IsLiked"
+	Like_LikeCount_FullMethodName      = "/pb.Like/LikeCount"
+	Like_LikedUsers_FullMethodName     = "/pb.Like/LikedUsers"
+	Like_UserLikedItems_FullMethodName = "/pb.Like/UserLikedItems"
 )
 
 // LikeClient is the client API for Like service.
@@ -36,6 +38,10 @@ type LikeClient interface {
 	IsLiked(ctx context.Context, in *IsLikedRequest, opts ...grpc.CallOption) (*IsLikedResponse, error)
 	// 查询点赞数（单个）
 	LikeCount(ctx context.Context, in *LikeCountRequest, opts ...grpc.CallOption) (*LikeCountResponse, error)
+	// 查询给某个对象点过赞的用户列表（按点赞时间倒序）
+	LikedUsers(ctx context.Context, in *LikedUsersRequest, opts ...grpc.CallOption) (*LikedUsersResponse, error)
+	// 查询用户点赞过的对象列表（按点赞时间倒序）
+	UserLikedItems(ctx context.Context, in *UserLikedItemsRequest, opts ...grpc.CallOption) (*UserLikedItemsResponse, error)
 }
 
 type likeClient struct {
@@ -76,6 +82,26 @@ func (c *likeClient) LikeCount(ctx context.Context, in *LikeCountRequest, opts .
 	return out, nil
 }
 
+func (c *likeClient) LikedUsers(ctx context.Context, in *LikedUsersRequest, opts ...grpc.CallOption) (*LikedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LikedUsersResponse)
+	err := c.cc.Invoke(ctx, Like_LikedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *likeClient) UserLikedItems(ctx context.Context, in *UserLikedItemsRequest, opts ...grpc.CallOption) (*UserLikedItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserLikedItemsResponse)
+	err := c.cc.Invoke(ctx, Like_UserLikedItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LikeServer is the server API for Like service.
 // All implementations must embed UnimplementedLikeServer
 // for forward compatibility.
@@ -88,6 +114,10 @@ type LikeServer interface {
 	IsLiked(context.Context, *IsLikedRequest) (*IsLikedResponse, error)
 	// 查询点赞数（单个）
 	LikeCount(context.Context, *LikeCountRequest) (*LikeCountResponse, error)
+	// 查询给某个对象点过赞的用户列表（按点赞时间倒序）
+	LikedUsers(context.Context, *LikedUsersRequest) (*LikedUsersResponse, error)
+	// 查询用户点赞过的对象列表（按点赞时间倒序）
+	UserLikedItems(context.Context, *UserLikedItemsRequest) (*UserLikedItemsResponse, error)
 	mustEmbedUnimplementedLikeServer()
 }
 
@@ -107,6 +137,12 @@ func (UnimplementedLikeServer) IsLiked(context.Context, *IsLikedRequest) (*IsLik
 func (UnimplementedLikeServer) LikeCount(context.Context, *LikeCountRequest) (*LikeCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LikeCount not implemented")
 }
+func (UnimplementedLikeServer) LikedUsers(context.Context, *LikedUsersRequest) (*LikedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikedUsers not implemented")
+}
+func (UnimplementedLikeServer) UserLikedItems(context.Context, *UserLikedItemsRequest) (*UserLikedItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLikedItems not implemented")
+}
 func (UnimplementedLikeServer) mustEmbedUnimplementedLikeServer() {}
 func (UnimplementedLikeServer) testEmbeddedByValue()              {}
 
@@ -182,6 +218,42 @@ func _Like_LikeCount_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Like_LikedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LikeServer).LikedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Like_LikedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LikeServer).LikedUsers(ctx, req.(*LikedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Like_UserLikedItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserLikedItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LikeServer).UserLikedItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Like_UserLikedItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LikeServer).UserLikedItems(ctx, req.(*UserLikedItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Like_ServiceDesc is the grpc.ServiceDesc for Like service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +273,14 @@ var Like_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LikeCount",
 			Handler:    _Like_LikeCount_Handler,
 		},
+		{
+			MethodName: "LikedUsers",
+			Handler:    _Like_LikedUsers_Handler,
+		},
+		{
+			MethodName: "UserLikedItems",
+			Handler:    _Like_UserLikedItems_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "like.proto",
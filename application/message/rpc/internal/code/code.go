@@ -0,0 +1,17 @@
+package code
+
+import "posta/pkg/xcode"
+
+var (
+	UserIdInvalid          = xcode.New(110001, "用户ID无效")
+	CannotMessageSelf      = xcode.New(110002, "不能给自己发私信")
+	MessageContentEmpty    = xcode.New(110003, "私信内容不能为空")
+	MessageContentTooLong  = xcode.New(110004, "私信内容过长")
+	MessageNotAllowed      = xcode.New(110005, "对方只接收关注的人的私信")
+	ConversationNotFound   = xcode.New(110006, "会话不存在")
+	MessageNotFound        = xcode.New(110007, "消息不存在")
+	MessageRecallForbidden = xcode.New(110008, "只能撤回自己发送的消息")
+	MessageRecallExpired   = xcode.New(110009, "消息发送超过2分钟，不能撤回")
+	MessageBlocked         = xcode.New(110010, "对方已将你拉黑，不能发私信")
+	PageTokenInvalid       = xcode.New(110011, "分页游标无效")
+)
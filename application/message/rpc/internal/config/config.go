@@ -0,0 +1,20 @@
+package config
+
+import (
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	zrpc.RpcServerConf
+	DataSource string
+	BizRedis   redis.RedisConf
+	FollowRPC  zrpc.RpcClientConf
+	// CursorSecret 分页游标的签名密钥
+	CursorSecret string
+	// 收到私信、消息被撤回或已读时推送给在线用户
+	PushKqPusherConf struct {
+		Brokers []string
+		Topic   string
+	}
+}
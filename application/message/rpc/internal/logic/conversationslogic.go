@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"posta/application/message/rpc/internal/code"
+	"posta/application/message/rpc/internal/svc"
+	"posta/application/message/rpc/internal/types"
+	"posta/application/message/rpc/pb"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConversationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewConversationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConversationsLogic {
+	return &ConversationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Conversations 会话列表，按最后一条消息的时间倒序
+func (l *ConversationsLogic) Conversations(in *pb.ConversationsRequest) (*pb.ConversationsResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+	scope := fmt.Sprintf("conversations#%d", in.UserId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	// Sort是上一页最后一个会话的最后一条消息的时间，Id是这个会话的ID
+	if pageCursor.IsZero() {
+		pageCursor.Sort = time.Now().Unix()
+		pageCursor.Id = math.MaxInt64
+	}
+
+	members, err := l.svcCtx.ConversationMemberModel.ConversationsByUserId(l.ctx, in.UserId,
+		time.Unix(pageCursor.Sort, 0).Format("2006-01-02 15:04:05"), pageCursor.Id, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[Conversations] ConversationMemberModel.ConversationsByUserId error: %v req: %v", err, in)
+		return nil, err
+	}
+
+	ret := &pb.ConversationsResponse{
+		IsEnd: len(members) < int(in.PageSize),
+	}
+	for _, member := range members {
+		ret.Conversations = append(ret.Conversations, &pb.ConversationItem{
+			ConversationId:  member.ConversationId,
+			PeerUserId:      member.PeerUserId,
+			LastMessageId:   member.LastMessageId,
+			LastSenderId:    member.LastSenderId,
+			LastMessage:     member.LastMessage,
+			LastMessageTime: member.LastMessageTime.Unix(),
+			UnreadCount:     member.UnreadCount,
+		})
+	}
+	if len(members) > 0 {
+		last := members[len(members)-1]
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: last.LastMessageTime.Unix(), Id: last.ConversationId})
+	}
+
+	return ret, nil
+}
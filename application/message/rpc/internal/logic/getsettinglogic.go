@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/message/rpc/internal/code"
+	"posta/application/message/rpc/internal/model"
+	"posta/application/message/rpc/internal/svc"
+	"posta/application/message/rpc/internal/types"
+	"posta/application/message/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSettingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetSettingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSettingLogic {
+	return &GetSettingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetSetting 没有设置过时默认所有人都可以发私信
+func (l *GetSettingLogic) GetSetting(in *pb.GetSettingRequest) (*pb.GetSettingResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+
+	setting, err := l.svcCtx.MessageSettingModel.FindOneByUserId(l.ctx, in.UserId)
+	if err == model.ErrNotFound {
+		return &pb.GetSettingResponse{}, nil
+	}
+	if err != nil {
+		l.Logger.Errorf("[GetSetting] MessageSettingModel.FindOneByUserId error: %v req: %v", err, in)
+		return nil, err
+	}
+
+	return &pb.GetSettingResponse{OnlyFollowing: setting.OnlyFollowing == types.SettingOn}, nil
+}
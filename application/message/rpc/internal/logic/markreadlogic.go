@@ -0,0 +1,82 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/message/rpc/internal/code"
+	"posta/application/message/rpc/internal/svc"
+	"posta/application/message/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 计算未读数期间有新消息进来时的重试次数
+const markReadRetry = 3
+
+type MarkReadLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMarkReadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MarkReadLogic {
+	return &MarkReadLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// MarkRead 把会话标记为已读到messageId，并通知对方更新已读回执
+func (l *MarkReadLogic) MarkRead(in *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+
+	var readMessageId int64
+	for i := 0; i < markReadRetry; i++ {
+		member, err := findMember(l.ctx, l.svcCtx, in.ConversationId, in.UserId)
+		if err != nil {
+			return nil, err
+		}
+		readMessageId = in.MessageId
+		if readMessageId <= 0 || readMessageId > member.LastMessageId {
+			readMessageId = member.LastMessageId
+		}
+		// 已读位置只前进不后退
+		if readMessageId <= member.LastReadMessageId {
+			return &pb.MarkReadResponse{}, nil
+		}
+
+		var unread int64
+		if readMessageId < member.LastMessageId {
+			unread, err = l.svcCtx.MessageModel.CountUnread(l.ctx, in.ConversationId, in.UserId, readMessageId)
+			if err != nil {
+				l.Logger.Errorf("[MarkRead] MessageModel.CountUnread error: %v req: %v", err, in)
+				return nil, err
+			}
+		}
+
+		ok, err := l.svcCtx.ConversationMemberModel.MarkRead(l.ctx, member.Id, member.LastMessageId, readMessageId, unread)
+		if err != nil {
+			l.Logger.Errorf("[MarkRead] ConversationMemberModel.MarkRead error: %v req: %v", err, in)
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		_, err = l.svcCtx.BizRedis.DelCtx(l.ctx, unreadCountKey(in.UserId))
+		if err != nil {
+			l.Logger.Errorf("[MarkRead] BizRedis.DelCtx userId: %d error: %v", in.UserId, err)
+		}
+		pushMessageEvent(l.ctx, l.svcCtx, member.PeerUserId, messageActionRead, in.ConversationId, readMessageId, in.UserId)
+
+		return &pb.MarkReadResponse{}, nil
+	}
+
+	// 一直有新消息进来，这次标记已读放弃，客户端下次打开会话时会重新标记
+	l.Logger.Infof("[MarkRead] conversation changed too frequently, req: %v", in)
+
+	return &pb.MarkReadResponse{}, nil
+}
@@ -0,0 +1,112 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"posta/application/message/rpc/internal/code"
+	"posta/application/message/rpc/internal/model"
+	"posta/application/message/rpc/internal/svc"
+	"posta/application/message/rpc/internal/types"
+	"posta/application/message/rpc/pb"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MessagesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MessagesLogic {
+	return &MessagesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Messages 会话中的消息，按消息ID从新到旧分页，同时返回对方的已读位置用于展示已读回执
+func (l *MessagesLogic) Messages(in *pb.MessagesRequest) (*pb.MessagesResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+	scope := fmt.Sprintf("messages#%d#%d", in.UserId, in.ConversationId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	// Id是上一页最后一条消息的ID，第一页从最新的消息开始
+	if pageCursor.IsZero() {
+		pageCursor.Id = math.MaxInt64
+	}
+
+	// 只有会话的双方才能查看消息
+	member, err := findMember(l.ctx, l.svcCtx, in.ConversationId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := l.svcCtx.MessageModel.MessagesByConversationId(l.ctx, in.ConversationId, pageCursor.Id, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[Messages] MessageModel.MessagesByConversationId error: %v req: %v", err, in)
+		return nil, err
+	}
+
+	ret := &pb.MessagesResponse{
+		IsEnd: len(messages) < int(in.PageSize),
+	}
+	for _, msg := range messages {
+		item := &pb.MessageItem{
+			Id:             msg.Id,
+			ConversationId: msg.ConversationId,
+			SenderId:       msg.SenderId,
+			ReceiverId:     msg.ReceiverId,
+			Content:        msg.Content,
+			IsRecalled:     msg.Status == types.MessageStatusRecall,
+			CreateTime:     msg.CreateTime.Unix(),
+		}
+		if item.IsRecalled {
+			item.Content = ""
+		}
+		ret.Messages = append(ret.Messages, item)
+	}
+	if len(messages) > 0 {
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Id: messages[len(messages)-1].Id})
+	}
+
+	peer, err := l.svcCtx.ConversationMemberModel.FindOneByConversationIdUserId(l.ctx, in.ConversationId, member.PeerUserId)
+	if err != nil && err != model.ErrNotFound {
+		// 已读回执查询失败不影响消息列表
+		l.Logger.Errorf("[Messages] ConversationMemberModel.FindOneByConversationIdUserId conversationId: %d userId: %d error: %v", in.ConversationId, member.PeerUserId, err)
+	}
+	if peer != nil {
+		ret.PeerReadMessageId = peer.LastReadMessageId
+	}
+
+	return ret, nil
+}
+
+// findMember 查询用户在会话中的成员记录，不是会话的成员时返回会话不存在
+func findMember(ctx context.Context, svcCtx *svc.ServiceContext, conversationId, userId int64) (*model.ConversationMember, error) {
+	if conversationId <= 0 {
+		return nil, code.ConversationNotFound
+	}
+	member, err := svcCtx.ConversationMemberModel.FindOneByConversationIdUserId(ctx, conversationId, userId)
+	if err == model.ErrNotFound {
+		return nil, code.ConversationNotFound
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("ConversationMemberModel.FindOneByConversationIdUserId conversationId: %d userId: %d error: %v", conversationId, userId, err)
+		return nil, err
+	}
+
+	return member, nil
+}
@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"posta/application/message/rpc/internal/code"
+	"posta/application/message/rpc/internal/model"
+	"posta/application/message/rpc/internal/svc"
+	"posta/application/message/rpc/internal/types"
+	"posta/application/message/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RecallMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRecallMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecallMessageLogic {
+	return &RecallMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RecallMessage 撤回自己在RecallWindow内发送的消息
+func (l *RecallMessageLogic) RecallMessage(in *pb.RecallMessageRequest) (*pb.RecallMessageResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.ConversationId <= 0 || in.MessageId <= 0 {
+		return nil, code.MessageNotFound
+	}
+
+	msg, err := l.svcCtx.MessageModel.FindOne(l.ctx, in.ConversationId, in.MessageId)
+	if err == model.ErrNotFound {
+		return nil, code.MessageNotFound
+	}
+	if err != nil {
+		l.Logger.Errorf("[RecallMessage] MessageModel.FindOne error: %v req: %v", err, in)
+		return nil, err
+	}
+	if msg.SenderId != in.UserId {
+		return nil, code.MessageRecallForbidden
+	}
+	if msg.Status == types.MessageStatusRecall {
+		return &pb.RecallMessageResponse{}, nil
+	}
+	if time.Since(msg.CreateTime) > types.RecallWindow*time.Second {
+		return nil, code.MessageRecallExpired
+	}
+
+	recalled, err := l.svcCtx.MessageModel.Recall(l.ctx, msg)
+	if err != nil {
+		l.Logger.Errorf("[RecallMessage] MessageModel.Recall error: %v req: %v", err, in)
+		return nil, err
+	}
+	if !recalled {
+		return &pb.RecallMessageResponse{}, nil
+	}
+
+	// 撤回的消息可能还没读，直接删掉接收者的未读数缓存，下次查询时回源
+	_, err = l.svcCtx.BizRedis.DelCtx(l.ctx, unreadCountKey(msg.ReceiverId))
+	if err != nil {
+		l.Logger.Errorf("[RecallMessage] BizRedis.DelCtx userId: %d error: %v", msg.ReceiverId, err)
+	}
+
+	pushMessageEvent(l.ctx, l.svcCtx, msg.ReceiverId, messageActionRecall, msg.ConversationId, msg.Id, msg.SenderId)
+
+	return &pb.RecallMessageResponse{}, nil
+}
@@ -0,0 +1,174 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"posta/application/follow/rpc/follow"
+	"posta/application/message/rpc/internal/code"
+	"posta/application/message/rpc/internal/model"
+	"posta/application/message/rpc/internal/svc"
+	"posta/application/message/rpc/internal/types"
+	"posta/application/message/rpc/pb"
+	"posta/pkg/push"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 推送给客户端的私信事件
+const (
+	messageActionNew    = "new"
+	messageActionRecall = "recall"
+	messageActionRead   = "read"
+)
+
+// 只有未读总数缓存存在时才累加，缓存不存在时由UnreadCount回源数据库统计
+const incrUnreadScript = `
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("INCR", KEYS[1])
+end
+return 0`
+
+type SendMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendMessageLogic {
+	return &SendMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SendMessage 发送私信，两个用户之间第一次发送时创建会话
+func (l *SendMessageLogic) SendMessage(in *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
+	if in.FromUserId <= 0 || in.ToUserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.FromUserId == in.ToUserId {
+		return nil, code.CannotMessageSelf
+	}
+	content := strings.TrimSpace(in.Content)
+	if len(content) == 0 {
+		return nil, code.MessageContentEmpty
+	}
+	if utf8.RuneCountInString(content) > types.MaxContentLength {
+		return nil, code.MessageContentTooLong
+	}
+
+	if err := l.checkPrivacy(in.FromUserId, in.ToUserId); err != nil {
+		return nil, err
+	}
+
+	conversationId, err := l.svcCtx.ConversationModel.FindOrCreate(l.ctx, min(in.FromUserId, in.ToUserId), max(in.FromUserId, in.ToUserId))
+	if err != nil {
+		l.Logger.Errorf("[SendMessage] ConversationModel.FindOrCreate error: %v req: %v", err, in)
+		return nil, err
+	}
+
+	msg := &model.Message{
+		ConversationId: conversationId,
+		SenderId:       in.FromUserId,
+		ReceiverId:     in.ToUserId,
+		Content:        content,
+		CreateTime:     time.Now(),
+	}
+	messageId, err := l.svcCtx.MessageModel.Send(l.ctx, msg, preview(content))
+	if err != nil {
+		l.Logger.Errorf("[SendMessage] MessageModel.Send error: %v req: %v", err, in)
+		return nil, err
+	}
+
+	key := unreadCountKey(in.ToUserId)
+	_, err = l.svcCtx.BizRedis.EvalCtx(l.ctx, incrUnreadScript, []string{key})
+	if err != nil {
+		// 未读数缓存更新失败不影响发送，缓存过期后会回源数据库
+		l.Logger.Errorf("[SendMessage] incrUnreadScript key: %s error: %v", key, err)
+	}
+
+	pushMessageEvent(l.ctx, l.svcCtx, in.ToUserId, messageActionNew, conversationId, messageId, in.FromUserId)
+
+	return &pb.SendMessageResponse{
+		MessageId:      messageId,
+		ConversationId: conversationId,
+		CreateTime:     msg.CreateTime.Unix(),
+	}, nil
+}
+
+// checkPrivacy 被对方拉黑时不能发私信；对方开启了只接收关注的人的私信时，发送者必须是对方关注的人
+func (l *SendMessageLogic) checkPrivacy(fromUserId, toUserId int64) error {
+	blocked, err := l.svcCtx.FollowRPC.CheckBlocked(l.ctx, &follow.CheckBlockedRequest{
+		UserId:   fromUserId,
+		OwnerIds: []int64{toUserId},
+	})
+	if err != nil {
+		l.Logger.Errorf("[SendMessage] FollowRPC.CheckBlocked userId: %d ownerId: %d error: %v", fromUserId, toUserId, err)
+		return err
+	}
+	if len(blocked.BlockerIds) > 0 {
+		return code.MessageBlocked
+	}
+
+	setting, err := l.svcCtx.MessageSettingModel.FindOneByUserId(l.ctx, toUserId)
+	if err == model.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		l.Logger.Errorf("[SendMessage] MessageSettingModel.FindOneByUserId userId: %d error: %v", toUserId, err)
+		return err
+	}
+	if setting.OnlyFollowing != types.SettingOn {
+		return nil
+	}
+
+	ret, err := l.svcCtx.FollowRPC.IsFollowing(l.ctx, &follow.IsFollowingRequest{
+		UserId:         toUserId,
+		FollowedUserId: fromUserId,
+	})
+	if err != nil {
+		l.Logger.Errorf("[SendMessage] FollowRPC.IsFollowing userId: %d followedUserId: %d error: %v", toUserId, fromUserId, err)
+		return err
+	}
+	if !ret.IsFollowing {
+		return code.MessageNotAllowed
+	}
+
+	return nil
+}
+
+// preview 截取消息的前PreviewLength个字符作为会话列表中的预览
+func preview(content string) string {
+	runes := []rune(content)
+	if len(runes) <= types.PreviewLength {
+		return content
+	}
+	return string(runes[:types.PreviewLength])
+}
+
+// pushMessageEvent 通知在线的用户私信有变化，推送失败不影响私信本身，客户端下次拉取时仍然能看到
+func pushMessageEvent(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, action string, conversationId, messageId, peerUserId int64) {
+	msg, err := push.NewMsg(userId, push.EventMessage, map[string]any{
+		"action":         action,
+		"conversationId": conversationId,
+		"messageId":      messageId,
+		"peerUserId":     peerUserId,
+	})
+	if err != nil {
+		logx.Errorf("push.NewMsg userId: %d error: %v", userId, err)
+		return
+	}
+	data, err := json.Marshal(msg)
+	if err != nil {
+		logx.Errorf("Marshal push msg: %+v error: %v", msg, err)
+		return
+	}
+	if err = svcCtx.PushPusherClient.Push(ctx, string(data)); err != nil {
+		logx.Errorf("PushPusherClient.Push userId: %d error: %v", userId, err)
+	}
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"posta/application/message/rpc/internal/code"
+	"posta/application/message/rpc/internal/svc"
+	"posta/application/message/rpc/internal/types"
+	"posta/application/message/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 私信未读总数，各会话的未读数在会话列表中返回
+const prefixUnreadCount = "biz#message#unread#%d"
+
+type UnreadCountLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnreadCountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnreadCountLogic {
+	return &UnreadCountLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnreadCount 私信未读总数，优先读redis，缓存不存在时回源数据库并回填
+func (l *UnreadCountLogic) UnreadCount(in *pb.UnreadCountRequest) (*pb.UnreadCountResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+
+	key := unreadCountKey(in.UserId)
+	val, err := l.svcCtx.BizRedis.GetCtx(l.ctx, key)
+	if err != nil {
+		l.Logger.Errorf("[UnreadCount] BizRedis.GetCtx key: %s error: %v", key, err)
+	}
+	if len(val) > 0 {
+		total, _ := strconv.ParseInt(val, 10, 64)
+		return &pb.UnreadCountResponse{Total: total}, nil
+	}
+
+	total, err := l.svcCtx.ConversationMemberModel.UnreadTotal(l.ctx, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("[UnreadCount] ConversationMemberModel.UnreadTotal userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+	// 未读数为0也要缓存，这样发送私信时才能在上面累加
+	err = l.svcCtx.BizRedis.SetexCtx(l.ctx, key, strconv.FormatInt(total, 10), types.UnreadCountExpire)
+	if err != nil {
+		l.Logger.Errorf("[UnreadCount] BizRedis.SetexCtx key: %s error: %v", key, err)
+	}
+
+	return &pb.UnreadCountResponse{Total: total}, nil
+}
+
+func unreadCountKey(userId int64) string {
+	return fmt.Sprintf(prefixUnreadCount, userId)
+}
@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/message/rpc/internal/code"
+	"posta/application/message/rpc/internal/model"
+	"posta/application/message/rpc/internal/svc"
+	"posta/application/message/rpc/internal/types"
+	"posta/application/message/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateSettingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateSettingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateSettingLogic {
+	return &UpdateSettingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdateSettingLogic) UpdateSetting(in *pb.UpdateSettingRequest) (*pb.UpdateSettingResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+
+	setting := &model.MessageSetting{
+		UserId:        in.UserId,
+		OnlyFollowing: types.SettingOff,
+	}
+	if in.OnlyFollowing {
+		setting.OnlyFollowing = types.SettingOn
+	}
+	err := l.svcCtx.MessageSettingModel.Upsert(l.ctx, setting)
+	if err != nil {
+		l.Logger.Errorf("[UpdateSetting] MessageSettingModel.Upsert error: %v req: %v", err, in)
+		return nil, err
+	}
+
+	return &pb.UpdateSettingResponse{}, nil
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ConversationMemberModel = (*customConversationMemberModel)(nil)
+
+type (
+	// ConversationMemberModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customConversationMemberModel.
+	ConversationMemberModel interface {
+		conversationMemberModel
+		ConversationsByUserId(ctx context.Context, userId int64, lastMessageTime string, lastConversationId int64, limit int) ([]*ConversationMember, error)
+		MarkRead(ctx context.Context, id, lastMessageId, readMessageId, unreadCount int64) (bool, error)
+		UnreadTotal(ctx context.Context, userId int64) (int64, error)
+	}
+
+	customConversationMemberModel struct {
+		*defaultConversationMemberModel
+	}
+)
+
+// NewConversationMemberModel returns a model for the database table.
+func NewConversationMemberModel(conn sqlx.SqlConn) ConversationMemberModel {
+	return &customConversationMemberModel{
+		defaultConversationMemberModel: newConversationMemberModel(conn),
+	}
+}
+
+// ConversationsByUserId 按 (last_message_time, conversation_id) 倒序查询用户的会话，走 ix_user_mtime 索引
+func (m *customConversationMemberModel) ConversationsByUserId(ctx context.Context, userId int64, lastMessageTime string, lastConversationId int64, limit int) ([]*ConversationMember, error) {
+	var members []*ConversationMember
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and (`last_message_time` < ? or (`last_message_time` = ? and `conversation_id` < ?)) order by `last_message_time` desc, `conversation_id` desc limit ?", conversationMemberRows, m.table)
+	err := m.conn.QueryRowsCtx(ctx, &members, query, userId, lastMessageTime, lastMessageTime, lastConversationId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// MarkRead 更新已读位置和未读数。lastMessageId是计算未读数时看到的最后一条消息，
+// 期间有新消息进来时不更新并返回false，由调用方重新计算，避免把新消息的未读数覆盖掉
+func (m *customConversationMemberModel) MarkRead(ctx context.Context, id, lastMessageId, readMessageId, unreadCount int64) (bool, error) {
+	query := fmt.Sprintf("update %s set `last_read_message_id` = ?, `unread_count` = ? where `id` = ? and `last_message_id` = ? and `last_read_message_id` < ?", m.table)
+	ret, err := m.conn.ExecCtx(ctx, query, readMessageId, unreadCount, id, lastMessageId, readMessageId)
+	if err != nil {
+		return false, err
+	}
+	affected, err := ret.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// UnreadTotal 用户所有会话的未读总数，未读数缓存失效时用来回源
+func (m *customConversationMemberModel) UnreadTotal(ctx context.Context, userId int64) (int64, error) {
+	var total int64
+	query := fmt.Sprintf("select coalesce(sum(`unread_count`), 0) from %s where `user_id` = ?", m.table)
+	err := m.conn.QueryRowCtx(ctx, &total, query, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
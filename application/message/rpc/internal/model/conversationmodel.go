@@ -0,0 +1,54 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ConversationModel = (*customConversationModel)(nil)
+
+type (
+	// ConversationModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customConversationModel.
+	ConversationModel interface {
+		conversationModel
+		FindOrCreate(ctx context.Context, userIdA, userIdB int64) (int64, error)
+	}
+
+	customConversationModel struct {
+		*defaultConversationModel
+	}
+)
+
+// NewConversationModel returns a model for the database table.
+func NewConversationModel(conn sqlx.SqlConn) ConversationModel {
+	return &customConversationModel{
+		defaultConversationModel: newConversationModel(conn),
+	}
+}
+
+// FindOrCreate 查询两个用户之间的会话ID，不存在时创建。userIdA必须小于userIdB，保证同一对用户只有一个会话
+func (m *customConversationModel) FindOrCreate(ctx context.Context, userIdA, userIdB int64) (int64, error) {
+	conversation, err := m.FindOneByUserIdAUserIdB(ctx, userIdA, userIdB)
+	if err == nil {
+		return conversation.Id, nil
+	}
+	if err != ErrNotFound {
+		return 0, err
+	}
+
+	// 注意：双方同时给对方发第一条消息时会并发创建，依赖唯一索引去重，插入被忽略后再查一次
+	query := fmt.Sprintf("insert ignore into %s (%s) values (?, ?)", m.table, conversationRowsExpectAutoSet)
+	_, err = m.conn.ExecCtx(ctx, query, userIdA, userIdB)
+	if err != nil {
+		return 0, err
+	}
+	conversation, err = m.FindOneByUserIdAUserIdB(ctx, userIdA, userIdB)
+	if err != nil {
+		return 0, err
+	}
+
+	return conversation.Id, nil
+}
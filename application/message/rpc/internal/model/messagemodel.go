@@ -0,0 +1,170 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+// MessageShardCount 消息表的分表数，和db/message.sql中的message_0 ~ message_15保持一致，上线后不能修改
+const MessageShardCount = 16
+
+const (
+	messageStatusOk     = 0
+	messageStatusRecall = 1
+)
+
+var (
+	messageFieldNames        = builder.RawFieldNames(&Message{})
+	messageRows              = strings.Join(messageFieldNames, ",")
+	messageRowsExpectAutoSet = strings.Join(stringx.Remove(messageFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	conversationMemberTable  = "`conversation_member`"
+)
+
+var _ MessageModel = (*customMessageModel)(nil)
+
+type (
+	// MessageModel 私信消息按会话ID分表，goctl生成的model只支持固定表名，所以这里手写
+	MessageModel interface {
+		Send(ctx context.Context, data *Message, preview string) (int64, error)
+		FindOne(ctx context.Context, conversationId, id int64) (*Message, error)
+		MessagesByConversationId(ctx context.Context, conversationId, lastId int64, limit int) ([]*Message, error)
+		CountUnread(ctx context.Context, conversationId, receiverId, readMessageId int64) (int64, error)
+		Recall(ctx context.Context, data *Message) (bool, error)
+	}
+
+	customMessageModel struct {
+		conn sqlx.SqlConn
+	}
+
+	Message struct {
+		Id             int64     `db:"id"`              // 消息ID
+		ConversationId int64     `db:"conversation_id"` // 会话ID
+		SenderId       int64     `db:"sender_id"`       // 发送者
+		ReceiverId     int64     `db:"receiver_id"`     // 接收者
+		Content        string    `db:"content"`         // 消息内容
+		Status         int64     `db:"status"`          // 状态 0:正常 1:已撤回
+		CreateTime     time.Time `db:"create_time"`     // 创建时间
+		UpdateTime     time.Time `db:"update_time"`     // 最后修改时间
+	}
+)
+
+func NewMessageModel(conn sqlx.SqlConn) MessageModel {
+	return &customMessageModel{
+		conn: conn,
+	}
+}
+
+// Send 在同一个事务里插入消息并更新双方的会话列表，发送者的已读位置直接移到这条消息，接收者的未读数加1
+func (m *customMessageModel) Send(ctx context.Context, data *Message, preview string) (int64, error) {
+	var messageId int64
+	err := m.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table(data.ConversationId), messageRowsExpectAutoSet)
+		ret, err := session.ExecCtx(ctx, query, data.ConversationId, data.SenderId, data.ReceiverId, data.Content, data.Status)
+		if err != nil {
+			return err
+		}
+		messageId, err = ret.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		query = fmt.Sprintf("insert into %s (`conversation_id`, `user_id`, `peer_user_id`, `last_message_id`, `last_sender_id`, `last_message`, `last_message_time`, `last_read_message_id`, `unread_count`) values (?, ?, ?, ?, ?, ?, ?, ?, ?) "+
+			"on duplicate key update `last_message_id` = values(`last_message_id`), `last_sender_id` = values(`last_sender_id`), `last_message` = values(`last_message`), `last_message_time` = values(`last_message_time`), "+
+			"`last_read_message_id` = greatest(`last_read_message_id`, values(`last_read_message_id`)), `unread_count` = `unread_count` + values(`unread_count`)", conversationMemberTable)
+		_, err = session.ExecCtx(ctx, query, data.ConversationId, data.SenderId, data.ReceiverId, messageId, data.SenderId, preview, data.CreateTime, messageId, 0)
+		if err != nil {
+			return err
+		}
+		_, err = session.ExecCtx(ctx, query, data.ConversationId, data.ReceiverId, data.SenderId, messageId, data.SenderId, preview, data.CreateTime, 0, 1)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return messageId, nil
+}
+
+func (m *customMessageModel) FindOne(ctx context.Context, conversationId, id int64) (*Message, error) {
+	query := fmt.Sprintf("select %s from %s where `id` = ? and `conversation_id` = ? limit 1", messageRows, m.table(conversationId))
+	var resp Message
+	err := m.conn.QueryRowCtx(ctx, &resp, query, id, conversationId)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// MessagesByConversationId 按消息ID倒序查询会话中的消息，走 ix_conversation_id 索引
+func (m *customMessageModel) MessagesByConversationId(ctx context.Context, conversationId, lastId int64, limit int) ([]*Message, error) {
+	var messages []*Message
+	query := fmt.Sprintf("select %s from %s where `conversation_id` = ? and `id` < ? order by `id` desc limit ?", messageRows, m.table(conversationId))
+	err := m.conn.QueryRowsCtx(ctx, &messages, query, conversationId, lastId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// CountUnread 统计接收者在readMessageId之后还没读的消息数，撤回的消息不算
+func (m *customMessageModel) CountUnread(ctx context.Context, conversationId, receiverId, readMessageId int64) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `conversation_id` = ? and `id` > ? and `receiver_id` = ? and `status` = ?", m.table(conversationId))
+	err := m.conn.QueryRowCtx(ctx, &count, query, conversationId, readMessageId, receiverId, messageStatusOk)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Recall 撤回消息，同时清掉会话列表中的预览，接收者还没读时减少未读数。消息已经撤回过时返回false
+func (m *customMessageModel) Recall(ctx context.Context, data *Message) (bool, error) {
+	var recalled bool
+	err := m.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table(data.ConversationId))
+		ret, err := session.ExecCtx(ctx, query, messageStatusRecall, data.Id, messageStatusOk)
+		if err != nil {
+			return err
+		}
+		affected, err := ret.RowsAffected()
+		if err != nil {
+			return err
+		}
+		// 防止重复撤回时未读数被多减
+		if affected == 0 {
+			return nil
+		}
+		recalled = true
+
+		query = fmt.Sprintf("update %s set `last_message` = '' where `conversation_id` = ? and `last_message_id` = ?", conversationMemberTable)
+		_, err = session.ExecCtx(ctx, query, data.ConversationId, data.Id)
+		if err != nil {
+			return err
+		}
+		query = fmt.Sprintf("update %s set `unread_count` = greatest(`unread_count` - 1, 0) where `conversation_id` = ? and `user_id` = ? and `last_read_message_id` < ?", conversationMemberTable)
+		_, err = session.ExecCtx(ctx, query, data.ConversationId, data.ReceiverId, data.Id)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return recalled, nil
+}
+
+// table 同一个会话的消息都在同一张表里，分页和已读统计不需要跨表
+func (m *customMessageModel) table(conversationId int64) string {
+	return fmt.Sprintf("`message_%d`", conversationId%MessageShardCount)
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ MessageSettingModel = (*customMessageSettingModel)(nil)
+
+type (
+	// MessageSettingModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customMessageSettingModel.
+	MessageSettingModel interface {
+		messageSettingModel
+		Upsert(ctx context.Context, data *MessageSetting) error
+	}
+
+	customMessageSettingModel struct {
+		*defaultMessageSettingModel
+	}
+)
+
+// NewMessageSettingModel returns a model for the database table.
+func NewMessageSettingModel(conn sqlx.SqlConn) MessageSettingModel {
+	return &customMessageSettingModel{
+		defaultMessageSettingModel: newMessageSettingModel(conn),
+	}
+}
+
+// Upsert 没有设置过时插入，否则更新
+func (m *customMessageSettingModel) Upsert(ctx context.Context, data *MessageSetting) error {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?) on duplicate key update `only_following` = values(`only_following`)", m.table, messageSettingRowsExpectAutoSet)
+	_, err := m.conn.ExecCtx(ctx, query, data.UserId, data.OnlyFollowing)
+	return err
+}
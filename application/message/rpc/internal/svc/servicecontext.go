@@ -0,0 +1,47 @@
+package svc
+
+import (
+	"posta/application/follow/rpc/follow"
+	"posta/application/message/rpc/internal/config"
+	"posta/application/message/rpc/internal/model"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-queue/kq"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type ServiceContext struct {
+	Config                  config.Config
+	ConversationModel       model.ConversationModel
+	ConversationMemberModel model.ConversationMemberModel
+	MessageModel            model.MessageModel
+	MessageSettingModel     model.MessageSettingModel
+	BizRedis                *redis.Redis
+	FollowRPC               follow.Follow
+	PushPusherClient        *kq.Pusher
+	CursorCodec             *cursor.Codec
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	rds, _ := redis.NewRedis(redis.RedisConf{
+		Host:     c.BizRedis.Host,
+		Pass:     c.BizRedis.Pass,
+		Type:     c.BizRedis.Type,
+		NonBlock: true,
+	})
+	conn := sqlx.NewMysql(c.DataSource)
+
+	return &ServiceContext{
+		Config:                  c,
+		ConversationModel:       model.NewConversationModel(conn),
+		ConversationMemberModel: model.NewConversationMemberModel(conn),
+		MessageModel:            model.NewMessageModel(conn),
+		MessageSettingModel:     model.NewMessageSettingModel(conn),
+		BizRedis:                rds,
+		FollowRPC:               follow.NewFollow(zrpc.MustNewClient(c.FollowRPC)),
+		PushPusherClient:        kq.NewPusher(c.PushKqPusherConf.Brokers, c.PushKqPusherConf.Topic),
+		CursorCodec:             cursor.NewCodec(c.CursorSecret),
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+const (
+	DefaultPageSize = 20
+	// MaxPageSize 每页最多的条数，避免一次查询太多
+	MaxPageSize = 50
+	// MaxContentLength 单条私信的最大长度（按字符计算）
+	MaxContentLength = 2000
+	// PreviewLength 会话列表中最后一条消息的预览长度
+	PreviewLength = 64
+	// RecallWindow 发送后多久内可以撤回，单位秒
+	RecallWindow = 120
+	// UnreadCountExpire 未读总数缓存过期时间
+	UnreadCountExpire = 3600 * 24 * 3
+)
+
+const (
+	SettingOff = 0
+	SettingOn  = 1
+)
+
+// 消息状态
+const (
+	MessageStatusOk     = 0
+	MessageStatusRecall = 1
+)
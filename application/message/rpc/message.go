@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"posta/application/message/rpc/internal/config"
+	"posta/application/message/rpc/internal/server"
+	"posta/application/message/rpc/internal/svc"
+	"posta/application/message/rpc/pb"
+	"posta/pkg/interceptors"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	zs "github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+var configFile = flag.String("f", "etc/message.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+	ctx := svc.NewServiceContext(c)
+
+	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
+		pb.RegisterMessageServer(grpcServer, server.NewMessageServer(ctx))
+
+		if c.Mode == zs.DevMode || c.Mode == zs.TestMode {
+			reflection.Register(grpcServer)
+		}
+	})
+	defer s.Stop()
+
+	s.AddUnaryInterceptors(interceptors.ServerErrorInterceptor())
+
+	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
+	s.Start()
+}
@@ -0,0 +1,1213 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.6.1
+// source: message.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MessageItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConversationId int64                  `protobuf:"varint,2,opt,name=conversationId,proto3" json:"conversationId,omitempty"`
+	SenderId       int64                  `protobuf:"varint,3,opt,name=senderId,proto3" json:"senderId,omitempty"`
+	ReceiverId     int64                  `protobuf:"varint,4,opt,name=receiverId,proto3" json:"receiverId,omitempty"`
+	Content        string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"` // 撤回的消息内容为空
+	IsRecalled     bool                   `protobuf:"varint,6,opt,name=isRecalled,proto3" json:"isRecalled,omitempty"`
+	CreateTime     int64                  `protobuf:"varint,7,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MessageItem) Reset() {
+	*x = MessageItem{}
+	mi := &file_message_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageItem) ProtoMessage() {}
+
+func (x *MessageItem) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageItem.ProtoReflect.Descriptor instead.
+func (*MessageItem) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MessageItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MessageItem) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *MessageItem) GetSenderId() int64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *MessageItem) GetReceiverId() int64 {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return 0
+}
+
+func (x *MessageItem) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MessageItem) GetIsRecalled() bool {
+	if x != nil {
+		return x.IsRecalled
+	}
+	return false
+}
+
+func (x *MessageItem) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type SendMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    int64                  `protobuf:"varint,1,opt,name=fromUserId,proto3" json:"fromUserId,omitempty"`
+	ToUserId      int64                  `protobuf:"varint,2,opt,name=toUserId,proto3" json:"toUserId,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_message_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendMessageRequest) GetFromUserId() int64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *SendMessageRequest) GetToUserId() int64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *SendMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type SendMessageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MessageId      int64                  `protobuf:"varint,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	ConversationId int64                  `protobuf:"varint,2,opt,name=conversationId,proto3" json:"conversationId,omitempty"`
+	CreateTime     int64                  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	mi := &file_message_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendMessageResponse) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *SendMessageResponse) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *SendMessageResponse) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type ConversationItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId  int64                  `protobuf:"varint,1,opt,name=conversationId,proto3" json:"conversationId,omitempty"`
+	PeerUserId      int64                  `protobuf:"varint,2,opt,name=peerUserId,proto3" json:"peerUserId,omitempty"` // 会话的另一方
+	LastMessageId   int64                  `protobuf:"varint,3,opt,name=lastMessageId,proto3" json:"lastMessageId,omitempty"`
+	LastSenderId    int64                  `protobuf:"varint,4,opt,name=lastSenderId,proto3" json:"lastSenderId,omitempty"`
+	LastMessage     string                 `protobuf:"bytes,5,opt,name=lastMessage,proto3" json:"lastMessage,omitempty"` // 最后一条消息的预览
+	LastMessageTime int64                  `protobuf:"varint,6,opt,name=lastMessageTime,proto3" json:"lastMessageTime,omitempty"`
+	UnreadCount     int64                  `protobuf:"varint,7,opt,name=unreadCount,proto3" json:"unreadCount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConversationItem) Reset() {
+	*x = ConversationItem{}
+	mi := &file_message_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationItem) ProtoMessage() {}
+
+func (x *ConversationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationItem.ProtoReflect.Descriptor instead.
+func (*ConversationItem) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConversationItem) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *ConversationItem) GetPeerUserId() int64 {
+	if x != nil {
+		return x.PeerUserId
+	}
+	return 0
+}
+
+func (x *ConversationItem) GetLastMessageId() int64 {
+	if x != nil {
+		return x.LastMessageId
+	}
+	return 0
+}
+
+func (x *ConversationItem) GetLastSenderId() int64 {
+	if x != nil {
+		return x.LastSenderId
+	}
+	return 0
+}
+
+func (x *ConversationItem) GetLastMessage() string {
+	if x != nil {
+		return x.LastMessage
+	}
+	return ""
+}
+
+func (x *ConversationItem) GetLastMessageTime() int64 {
+	if x != nil {
+		return x.LastMessageTime
+	}
+	return 0
+}
+
+func (x *ConversationItem) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type ConversationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	PageSize      int64                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationsRequest) Reset() {
+	*x = ConversationsRequest{}
+	mi := &file_message_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationsRequest) ProtoMessage() {}
+
+func (x *ConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConversationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ConversationsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ConversationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversations []*ConversationItem    `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	IsEnd         bool                   `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationsResponse) Reset() {
+	*x = ConversationsResponse{}
+	mi := &file_message_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationsResponse) ProtoMessage() {}
+
+func (x *ConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationsResponse.ProtoReflect.Descriptor instead.
+func (*ConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConversationsResponse) GetConversations() []*ConversationItem {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+func (x *ConversationsResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *ConversationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ConversationId int64                  `protobuf:"varint,2,opt,name=conversationId,proto3" json:"conversationId,omitempty"`
+	PageSize       int64                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken      string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空，从最新的消息开始
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MessagesRequest) Reset() {
+	*x = MessagesRequest{}
+	mi := &file_message_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagesRequest) ProtoMessage() {}
+
+func (x *MessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagesRequest.ProtoReflect.Descriptor instead.
+func (*MessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MessagesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MessagesRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *MessagesRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *MessagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type MessagesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Messages          []*MessageItem         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // 按消息ID倒序
+	IsEnd             bool                   `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+	PeerReadMessageId int64                  `protobuf:"varint,4,opt,name=peerReadMessageId,proto3" json:"peerReadMessageId,omitempty"` // 对方已读到的消息ID，ID不大于它的消息显示为已读
+	NextPageToken     string                 `protobuf:"bytes,5,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`          // 不透明的分页游标，原样传给下一页
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MessagesResponse) Reset() {
+	*x = MessagesResponse{}
+	mi := &file_message_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagesResponse) ProtoMessage() {}
+
+func (x *MessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagesResponse.ProtoReflect.Descriptor instead.
+func (*MessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MessagesResponse) GetMessages() []*MessageItem {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *MessagesResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *MessagesResponse) GetPeerReadMessageId() int64 {
+	if x != nil {
+		return x.PeerReadMessageId
+	}
+	return 0
+}
+
+func (x *MessagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RecallMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ConversationId int64                  `protobuf:"varint,2,opt,name=conversationId,proto3" json:"conversationId,omitempty"`
+	MessageId      int64                  `protobuf:"varint,3,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecallMessageRequest) Reset() {
+	*x = RecallMessageRequest{}
+	mi := &file_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecallMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecallMessageRequest) ProtoMessage() {}
+
+func (x *RecallMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecallMessageRequest.ProtoReflect.Descriptor instead.
+func (*RecallMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RecallMessageRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecallMessageRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *RecallMessageRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type RecallMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecallMessageResponse) Reset() {
+	*x = RecallMessageResponse{}
+	mi := &file_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecallMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecallMessageResponse) ProtoMessage() {}
+
+func (x *RecallMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecallMessageResponse.ProtoReflect.Descriptor instead.
+func (*RecallMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{9}
+}
+
+type MarkReadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ConversationId int64                  `protobuf:"varint,2,opt,name=conversationId,proto3" json:"conversationId,omitempty"`
+	MessageId      int64                  `protobuf:"varint,3,opt,name=messageId,proto3" json:"messageId,omitempty"` // 已读到的消息ID，0表示全部已读
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkReadRequest) Reset() {
+	*x = MarkReadRequest{}
+	mi := &file_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadRequest) ProtoMessage() {}
+
+func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MarkReadRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MarkReadRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *MarkReadRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type MarkReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReadResponse) Reset() {
+	*x = MarkReadResponse{}
+	mi := &file_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadResponse) ProtoMessage() {}
+
+func (x *MarkReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkReadResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{11}
+}
+
+type UnreadCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreadCountRequest) Reset() {
+	*x = UnreadCountRequest{}
+	mi := &file_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCountRequest) ProtoMessage() {}
+
+func (x *UnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*UnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnreadCountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UnreadCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreadCountResponse) Reset() {
+	*x = UnreadCountResponse{}
+	mi := &file_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCountResponse) ProtoMessage() {}
+
+func (x *UnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*UnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UnreadCountResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type UpdateSettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OnlyFollowing bool                   `protobuf:"varint,2,opt,name=onlyFollowing,proto3" json:"onlyFollowing,omitempty"` // 只接收我关注的人发来的私信
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingRequest) Reset() {
+	*x = UpdateSettingRequest{}
+	mi := &file_message_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingRequest) ProtoMessage() {}
+
+func (x *UpdateSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateSettingRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateSettingRequest) GetOnlyFollowing() bool {
+	if x != nil {
+		return x.OnlyFollowing
+	}
+	return false
+}
+
+type UpdateSettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingResponse) Reset() {
+	*x = UpdateSettingResponse{}
+	mi := &file_message_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingResponse) ProtoMessage() {}
+
+func (x *UpdateSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSettingResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{15}
+}
+
+type GetSettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingRequest) Reset() {
+	*x = GetSettingRequest{}
+	mi := &file_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingRequest) ProtoMessage() {}
+
+func (x *GetSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingRequest.ProtoReflect.Descriptor instead.
+func (*GetSettingRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetSettingRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetSettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OnlyFollowing bool                   `protobuf:"varint,1,opt,name=onlyFollowing,proto3" json:"onlyFollowing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingResponse) Reset() {
+	*x = GetSettingResponse{}
+	mi := &file_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingResponse) ProtoMessage() {}
+
+func (x *GetSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingResponse.ProtoReflect.Descriptor instead.
+func (*GetSettingResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetSettingResponse) GetOnlyFollowing() bool {
+	if x != nil {
+		return x.OnlyFollowing
+	}
+	return false
+}
+
+var File_message_proto protoreflect.FileDescriptor
+
+const file_message_proto_rawDesc = "" +
+	"\n" +
+	"\rmessage.proto\x12\x02pb\"\xdb\x01\n" +
+	"\vMessageItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x0econversationId\x18\x02 \x01(\x03R\x0econversationId\x12\x1a\n" +
+	"\bsenderId\x18\x03 \x01(\x03R\bsenderId\x12\x1e\n" +
+	"\n" +
+	"receiverId\x18\x04 \x01(\x03R\n" +
+	"receiverId\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"isRecalled\x18\x06 \x01(\bR\n" +
+	"isRecalled\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\a \x01(\x03R\n" +
+	"createTime\"j\n" +
+	"\x12SendMessageRequest\x12\x1e\n" +
+	"\n" +
+	"fromUserId\x18\x01 \x01(\x03R\n" +
+	"fromUserId\x12\x1a\n" +
+	"\btoUserId\x18\x02 \x01(\x03R\btoUserId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"{\n" +
+	"\x13SendMessageResponse\x12\x1c\n" +
+	"\tmessageId\x18\x01 \x01(\x03R\tmessageId\x12&\n" +
+	"\x0econversationId\x18\x02 \x01(\x03R\x0econversationId\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x03 \x01(\x03R\n" +
+	"createTime\"\x92\x02\n" +
+	"\x10ConversationItem\x12&\n" +
+	"\x0econversationId\x18\x01 \x01(\x03R\x0econversationId\x12\x1e\n" +
+	"\n" +
+	"peerUserId\x18\x02 \x01(\x03R\n" +
+	"peerUserId\x12$\n" +
+	"\rlastMessageId\x18\x03 \x01(\x03R\rlastMessageId\x12\"\n" +
+	"\flastSenderId\x18\x04 \x01(\x03R\flastSenderId\x12 \n" +
+	"\vlastMessage\x18\x05 \x01(\tR\vlastMessage\x12(\n" +
+	"\x0flastMessageTime\x18\x06 \x01(\x03R\x0flastMessageTime\x12 \n" +
+	"\vunreadCount\x18\a \x01(\x03R\vunreadCount\"\x8c\x01\n" +
+	"\x14ConversationsRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x05 \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03J\x04\b\x04\x10\x05R\x06cursorR\x0econversationId\"\xb3\x01\n" +
+	"\x15ConversationsResponse\x12:\n" +
+	"\rconversations\x18\x01 \x03(\v2\x14.pb.ConversationItemR\rconversations\x12\x14\n" +
+	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x05 \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x06cursorR\x0econversationId\"\x99\x01\n" +
+	"\x0fMessagesRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x0econversationId\x18\x02 \x01(\x03R\x0econversationId\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x05 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04R\x06cursor\"\xb7\x01\n" +
+	"\x10MessagesResponse\x12+\n" +
+	"\bmessages\x18\x01 \x03(\v2\x0f.pb.MessageItemR\bmessages\x12\x14\n" +
+	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12,\n" +
+	"\x11peerReadMessageId\x18\x04 \x01(\x03R\x11peerReadMessageId\x12$\n" +
+	"\rnextPageToken\x18\x05 \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04R\x06cursor\"t\n" +
+	"\x14RecallMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x0econversationId\x18\x02 \x01(\x03R\x0econversationId\x12\x1c\n" +
+	"\tmessageId\x18\x03 \x01(\x03R\tmessageId\"\x17\n" +
+	"\x15RecallMessageResponse\"o\n" +
+	"\x0fMarkReadRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x0econversationId\x18\x02 \x01(\x03R\x0econversationId\x12\x1c\n" +
+	"\tmessageId\x18\x03 \x01(\x03R\tmessageId\"\x12\n" +
+	"\x10MarkReadResponse\",\n" +
+	"\x12UnreadCountRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\"+\n" +
+	"\x13UnreadCountResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\"T\n" +
+	"\x14UpdateSettingRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12$\n" +
+	"\ronlyFollowing\x18\x02 \x01(\bR\ronlyFollowing\"\x17\n" +
+	"\x15UpdateSettingResponse\"+\n" +
+	"\x11GetSettingRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\":\n" +
+	"\x12GetSettingResponse\x12$\n" +
+	"\ronlyFollowing\x18\x01 \x01(\bR\ronlyFollowing2\x86\x04\n" +
+	"\aMessage\x12>\n" +
+	"\vSendMessage\x12\x16.pb.SendMessageRequest\x1a\x17.pb.SendMessageResponse\x12D\n" +
+	"\rConversations\x12\x18.pb.ConversationsRequest\x1a\x19.pb.ConversationsResponse\x125\n" +
+	"\bMessages\x12\x13.pb.MessagesRequest\x1a\x14.pb.MessagesResponse\x12D\n" +
+	"\rRecallMessage\x12\x18.pb.RecallMessageRequest\x1a\x19.pb.RecallMessageResponse\x125\n" +
+	"\bMarkRead\x12\x13.pb.MarkReadRequest\x1a\x14.pb.MarkReadResponse\x12>\n" +
+	"\vUnreadCount\x12\x16.pb.UnreadCountRequest\x1a\x17.pb.UnreadCountResponse\x12D\n" +
+	"\rUpdateSetting\x12\x18.pb.UpdateSettingRequest\x1a\x19.pb.UpdateSettingResponse\x12;\n" +
+	"\n" +
+	"GetSetting\x12\x15.pb.GetSettingRequest\x1a\x16.pb.GetSettingResponseB\x06Z\x04./pbb\x06proto3"
+
+var (
+	file_message_proto_rawDescOnce sync.Once
+	file_message_proto_rawDescData []byte
+)
+
+func file_message_proto_rawDescGZIP() []byte {
+	file_message_proto_rawDescOnce.Do(func() {
+		file_message_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_message_proto_rawDesc), len(file_message_proto_rawDesc)))
+	})
+	return file_message_proto_rawDescData
+}
+
+var file_message_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_message_proto_goTypes = []any{
+	(*MessageItem)(nil),           // 0: pb.MessageItem
+	(*SendMessageRequest)(nil),    // 1: pb.SendMessageRequest
+	(*SendMessageResponse)(nil),   // 2: pb.SendMessageResponse
+	(*ConversationItem)(nil),      // 3: pb.ConversationItem
+	(*ConversationsRequest)(nil),  // 4: pb.ConversationsRequest
+	(*ConversationsResponse)(nil), // 5: pb.ConversationsResponse
+	(*MessagesRequest)(nil),       // 6: pb.MessagesRequest
+	(*MessagesResponse)(nil),      // 7: pb.MessagesResponse
+	(*RecallMessageRequest)(nil),  // 8: pb.RecallMessageRequest
+	(*RecallMessageResponse)(nil), // 9: pb.RecallMessageResponse
+	(*MarkReadRequest)(nil),       // 10: pb.MarkReadRequest
+	(*MarkReadResponse)(nil),      // 11: pb.MarkReadResponse
+	(*UnreadCountRequest)(nil),    // 12: pb.UnreadCountRequest
+	(*UnreadCountResponse)(nil),   // 13: pb.UnreadCountResponse
+	(*UpdateSettingRequest)(nil),  // 14: pb.UpdateSettingRequest
+	(*UpdateSettingResponse)(nil), // 15: pb.UpdateSettingResponse
+	(*GetSettingRequest)(nil),     // 16: pb.GetSettingRequest
+	(*GetSettingResponse)(nil),    // 17: pb.GetSettingResponse
+}
+var file_message_proto_depIdxs = []int32{
+	3,  // 0: pb.ConversationsResponse.conversations:type_name -> pb.ConversationItem
+	0,  // 1: pb.MessagesResponse.messages:type_name -> pb.MessageItem
+	1,  // 2: pb.Message.SendMessage:input_type -> pb.SendMessageRequest
+	4,  // 3: pb.Message.Conversations:input_type -> pb.ConversationsRequest
+	6,  // 4: pb.Message.Messages:input_type -> pb.MessagesRequest
+	8,  // 5: pb.Message.RecallMessage:input_type -> pb.RecallMessageRequest
+	10, // 6: pb.Message.MarkRead:input_type -> pb.MarkReadRequest
+	12, // 7: pb.Message.UnreadCount:input_type -> pb.UnreadCountRequest
+	14, // 8: pb.Message.UpdateSetting:input_type -> pb.UpdateSettingRequest
+	16, // 9: pb.Message.GetSetting:input_type -> pb.GetSettingRequest
+	2,  // 10: pb.Message.SendMessage:output_type -> pb.SendMessageResponse
+	5,  // 11: pb.Message.Conversations:output_type -> pb.ConversationsResponse
+	7,  // 12: pb.Message.Messages:output_type -> pb.MessagesResponse
+	9,  // 13: pb.Message.RecallMessage:output_type -> pb.RecallMessageResponse
+	11, // 14: pb.Message.MarkRead:output_type -> pb.MarkReadResponse
+	13, // 15: pb.Message.UnreadCount:output_type -> pb.UnreadCountResponse
+	15, // 16: pb.Message.UpdateSetting:output_type -> pb.UpdateSettingResponse
+	17, // 17: pb.Message.GetSetting:output_type -> pb.GetSettingResponse
+	10, // [10:18] is the sub-list for method output_type
+	2,  // [2:10] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_message_proto_init() }
+func file_message_proto_init() {
+	if File_message_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_message_proto_rawDesc), len(file_message_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_message_proto_goTypes,
+		DependencyIndexes: file_message_proto_depIdxs,
+		MessageInfos:      file_message_proto_msgTypes,
+	}.Build()
+	File_message_proto = out.File
+	file_message_proto_goTypes = nil
+	file_message_proto_depIdxs = nil
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"github.com/zeromicro/go-queue/kq"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	service.ServiceConf
+	LikeKqConsumerConf    kq.KqConf
+	ReplyKqConsumerConf   kq.KqConf
+	FollowKqConsumerConf  kq.KqConf
+	MentionKqConsumerConf kq.KqConf
+	DataSource            string
+	DataSourceReply       string
+	CacheRedis            cache.CacheConf
+	BizRedis              redis.RedisConf
+	ArticleRPC            zrpc.RpcClientConf
+	// 通知写入后推送给在线用户
+	PushKqPusherConf struct {
+		Brokers []string
+		Topic   string
+	}
+}
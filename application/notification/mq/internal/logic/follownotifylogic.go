@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"posta/application/notification/mq/internal/model"
+	"posta/application/notification/mq/internal/svc"
+	"posta/application/notification/mq/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FollowNotifyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFollowNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FollowNotifyLogic {
+	return &FollowNotifyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *FollowNotifyLogic) Consume(ctx context.Context, _, val string) error {
+	var msg *types.CanalFollowMsg
+	err := json.Unmarshal([]byte(val), &msg)
+	if err != nil {
+		logx.Errorf("Consume val: %s error: %v", val, err)
+		return err
+	}
+
+	return l.notifyFollow(ctx, msg)
+}
+
+// notifyFollow 新增关注时通知被关注的人，取消关注不通知
+func (l *FollowNotifyLogic) notifyFollow(ctx context.Context, msg *types.CanalFollowMsg) error {
+	for _, d := range msg.Data {
+		status, _ := strconv.Atoi(d.Status)
+		if status != types.FollowStatusFollow {
+			continue
+		}
+		userId, _ := strconv.ParseInt(d.UserId, 10, 64)
+		followedUserId, _ := strconv.ParseInt(d.FollowedUserID, 10, 64)
+
+		err := addNotification(ctx, l.svcCtx, &model.Notification{
+			UserId:  followedUserId,
+			Type:    types.NotificationTypeFollow,
+			ActorId: userId,
+		}, false)
+		if err != nil {
+			logx.Errorf("addNotification follow userId: %d followedUserId: %d error: %v", userId, followedUserId, err)
+		}
+	}
+
+	return nil
+}
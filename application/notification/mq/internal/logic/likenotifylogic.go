@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"posta/application/article/rpc/article"
+	"posta/application/notification/mq/internal/model"
+	"posta/application/notification/mq/internal/svc"
+	"posta/application/notification/mq/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LikeNotifyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLikeNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LikeNotifyLogic {
+	return &LikeNotifyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *LikeNotifyLogic) Consume(ctx context.Context, _, val string) error {
+	var msg *types.LikeActionMsg
+	err := json.Unmarshal([]byte(val), &msg)
+	if err != nil {
+		logx.Errorf("Consume val: %s error: %v", val, err)
+		return err
+	}
+
+	return l.notifyLike(ctx, msg)
+}
+
+// notifyLike 通知被点赞对象的作者，同一个对象上的点赞聚合成一条"A等N人赞了..."
+func (l *LikeNotifyLogic) notifyLike(ctx context.Context, msg *types.LikeActionMsg) error {
+	// 取消点赞、点踩和切换表态都不通知
+	if msg.LikeAction != types.LikeActionLike || msg.ReactionSwitch {
+		return nil
+	}
+
+	var (
+		bizId   string
+		ownerId int64
+	)
+	switch msg.BizId {
+	case types.BizArticle:
+		bizId = types.NotificationBizArticle
+		detail, err := l.svcCtx.ArticleRPC.ArticleDetail(ctx, &article.ArticleDetailRequest{ArticleId: msg.ObjId})
+		if err != nil {
+			logx.Errorf("ArticleRPC.ArticleDetail articleId: %d error: %v", msg.ObjId, err)
+			return nil
+		}
+		if detail.Article == nil {
+			return nil
+		}
+		ownerId = detail.Article.AuthorId
+	case types.BizReply:
+		bizId = types.NotificationBizReply
+		reply, err := l.svcCtx.ReplyModel.FindOne(ctx, msg.ObjId)
+		if err != nil {
+			logx.Errorf("ReplyModel.FindOne replyId: %d error: %v", msg.ObjId, err)
+			return nil
+		}
+		ownerId = reply.ReplyUserId
+	default:
+		return nil
+	}
+
+	err := addNotification(ctx, l.svcCtx, &model.Notification{
+		UserId:  ownerId,
+		Type:    types.NotificationTypeLike,
+		BizId:   bizId,
+		ObjId:   msg.ObjId,
+		ActorId: msg.UserId,
+	}, true)
+	if err != nil {
+		logx.Errorf("addNotification like msg: %+v error: %v", msg, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"posta/application/notification/mq/internal/model"
+	"posta/application/notification/mq/internal/svc"
+	"posta/application/notification/mq/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MentionNotifyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMentionNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MentionNotifyLogic {
+	return &MentionNotifyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *MentionNotifyLogic) Consume(ctx context.Context, _, val string) error {
+	var msg *types.MentionMsg
+	err := json.Unmarshal([]byte(val), &msg)
+	if err != nil {
+		logx.Errorf("Consume val: %s error: %v", val, err)
+		return err
+	}
+
+	err = addNotification(ctx, l.svcCtx, &model.Notification{
+		UserId:  msg.UserId,
+		Type:    types.NotificationTypeMention,
+		BizId:   msg.BizId,
+		ObjId:   msg.ObjId,
+		ActorId: msg.FromUserId,
+	}, false)
+	if err != nil {
+		logx.Errorf("addNotification mention msg: %+v error: %v", msg, err)
+	}
+
+	return nil
+}
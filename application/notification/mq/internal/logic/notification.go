@@ -0,0 +1,106 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"posta/application/notification/mq/internal/model"
+	"posta/application/notification/mq/internal/svc"
+	"posta/application/notification/mq/internal/types"
+	"posta/pkg/push"
+
+	"github.com/zeromicro/go-queue/kq"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+// 和notification-rpc中的缓存key保持一致
+const prefixUnreadCount = "biz#notification#unread#%d"
+
+// 只有未读数缓存存在时才累加，缓存不存在时由notification-rpc回源数据库统计，否则缓存中只有这一种类型的未读数
+const incrUnreadScript = `
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("HINCRBY", KEYS[1], ARGV[1], 1)
+end
+return 0`
+
+// addNotification 写入一条通知。aggregate为true时，同一个对象上还没读的通知会合并成一条，只更新最近的触发人和人数
+func addNotification(ctx context.Context, svcCtx *svc.ServiceContext, n *model.Notification, aggregate bool) error {
+	// 自己给自己点赞、回复自己等不需要通知
+	if n.UserId <= 0 || n.UserId == n.ActorId {
+		return nil
+	}
+
+	if aggregate {
+		exist, err := svcCtx.NotificationModel.FindUnreadByObj(ctx, n.UserId, n.Type, n.BizId, n.ObjId)
+		if err == nil {
+			// 合并到已有的未读通知中，未读数不变。已经触发过的人不重复计数，也不再推送
+			added, err := svcCtx.NotificationModel.AddActor(ctx, exist, n.ActorId)
+			if err != nil {
+				return err
+			}
+			if added {
+				pushNotification(ctx, svcCtx, n)
+			}
+			return nil
+		}
+		if !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	}
+
+	n.ActorCount = 1
+	_, err := svcCtx.NotificationModel.Insert(ctx, n)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf(prefixUnreadCount, n.UserId)
+	_, err = svcCtx.BizRedis.EvalCtx(ctx, incrUnreadScript, []string{key}, strconv.FormatInt(n.Type, 10))
+	if err != nil {
+		// 未读数缓存更新失败不影响通知写入，缓存过期后会回源数据库
+		logx.Errorf("incrUnreadScript key: %s error: %v", key, err)
+	}
+
+	pushNotification(ctx, svcCtx, n)
+
+	return nil
+}
+
+// pushNotification 通知在线的用户有新通知，推送失败不影响通知写入，客户端下次拉取时仍然能看到
+func pushNotification(ctx context.Context, svcCtx *svc.ServiceContext, n *model.Notification) {
+	eventType := push.EventNotification
+	if n.Type == types.NotificationTypeReply {
+		eventType = push.EventReply
+	}
+	msg, err := push.NewMsg(n.UserId, eventType, map[string]any{
+		"notificationType": n.Type,
+		"bizId":            n.BizId,
+		"objId":            n.ObjId,
+		"actorId":          n.ActorId,
+	})
+	if err != nil {
+		logx.Errorf("push.NewMsg notification: %+v error: %v", n, err)
+		return
+	}
+	data, err := json.Marshal(msg)
+	if err != nil {
+		logx.Errorf("Marshal push msg: %+v error: %v", msg, err)
+		return
+	}
+	if err = svcCtx.PushPusherClient.Push(ctx, string(data)); err != nil {
+		logx.Errorf("PushPusherClient.Push userId: %d error: %v", n.UserId, err)
+	}
+}
+
+func Consumers(ctx context.Context, svcCtx *svc.ServiceContext) []service.Service {
+	return []service.Service{
+		kq.MustNewQueue(svcCtx.Config.LikeKqConsumerConf, NewLikeNotifyLogic(ctx, svcCtx)),
+		kq.MustNewQueue(svcCtx.Config.ReplyKqConsumerConf, NewReplyNotifyLogic(ctx, svcCtx)),
+		kq.MustNewQueue(svcCtx.Config.FollowKqConsumerConf, NewFollowNotifyLogic(ctx, svcCtx)),
+		kq.MustNewQueue(svcCtx.Config.MentionKqConsumerConf, NewMentionNotifyLogic(ctx, svcCtx)),
+	}
+}
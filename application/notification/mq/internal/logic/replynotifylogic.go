@@ -0,0 +1,100 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"posta/application/article/rpc/article"
+	"posta/application/notification/mq/internal/model"
+	"posta/application/notification/mq/internal/svc"
+	"posta/application/notification/mq/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReplyNotifyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReplyNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReplyNotifyLogic {
+	return &ReplyNotifyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ReplyNotifyLogic) Consume(ctx context.Context, _, val string) error {
+	var msg *types.CanalReplyMsg
+	err := json.Unmarshal([]byte(val), &msg)
+	if err != nil {
+		logx.Errorf("Consume val: %s error: %v", val, err)
+		return err
+	}
+
+	return l.notifyReply(ctx, msg)
+}
+
+// notifyReply 新评论通知被回复的人：回复评论时通知beReplyUserId，直接评论文章时通知文章作者
+func (l *ReplyNotifyLogic) notifyReply(ctx context.Context, msg *types.CanalReplyMsg) error {
+	// 点赞数、状态等字段的更新不通知
+	if msg.Type != types.CanalTypeInsert {
+		return nil
+	}
+
+	for _, d := range msg.Data {
+		status, _ := strconv.Atoi(d.Status)
+		if status != types.ReplyStatusOk {
+			continue
+		}
+		replyId, _ := strconv.ParseInt(d.ID, 10, 64)
+		targetId, _ := strconv.ParseInt(d.TargetID, 10, 64)
+		replyUserId, _ := strconv.ParseInt(d.ReplyUserID, 10, 64)
+		beReplyUserId, _ := strconv.ParseInt(d.BeReplyUserID, 10, 64)
+		parentId, _ := strconv.ParseInt(d.ParentID, 10, 64)
+
+		receiverId := beReplyUserId
+		if receiverId == 0 {
+			receiverId = l.receiverOf(ctx, targetId, parentId)
+		}
+
+		err := addNotification(ctx, l.svcCtx, &model.Notification{
+			UserId:  receiverId,
+			Type:    types.NotificationTypeReply,
+			BizId:   types.NotificationBizReply,
+			ObjId:   replyId,
+			ActorId: replyUserId,
+		}, false)
+		if err != nil {
+			logx.Errorf("addNotification reply replyId: %d error: %v", replyId, err)
+		}
+	}
+
+	return nil
+}
+
+// receiverOf 没有beReplyUserId时，一级评论通知文章作者，二级评论通知一级评论的作者
+func (l *ReplyNotifyLogic) receiverOf(ctx context.Context, targetId, parentId int64) int64 {
+	if parentId != 0 {
+		parent, err := l.svcCtx.ReplyModel.FindOne(ctx, parentId)
+		if err != nil {
+			logx.Errorf("ReplyModel.FindOne parentId: %d error: %v", parentId, err)
+			return 0
+		}
+		return parent.ReplyUserId
+	}
+
+	detail, err := l.svcCtx.ArticleRPC.ArticleDetail(ctx, &article.ArticleDetailRequest{ArticleId: targetId})
+	if err != nil {
+		logx.Errorf("ArticleRPC.ArticleDetail articleId: %d error: %v", targetId, err)
+		return 0
+	}
+	if detail.Article == nil {
+		return 0
+	}
+
+	return detail.Article.AuthorId
+}
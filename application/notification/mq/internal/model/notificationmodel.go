@@ -0,0 +1,77 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ NotificationModel = (*customNotificationModel)(nil)
+
+// 聚合通知的触发人，按(notification_id, actor_id)去重
+const notificationActorTable = "`notification_actor`"
+
+type (
+	// NotificationModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customNotificationModel.
+	NotificationModel interface {
+		notificationModel
+		FindUnreadByObj(ctx context.Context, userId, notificationType int64, bizId string, objId int64) (*Notification, error)
+		AddActor(ctx context.Context, n *Notification, actorId int64) (bool, error)
+	}
+
+	customNotificationModel struct {
+		*defaultNotificationModel
+	}
+)
+
+// NewNotificationModel returns a model for the database table.
+func NewNotificationModel(conn sqlx.SqlConn) NotificationModel {
+	return &customNotificationModel{
+		defaultNotificationModel: newNotificationModel(conn),
+	}
+}
+
+// FindUnreadByObj 查询同一个对象上还未读的通知，用于聚合点赞通知，走 ix_user_type_obj 索引
+func (m *customNotificationModel) FindUnreadByObj(ctx context.Context, userId, notificationType int64, bizId string, objId int64) (*Notification, error) {
+	var resp Notification
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `type` = ? and `biz_id` = ? and `obj_id` = ? and `is_read` = 0 order by `id` desc limit 1", notificationRows, m.table)
+	err := m.conn.QueryRowCtx(ctx, &resp, query, userId, notificationType, bizId, objId)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// AddActor 聚合通知多了一个触发人，返回是否是新的触发人。同一个人反复点赞取消再点赞只计一次，
+// update_time会自动更新，通知重新排到列表最前面
+func (m *customNotificationModel) AddActor(ctx context.Context, n *Notification, actorId int64) (bool, error) {
+	var added bool
+	err := m.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		// 通知创建时不记录第一个触发人，第二个人触发时补上
+		query := fmt.Sprintf("insert ignore into %s (`notification_id`, `actor_id`) values (?, ?)", notificationActorTable)
+		if _, err := session.ExecCtx(ctx, query, n.Id, n.ActorId); err != nil {
+			return err
+		}
+		ret, err := session.ExecCtx(ctx, query, n.Id, actorId)
+		if err != nil {
+			return err
+		}
+		if affected, err := ret.RowsAffected(); err != nil || affected == 0 {
+			return err
+		}
+
+		query = fmt.Sprintf("update %s set `actor_id` = ?, `actor_count` = `actor_count` + 1 where `id` = ?", m.table)
+		if _, err = session.ExecCtx(ctx, query, actorId, n.Id); err != nil {
+			return err
+		}
+		added = true
+		return nil
+	})
+	return added, err
+}
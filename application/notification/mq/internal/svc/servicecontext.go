@@ -0,0 +1,41 @@
+package svc
+
+import (
+	"posta/application/article/rpc/article"
+	"posta/application/notification/mq/internal/config"
+	"posta/application/notification/mq/internal/model"
+
+	"github.com/zeromicro/go-queue/kq"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type ServiceContext struct {
+	Config            config.Config
+	NotificationModel model.NotificationModel
+	ReplyModel        model.ReplyModel
+	BizRedis          *redis.Redis
+	ArticleRPC        article.Article
+	PushPusherClient  *kq.Pusher
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	rds, err := redis.NewRedis(redis.RedisConf{
+		Host: c.BizRedis.Host,
+		Pass: c.BizRedis.Pass,
+		Type: c.BizRedis.Type,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return &ServiceContext{
+		Config:            c,
+		NotificationModel: model.NewNotificationModel(sqlx.NewMysql(c.DataSource)),
+		ReplyModel:        model.NewReplyModel(sqlx.NewMysql(c.DataSourceReply), c.CacheRedis),
+		BizRedis:          rds,
+		ArticleRPC:        article.NewArticle(zrpc.MustNewClient(c.ArticleRPC)),
+		PushPusherClient:  kq.NewPusher(c.PushKqPusherConf.Brokers, c.PushKqPusherConf.Topic),
+	}
+}
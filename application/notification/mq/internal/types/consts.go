@@ -0,0 +1,33 @@
+package types
+
+// 通知类型，和notification-rpc中保持一致
+const (
+	NotificationTypeLike = iota + 1
+	NotificationTypeReply
+	NotificationTypeFollow
+	NotificationTypeMention
+)
+
+// 点赞业务，和like-rpc中保持一致
+const (
+	BizArticle = iota
+	BizReply
+)
+
+// 通知关联的业务，和mention中的业务保持一致
+const (
+	NotificationBizArticle = "article"
+	NotificationBizReply   = "reply"
+)
+
+const (
+	// LikeActionLike 点赞，取消点赞不发通知
+	LikeActionLike = 0
+	// ReplyStatusOk 正常的评论
+	ReplyStatusOk = 0
+	// FollowStatusFollow 关注
+	FollowStatusFollow = 1
+)
+
+// CanalTypeInsert canal消息的类型，评论只在新增时通知，点赞数等字段的更新不通知
+const CanalTypeInsert = "INSERT"
@@ -0,0 +1,44 @@
+package types
+
+// LikeActionMsg like-rpc发送的点赞消息
+type LikeActionMsg struct {
+	BizId      int64 `json:"bizId,omitempty"`
+	ObjId      int64 `json:"objId,omitempty"`
+	UserId     int64 `json:"userId,omitempty"`
+	LikeAction int32 `json:"likeType,omitempty"`
+	// 只是切换了表态类型，不是新的点赞
+	ReactionSwitch bool `json:"reactionSwitch,omitempty"`
+}
+
+// CanalReplyMsg canal解析reply binlog消息.
+type CanalReplyMsg struct {
+	Type string `json:"type"`
+	Data []struct {
+		ID            string `json:"id"`
+		TargetID      string `json:"target_id"`
+		ReplyUserID   string `json:"reply_user_id"`
+		BeReplyUserID string `json:"be_reply_user_id"`
+		ParentID      string `json:"parent_id"`
+		Status        string `json:"status"`
+	} `json:"data"`
+}
+
+// CanalFollowMsg canal解析follow binlog消息.
+type CanalFollowMsg struct {
+	Data []struct {
+		ID             string `json:"id"`
+		UserId         string `json:"user_id"`
+		FollowedUserID string `json:"followed_user_id"`
+		Status         string `json:"follow_status"`
+	} `json:"data"`
+}
+
+// MentionMsg user-rpc发送的@通知消息
+type MentionMsg struct {
+	MentionId  int64  `json:"mentionId"`
+	BizId      string `json:"bizId"`
+	ObjId      int64  `json:"objId"`
+	FromUserId int64  `json:"fromUserId"`
+	UserId     int64  `json:"userId"`
+	CreateTime int64  `json:"createTime"`
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"posta/application/notification/mq/internal/config"
+	"posta/application/notification/mq/internal/logic"
+	"posta/application/notification/mq/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+var configFile = flag.String("f", "etc/notification.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+
+	logx.DisableStat()
+	svcCtx := svc.NewServiceContext(c)
+	ctx := context.Background()
+	serviceGroup := service.NewServiceGroup()
+	defer serviceGroup.Stop()
+
+	for _, mq := range logic.Consumers(ctx, svcCtx) {
+		serviceGroup.Add(mq)
+	}
+
+	serviceGroup.Start()
+}
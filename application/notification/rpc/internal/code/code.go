@@ -0,0 +1,9 @@
+package code
+
+import "posta/pkg/xcode"
+
+var (
+	UserIdInvalid           = xcode.New(90001, "用户ID无效")
+	NotificationTypeInvalid = xcode.New(90002, "通知类型无效")
+	PageTokenInvalid        = xcode.New(90003, "分页游标无效")
+)
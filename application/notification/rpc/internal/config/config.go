@@ -0,0 +1,14 @@
+package config
+
+import (
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	zrpc.RpcServerConf
+	DataSource string
+	BizRedis   redis.RedisConf
+	// CursorSecret 分页游标的签名密钥
+	CursorSecret string
+}
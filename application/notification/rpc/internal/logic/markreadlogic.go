@@ -0,0 +1,49 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/notification/rpc/internal/code"
+	"posta/application/notification/rpc/internal/svc"
+	"posta/application/notification/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MarkReadLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMarkReadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MarkReadLogic {
+	return &MarkReadLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// MarkRead 标记通知已读，不传notificationIds时把对应类型的通知全部标记为已读
+func (l *MarkReadLogic) MarkRead(in *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if !validNotificationType(in.Type) {
+		return nil, code.NotificationTypeInvalid
+	}
+
+	err := l.svcCtx.NotificationModel.MarkRead(l.ctx, in.UserId, int64(in.Type), in.NotificationIds)
+	if err != nil {
+		l.Logger.Errorf("[MarkRead] NotificationModel.MarkRead error: %v req: %v", err, in)
+		return nil, err
+	}
+
+	// 注意：这里直接删掉未读数缓存，下次查询时回源数据库重新统计，避免按id标记时还要区分每条通知的类型和是否已读
+	_, err = l.svcCtx.BizRedis.DelCtx(l.ctx, unreadCountKey(in.UserId))
+	if err != nil {
+		l.Logger.Errorf("[MarkRead] BizRedis.DelCtx userId: %d error: %v", in.UserId, err)
+	}
+
+	return &pb.MarkReadResponse{}, nil
+}
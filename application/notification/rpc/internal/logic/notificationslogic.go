@@ -0,0 +1,88 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"posta/application/notification/rpc/internal/code"
+	"posta/application/notification/rpc/internal/svc"
+	"posta/application/notification/rpc/internal/types"
+	"posta/application/notification/rpc/pb"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type NotificationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewNotificationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NotificationsLogic {
+	return &NotificationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Notifications 通知列表（按最近更新时间倒序），点赞通知是聚合过的，有新的点赞时会排到前面
+func (l *NotificationsLogic) Notifications(in *pb.NotificationsRequest) (*pb.NotificationsResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if !validNotificationType(in.Type) {
+		return nil, code.NotificationTypeInvalid
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+	scope := fmt.Sprintf("notifications#%d#%d", in.UserId, in.Type)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	// Sort是上一页最后一条通知的更新时间，Id是这条通知的ID
+	if pageCursor.IsZero() {
+		pageCursor.Sort = time.Now().Unix()
+		pageCursor.Id = math.MaxInt64
+	}
+
+	notifications, err := l.svcCtx.NotificationModel.NotificationsByUserId(l.ctx, in.UserId, int64(in.Type),
+		time.Unix(pageCursor.Sort, 0).Format("2006-01-02 15:04:05"), pageCursor.Id, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[Notifications] NotificationModel.NotificationsByUserId error: %v req: %v", err, in)
+		return nil, err
+	}
+
+	ret := &pb.NotificationsResponse{
+		IsEnd: len(notifications) < int(in.PageSize),
+	}
+	for _, n := range notifications {
+		ret.Notifications = append(ret.Notifications, &pb.NotificationItem{
+			Id:         n.Id,
+			Type:       int32(n.Type),
+			BizId:      n.BizId,
+			ObjId:      n.ObjId,
+			ActorId:    n.ActorId,
+			ActorCount: n.ActorCount,
+			IsRead:     n.IsRead == 1,
+			CreateTime: n.CreateTime.Unix(),
+			UpdateTime: n.UpdateTime.Unix(),
+		})
+	}
+	if len(notifications) > 0 {
+		last := notifications[len(notifications)-1]
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: last.UpdateTime.Unix(), Id: last.Id})
+	}
+
+	return ret, nil
+}
+
+func validNotificationType(notificationType int32) bool {
+	return notificationType >= types.NotificationTypeAll && notificationType <= types.NotificationTypeMention
+}
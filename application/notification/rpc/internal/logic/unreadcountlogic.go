@@ -0,0 +1,103 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"posta/application/notification/rpc/internal/code"
+	"posta/application/notification/rpc/internal/svc"
+	"posta/application/notification/rpc/internal/types"
+	"posta/application/notification/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 和notification-mq中的缓存key保持一致，hash的field是通知类型，value是未读数
+const prefixUnreadCount = "biz#notification#unread#%d"
+
+type UnreadCountLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnreadCountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnreadCountLogic {
+	return &UnreadCountLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnreadCount 查询各类型通知的未读数，优先读redis，缓存不存在时回源数据库并回填
+func (l *UnreadCountLogic) UnreadCount(in *pb.UnreadCountRequest) (*pb.UnreadCountResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+
+	key := unreadCountKey(in.UserId)
+	counts := make(map[int32]int64)
+	fields, err := l.svcCtx.BizRedis.HgetallCtx(l.ctx, key)
+	if err != nil {
+		l.Logger.Errorf("[UnreadCount] BizRedis.HgetallCtx key: %s error: %v", key, err)
+	}
+	if len(fields) > 0 {
+		for field, value := range fields {
+			notificationType, _ := strconv.Atoi(field)
+			num, _ := strconv.ParseInt(value, 10, 64)
+			counts[int32(notificationType)] = num
+		}
+	} else {
+		counts, err = l.unreadCountFromDB(in.UserId)
+		if err != nil {
+			return nil, err
+		}
+		l.cacheUnreadCount(key, counts)
+	}
+
+	ret := &pb.UnreadCountResponse{Counts: counts}
+	for _, num := range counts {
+		ret.Total += num
+	}
+
+	return ret, nil
+}
+
+func (l *UnreadCountLogic) unreadCountFromDB(userId int64) (map[int32]int64, error) {
+	rows, err := l.svcCtx.NotificationModel.UnreadCountByType(l.ctx, userId)
+	if err != nil {
+		l.Logger.Errorf("[UnreadCount] NotificationModel.UnreadCountByType userId: %d error: %v", userId, err)
+		return nil, err
+	}
+	// 没有未读的类型也要写0，这样缓存一定存在，notification-mq才能在上面累加
+	counts := make(map[int32]int64)
+	for t := types.NotificationTypeLike; t <= types.NotificationTypeMention; t++ {
+		counts[int32(t)] = 0
+	}
+	for _, row := range rows {
+		counts[int32(row.Type)] = row.Num
+	}
+
+	return counts, nil
+}
+
+func (l *UnreadCountLogic) cacheUnreadCount(key string, counts map[int32]int64) {
+	fields := make(map[string]string, len(counts))
+	for notificationType, num := range counts {
+		fields[strconv.Itoa(int(notificationType))] = strconv.FormatInt(num, 10)
+	}
+	err := l.svcCtx.BizRedis.HmsetCtx(l.ctx, key, fields)
+	if err != nil {
+		l.Logger.Errorf("[UnreadCount] BizRedis.HmsetCtx key: %s error: %v", key, err)
+		return
+	}
+	err = l.svcCtx.BizRedis.ExpireCtx(l.ctx, key, types.UnreadCountExpire)
+	if err != nil {
+		l.Logger.Errorf("[UnreadCount] BizRedis.ExpireCtx key: %s error: %v", key, err)
+	}
+}
+
+func unreadCountKey(userId int64) string {
+	return fmt.Sprintf(prefixUnreadCount, userId)
+}
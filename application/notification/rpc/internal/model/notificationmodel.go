@@ -0,0 +1,93 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ NotificationModel = (*customNotificationModel)(nil)
+
+type (
+	// NotificationModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customNotificationModel.
+	NotificationModel interface {
+		notificationModel
+		NotificationsByUserId(ctx context.Context, userId, notificationType int64, updateTime string, lastId int64, limit int) ([]*Notification, error)
+		MarkRead(ctx context.Context, userId, notificationType int64, ids []int64) error
+		UnreadCountByType(ctx context.Context, userId int64) ([]*UnreadCount, error)
+	}
+
+	customNotificationModel struct {
+		*defaultNotificationModel
+	}
+
+	UnreadCount struct {
+		Type int64 `db:"type"`
+		Num  int64 `db:"num"`
+	}
+)
+
+// NewNotificationModel returns a model for the database table.
+func NewNotificationModel(conn sqlx.SqlConn) NotificationModel {
+	return &customNotificationModel{
+		defaultNotificationModel: newNotificationModel(conn),
+	}
+}
+
+// NotificationsByUserId 按 (update_time, id) 倒序查询用户的通知，notificationType为0时查全部类型，走 ix_user_utime 索引
+func (m *customNotificationModel) NotificationsByUserId(ctx context.Context, userId, notificationType int64, updateTime string, lastId int64, limit int) ([]*Notification, error) {
+	var (
+		notifications []*Notification
+		typeCond      string
+		args          = []any{userId}
+	)
+	if notificationType != 0 {
+		typeCond = " and `type` = ?"
+		args = append(args, notificationType)
+	}
+	args = append(args, updateTime, updateTime, lastId, limit)
+	sql := fmt.Sprintf("select %s from %s where `user_id` = ?%s and (`update_time` < ? or (`update_time` = ? and `id` < ?)) order by `update_time` desc, `id` desc limit ?", notificationRows, m.table, typeCond)
+	err := m.conn.QueryRowsCtx(ctx, &notifications, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// MarkRead 标记已读，ids为空时把notificationType下的全部未读通知标记为已读
+func (m *customNotificationModel) MarkRead(ctx context.Context, userId, notificationType int64, ids []int64) error {
+	var (
+		conds = []string{"`user_id` = ?", "`is_read` = 0"}
+		args  = []any{userId}
+	)
+	if notificationType != 0 {
+		conds = append(conds, "`type` = ?")
+		args = append(args, notificationType)
+	}
+	if len(ids) > 0 {
+		conds = append(conds, fmt.Sprintf("`id` in (%s)", strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")))
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+	// 注意：显式带上update_time，标记已读不应该改变通知在列表中的位置
+	query := fmt.Sprintf("update %s set `is_read` = 1, `update_time` = `update_time` where %s", m.table, strings.Join(conds, " and "))
+	_, err := m.conn.ExecCtx(ctx, query, args...)
+	return err
+}
+
+// UnreadCountByType 按通知类型统计未读数，未读数缓存失效时用来回源
+func (m *customNotificationModel) UnreadCountByType(ctx context.Context, userId int64) ([]*UnreadCount, error) {
+	var counts []*UnreadCount
+	query := fmt.Sprintf("select `type`, count(*) as `num` from %s where `user_id` = ? and `is_read` = 0 group by `type`", m.table)
+	err := m.conn.QueryRowsCtx(ctx, &counts, query, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
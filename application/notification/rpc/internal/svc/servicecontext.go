@@ -0,0 +1,32 @@
+package svc
+
+import (
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"posta/application/notification/rpc/internal/config"
+	"posta/application/notification/rpc/internal/model"
+	"posta/pkg/cursor"
+)
+
+type ServiceContext struct {
+	Config            config.Config
+	NotificationModel model.NotificationModel
+	BizRedis          *redis.Redis
+	CursorCodec       *cursor.Codec
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	rds, _ := redis.NewRedis(redis.RedisConf{
+		Host:     c.BizRedis.Host,
+		Pass:     c.BizRedis.Pass,
+		Type:     c.BizRedis.Type,
+		NonBlock: true,
+	})
+
+	return &ServiceContext{
+		Config:            c,
+		NotificationModel: model.NewNotificationModel(sqlx.NewMysql(c.DataSource)),
+		BizRedis:          rds,
+		CursorCodec:       cursor.NewCodec(c.CursorSecret),
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+// 通知类型，和notification-mq中保持一致
+const (
+	NotificationTypeAll = iota // 查询时表示全部类型
+	NotificationTypeLike
+	NotificationTypeReply
+	NotificationTypeFollow
+	NotificationTypeMention
+)
+
+const (
+	DefaultPageSize = 20
+	// MaxPageSize 每页最多的条数，避免一次查询太多
+	MaxPageSize = 50
+	// 未读数缓存过期时间
+	UnreadCountExpire = 3600 * 24 * 3
+)
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"posta/application/notification/rpc/internal/config"
+	"posta/application/notification/rpc/internal/server"
+	"posta/application/notification/rpc/internal/svc"
+	"posta/application/notification/rpc/pb"
+	"posta/pkg/interceptors"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	zs "github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+var configFile = flag.String("f", "etc/notification.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+	ctx := svc.NewServiceContext(c)
+
+	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
+		pb.RegisterNotificationServer(grpcServer, server.NewNotificationServer(ctx))
+
+		if c.Mode == zs.DevMode || c.Mode == zs.TestMode {
+			reflection.Register(grpcServer)
+		}
+	})
+	defer s.Stop()
+
+	s.AddUnaryInterceptors(interceptors.ServerErrorInterceptor())
+
+	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
+	s.Start()
+}
@@ -0,0 +1,561 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.6.1
+// source: notification.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NotificationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`             // 通知类型 1:点赞 2:评论 3:关注 4:@
+	BizId         string                 `protobuf:"bytes,3,opt,name=bizId,proto3" json:"bizId,omitempty"`            // 通知关联的业务 article:文章 reply:评论，关注通知为空
+	ObjId         int64                  `protobuf:"varint,4,opt,name=objId,proto3" json:"objId,omitempty"`           // 点赞的对象ID、新评论的ID或@所在内容的ID
+	ActorId       int64                  `protobuf:"varint,5,opt,name=actorId,proto3" json:"actorId,omitempty"`       // 最近一次触发通知的用户
+	ActorCount    int64                  `protobuf:"varint,6,opt,name=actorCount,proto3" json:"actorCount,omitempty"` // 聚合的人数，点赞通知展示为"A等N人赞了..."
+	IsRead        bool                   `protobuf:"varint,7,opt,name=isRead,proto3" json:"isRead,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	UpdateTime    int64                  `protobuf:"varint,9,opt,name=updateTime,proto3" json:"updateTime,omitempty"` // 聚合通知最近一次更新的时间，列表按它倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationItem) Reset() {
+	*x = NotificationItem{}
+	mi := &file_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationItem) ProtoMessage() {}
+
+func (x *NotificationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationItem.ProtoReflect.Descriptor instead.
+func (*NotificationItem) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NotificationItem) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *NotificationItem) GetBizId() string {
+	if x != nil {
+		return x.BizId
+	}
+	return ""
+}
+
+func (x *NotificationItem) GetObjId() int64 {
+	if x != nil {
+		return x.ObjId
+	}
+	return 0
+}
+
+func (x *NotificationItem) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *NotificationItem) GetActorCount() int64 {
+	if x != nil {
+		return x.ActorCount
+	}
+	return 0
+}
+
+func (x *NotificationItem) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *NotificationItem) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *NotificationItem) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type NotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"` // 0表示全部类型
+	PageSize      int64                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationsRequest) Reset() {
+	*x = NotificationsRequest{}
+	mi := &file_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsRequest) ProtoMessage() {}
+
+func (x *NotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsRequest.ProtoReflect.Descriptor instead.
+func (*NotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NotificationsRequest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *NotificationsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *NotificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type NotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*NotificationItem    `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	IsEnd         bool                   `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationsResponse) Reset() {
+	*x = NotificationsResponse{}
+	mi := &file_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsResponse) ProtoMessage() {}
+
+func (x *NotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsResponse.ProtoReflect.Descriptor instead.
+func (*NotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NotificationsResponse) GetNotifications() []*NotificationItem {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *NotificationsResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *NotificationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MarkReadRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	NotificationIds []int64                `protobuf:"varint,2,rep,packed,name=notificationIds,proto3" json:"notificationIds,omitempty"` // 为空时按type全部标记已读
+	Type            int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`                              // 0表示全部类型
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkReadRequest) Reset() {
+	*x = MarkReadRequest{}
+	mi := &file_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadRequest) ProtoMessage() {}
+
+func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MarkReadRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MarkReadRequest) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+func (x *MarkReadRequest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+type MarkReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReadResponse) Reset() {
+	*x = MarkReadResponse{}
+	mi := &file_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadResponse) ProtoMessage() {}
+
+func (x *MarkReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkReadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{4}
+}
+
+type UnreadCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreadCountRequest) Reset() {
+	*x = UnreadCountRequest{}
+	mi := &file_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCountRequest) ProtoMessage() {}
+
+func (x *UnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*UnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnreadCountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UnreadCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Counts        map[int32]int64        `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 每种通知类型的未读数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreadCountResponse) Reset() {
+	*x = UnreadCountResponse{}
+	mi := &file_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCountResponse) ProtoMessage() {}
+
+func (x *UnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*UnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnreadCountResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UnreadCountResponse) GetCounts() map[int32]int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+var File_notification_proto protoreflect.FileDescriptor
+
+const file_notification_proto_rawDesc = "" +
+	"\n" +
+	"\x12notification.proto\x12\x02pb\"\xf4\x01\n" +
+	"\x10NotificationItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x14\n" +
+	"\x05bizId\x18\x03 \x01(\tR\x05bizId\x12\x14\n" +
+	"\x05objId\x18\x04 \x01(\x03R\x05objId\x12\x18\n" +
+	"\aactorId\x18\x05 \x01(\x03R\aactorId\x12\x1e\n" +
+	"\n" +
+	"actorCount\x18\x06 \x01(\x03R\n" +
+	"actorCount\x12\x16\n" +
+	"\x06isRead\x18\a \x01(\bR\x06isRead\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\b \x01(\x03R\n" +
+	"createTime\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\t \x01(\x03R\n" +
+	"updateTime\"\xa0\x01\n" +
+	"\x14NotificationsRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x06 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04J\x04\b\x05\x10\x06R\x06cursorR\x0enotificationId\"\xb3\x01\n" +
+	"\x15NotificationsResponse\x12:\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x14.pb.NotificationItemR\rnotifications\x12\x14\n" +
+	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x05 \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x06cursorR\x0enotificationId\"g\n" +
+	"\x0fMarkReadRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12(\n" +
+	"\x0fnotificationIds\x18\x02 \x03(\x03R\x0fnotificationIds\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\x05R\x04type\"\x12\n" +
+	"\x10MarkReadResponse\",\n" +
+	"\x12UnreadCountRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\"\xa3\x01\n" +
+	"\x13UnreadCountResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12;\n" +
+	"\x06counts\x18\x02 \x03(\v2#.pb.UnreadCountResponse.CountsEntryR\x06counts\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x012\xcb\x01\n" +
+	"\fNotification\x12D\n" +
+	"\rNotifications\x12\x18.pb.NotificationsRequest\x1a\x19.pb.NotificationsResponse\x125\n" +
+	"\bMarkRead\x12\x13.pb.MarkReadRequest\x1a\x14.pb.MarkReadResponse\x12>\n" +
+	"\vUnreadCount\x12\x16.pb.UnreadCountRequest\x1a\x17.pb.UnreadCountResponseB\x06Z\x04./pbb\x06proto3"
+
+var (
+	file_notification_proto_rawDescOnce sync.Once
+	file_notification_proto_rawDescData []byte
+)
+
+func file_notification_proto_rawDescGZIP() []byte {
+	file_notification_proto_rawDescOnce.Do(func() {
+		file_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_proto_rawDesc), len(file_notification_proto_rawDesc)))
+	})
+	return file_notification_proto_rawDescData
+}
+
+var file_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_notification_proto_goTypes = []any{
+	(*NotificationItem)(nil),      // 0: pb.NotificationItem
+	(*NotificationsRequest)(nil),  // 1: pb.NotificationsRequest
+	(*NotificationsResponse)(nil), // 2: pb.NotificationsResponse
+	(*MarkReadRequest)(nil),       // 3: pb.MarkReadRequest
+	(*MarkReadResponse)(nil),      // 4: pb.MarkReadResponse
+	(*UnreadCountRequest)(nil),    // 5: pb.UnreadCountRequest
+	(*UnreadCountResponse)(nil),   // 6: pb.UnreadCountResponse
+	nil,                           // 7: pb.UnreadCountResponse.CountsEntry
+}
+var file_notification_proto_depIdxs = []int32{
+	0, // 0: pb.NotificationsResponse.notifications:type_name -> pb.NotificationItem
+	7, // 1: pb.UnreadCountResponse.counts:type_name -> pb.UnreadCountResponse.CountsEntry
+	1, // 2: pb.Notification.Notifications:input_type -> pb.NotificationsRequest
+	3, // 3: pb.Notification.MarkRead:input_type -> pb.MarkReadRequest
+	5, // 4: pb.Notification.UnreadCount:input_type -> pb.UnreadCountRequest
+	2, // 5: pb.Notification.Notifications:output_type -> pb.NotificationsResponse
+	4, // 6: pb.Notification.MarkRead:output_type -> pb.MarkReadResponse
+	6, // 7: pb.Notification.UnreadCount:output_type -> pb.UnreadCountResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_notification_proto_init() }
+func file_notification_proto_init() {
+	if File_notification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_proto_rawDesc), len(file_notification_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_proto_goTypes,
+		DependencyIndexes: file_notification_proto_depIdxs,
+		MessageInfos:      file_notification_proto_msgTypes,
+	}.Build()
+	File_notification_proto = out.File
+	file_notification_proto_goTypes = nil
+	file_notification_proto_depIdxs = nil
+}
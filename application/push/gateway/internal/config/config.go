@@ -0,0 +1,21 @@
+package config
+
+import (
+	"github.com/zeromicro/go-queue/kq"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/rest"
+)
+
+type Config struct {
+	rest.RestConf
+	Auth struct {
+		AccessSecret string
+		AccessExpire int64
+	}
+	PushKqConsumerConf kq.KqConf
+	BizRedis           redis.RedisConf
+	// 心跳间隔（秒），要小于负载均衡的空闲连接超时
+	HeartbeatInterval int `json:",default=25"`
+	// 每个用户最多保留多少条事件用于断线重连后补发
+	MaxReplayEvents int `json:",default=100"`
+}
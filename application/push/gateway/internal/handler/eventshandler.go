@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"posta/application/push/gateway/internal/logic"
+	"posta/application/push/gateway/internal/svc"
+	"posta/application/push/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logc"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func EventsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.EventsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+		// 标准的EventSource重连时会把最后收到的事件ID放在Last-Event-ID请求头中
+		if id, err := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 64); err == nil && id > req.LastEventId {
+			req.LastEventId = id
+		}
+
+		client := make(chan *types.Event, types.ConnBufferSize)
+		l := logic.NewEventsLogic(r.Context(), svcCtx)
+		threading.GoSafeCtx(r.Context(), func() {
+			defer close(client)
+			if err := l.Events(&req, client); err != nil {
+				logc.Errorw(r.Context(), "EventsHandler", logc.Field("error", err))
+			}
+		})
+
+		rc := http.NewResponseController(w)
+		// 告诉客户端断线后多久重连
+		fmt.Fprintf(w, "retry: %d\n\n", types.ReconnectRetry)
+		if err := rc.Flush(); err != nil {
+			return
+		}
+
+		ticker := time.NewTicker(time.Duration(svcCtx.Config.HeartbeatInterval) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case ev, ok := <-client:
+				if !ok {
+					return
+				}
+				if err := writeEvent(w, ev); err != nil {
+					return
+				}
+			case <-ticker.C:
+				// 注意：以冒号开头的是注释行，客户端不会触发事件，只用来保持连接不被中间的代理断开
+				if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+					return
+				}
+			case <-r.Context().Done():
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func writeEvent(w io.Writer, ev *types.Event) error {
+	var data bytes.Buffer
+	if len(ev.Data) == 0 {
+		data.WriteString("{}")
+	} else if err := json.Compact(&data, ev.Data); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	// resync等控制事件没有ID，不会改变客户端记录的Last-Event-ID
+	if ev.Id > 0 {
+		fmt.Fprintf(&buf, "id: %d\n", ev.Id)
+	}
+	fmt.Fprintf(&buf, "event: %s\ndata: %s\n\n", ev.Type, data.Bytes())
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,79 @@
+package hub
+
+import (
+	"sync"
+
+	"posta/application/push/gateway/internal/types"
+)
+
+// Conn 一个用户的一条SSE连接，同一个用户可以在多个设备上同时在线
+type Conn struct {
+	UserId int64
+	Events chan *types.Event
+	done   chan struct{}
+	once   sync.Once
+}
+
+// Done 连接被踢掉时关闭，比如客户端消费太慢导致缓冲写满
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *Conn) close() {
+	c.once.Do(func() {
+		close(c.done)
+	})
+}
+
+// Hub 管理当前网关实例上的所有在线连接
+type Hub struct {
+	mu    sync.RWMutex
+	conns map[int64]map[*Conn]struct{}
+}
+
+func New() *Hub {
+	return &Hub{
+		conns: make(map[int64]map[*Conn]struct{}),
+	}
+}
+
+func (h *Hub) Register(userId int64) *Conn {
+	c := &Conn{
+		UserId: userId,
+		Events: make(chan *types.Event, types.ConnBufferSize),
+		done:   make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.conns[userId] == nil {
+		h.conns[userId] = make(map[*Conn]struct{})
+	}
+	h.conns[userId][c] = struct{}{}
+
+	return c
+}
+
+func (h *Hub) Unregister(c *Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.conns[c.UserId], c)
+	if len(h.conns[c.UserId]) == 0 {
+		delete(h.conns, c.UserId)
+	}
+	c.close()
+}
+
+// Dispatch 把事件投递给该用户在本实例上的所有连接，用户不在本实例上时直接丢弃
+func (h *Hub) Dispatch(ev *types.Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for c := range h.conns[ev.UserId] {
+		select {
+		case c.Events <- ev:
+		default:
+			// 注意：不能阻塞广播，缓冲满了就断开连接，客户端带着Last-Event-ID重连后从redis补发
+			c.close()
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"posta/application/push/gateway/internal/svc"
+	"posta/application/push/gateway/internal/types"
+	"posta/pkg/push"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EventsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewEventsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EventsLogic {
+	return &EventsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Events 保持一个用户的推送连接，先补发Last-Event-ID之后的事件，再持续推送新事件，直到连接断开
+func (l *EventsLogic) Events(req *types.EventsRequest, client chan<- *types.Event) error {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		return err
+	}
+
+	// 注意：先注册再补发，否则补发和注册之间产生的事件会丢失；重复的事件按ID去重
+	conn := l.svcCtx.Hub.Register(userId)
+	defer l.svcCtx.Hub.Unregister(conn)
+
+	lastSent := req.LastEventId
+	if req.LastEventId > 0 {
+		events, err := l.replay(userId, req.LastEventId)
+		if err != nil {
+			l.Logger.Errorf("replay userId: %d lastEventId: %d error: %v", userId, req.LastEventId, err)
+		}
+		for _, ev := range events {
+			if !l.send(client, ev) {
+				return nil
+			}
+			lastSent = max(lastSent, ev.Id)
+		}
+	}
+
+	for {
+		select {
+		case <-l.ctx.Done():
+			return nil
+		case <-conn.Done():
+			return nil
+		case ev := <-conn.Events:
+			if ev.Id <= lastSent {
+				continue
+			}
+			if !l.send(client, ev) {
+				return nil
+			}
+			lastSent = ev.Id
+		}
+	}
+}
+
+func (l *EventsLogic) send(client chan<- *types.Event, ev *types.Event) bool {
+	select {
+	case client <- ev:
+		return true
+	case <-l.ctx.Done():
+		return false
+	}
+}
+
+// replay 从redis中取出lastEventId之后的事件，缓存已经被裁剪或者过期时通知客户端重新拉取
+func (l *EventsLogic) replay(userId, lastEventId int64) ([]*types.Event, error) {
+	key := userEventsKey(userId)
+	oldest, err := l.svcCtx.BizRedis.ZrangeWithScoresCtx(l.ctx, key, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(oldest) == 0 {
+		return []*types.Event{resyncEvent(userId)}, nil
+	}
+	// 最早保留的事件比lastEventId还新，说明之间的事件已经被裁剪或者过期，
+	// 事件ID是全局递增的，无法判断中间是否真的有这个用户的事件，只能让客户端重新拉取
+	if oldest[0].Score > lastEventId {
+		return []*types.Event{resyncEvent(userId)}, nil
+	}
+
+	pairs, err := l.svcCtx.BizRedis.ZrangebyscoreWithScoresCtx(l.ctx, key, lastEventId+1, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*types.Event, 0, len(pairs))
+	for _, pair := range pairs {
+		var ev types.Event
+		if err := json.Unmarshal([]byte(pair.Key), &ev); err != nil {
+			l.Logger.Errorf("replay unmarshal event: %s error: %v", pair.Key, err)
+			continue
+		}
+		events = append(events, &ev)
+	}
+
+	return events, nil
+}
+
+func resyncEvent(userId int64) *types.Event {
+	return &types.Event{UserId: userId, Type: push.EventResync}
+}
+
+func userEventsKey(userId int64) string {
+	return fmt.Sprintf(types.PrefixUserEvents, userId)
+}
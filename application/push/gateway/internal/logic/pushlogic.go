@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"posta/application/push/gateway/internal/svc"
+	"posta/application/push/gateway/internal/types"
+	"posta/pkg/push"
+
+	"github.com/zeromicro/go-queue/kq"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+// 保存事件用于补发，只保留最近的ARGV[3]条
+const saveEventScript = `
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+redis.call("ZREMRANGEBYRANK", KEYS[1], 0, -(tonumber(ARGV[3]) + 1))
+redis.call("EXPIRE", KEYS[1], ARGV[4])
+return 1`
+
+type PushLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPushLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PushLogic {
+	return &PushLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Consume 给业务服务发来的消息分配事件ID，保存到用户的事件缓存中，再广播给所有网关实例
+func (l *PushLogic) Consume(ctx context.Context, _, val string) error {
+	var msg *push.Msg
+	err := json.Unmarshal([]byte(val), &msg)
+	if err != nil {
+		logx.Errorf("Consume val: %s error: %v", val, err)
+		return err
+	}
+	if msg.UserId <= 0 || len(msg.Type) == 0 {
+		return nil
+	}
+
+	// 注意：事件ID全局递增而不是按用户递增，这样用户事件缓存过期后重新计数也不会和客户端的Last-Event-ID冲突
+	id, err := l.svcCtx.BizRedis.IncrCtx(ctx, types.EventSeqKey)
+	if err != nil {
+		logx.Errorf("IncrCtx key: %s error: %v", types.EventSeqKey, err)
+		return err
+	}
+	data, err := json.Marshal(&types.Event{
+		Id:     id,
+		UserId: msg.UserId,
+		Type:   msg.Type,
+		Data:   msg.Data,
+	})
+	if err != nil {
+		logx.Errorf("Marshal event msg: %+v error: %v", msg, err)
+		return err
+	}
+
+	_, err = l.svcCtx.BizRedis.EvalCtx(ctx, saveEventScript, []string{userEventsKey(msg.UserId)},
+		id, string(data), l.svcCtx.Config.MaxReplayEvents, types.UserEventsExpire)
+	if err != nil {
+		// 保存失败只影响断线补发，在线推送照常进行
+		logx.Errorf("saveEventScript userId: %d error: %v", msg.UserId, err)
+	}
+
+	_, err = l.svcCtx.BizRedis.PublishCtx(ctx, types.PushChannel, string(data))
+	if err != nil {
+		logx.Errorf("PublishCtx channel: %s error: %v", types.PushChannel, err)
+		return err
+	}
+
+	return nil
+}
+
+func Consumers(ctx context.Context, svcCtx *svc.ServiceContext) []service.Service {
+	return []service.Service{
+		kq.MustNewQueue(svcCtx.Config.PushKqConsumerConf, NewPushLogic(ctx, svcCtx)),
+		NewSubscribeLogic(ctx, svcCtx),
+	}
+}
@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"posta/application/push/gateway/internal/svc"
+	"posta/application/push/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// SubscribeLogic 订阅广播频道，把事件投递给本实例上的在线连接。每个网关实例都会收到全部事件，实例之间不需要感知用户连在哪台机器上
+type SubscribeLogic struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSubscribeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubscribeLogic {
+	ctx, cancel := context.WithCancel(ctx)
+	return &SubscribeLogic{
+		ctx:    ctx,
+		cancel: cancel,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *SubscribeLogic) Start() {
+	pubsub := l.svcCtx.PubSubRedis.Subscribe(l.ctx, types.PushChannel)
+	defer pubsub.Close()
+
+	// 注意：go-redis在连接断开时会自动重新订阅，断开期间的事件由客户端重连时补发
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-l.ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var ev types.Event
+			if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+				l.Logger.Errorf("Subscribe unmarshal payload: %s error: %v", msg.Payload, err)
+				continue
+			}
+			l.svcCtx.Hub.Dispatch(&ev)
+		}
+	}
+}
+
+func (l *SubscribeLogic) Stop() {
+	l.cancel()
+}
@@ -0,0 +1,29 @@
+package svc
+
+import (
+	"posta/application/push/gateway/internal/config"
+	"posta/application/push/gateway/internal/hub"
+
+	red "github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+type ServiceContext struct {
+	Config   config.Config
+	BizRedis *redis.Redis
+	// 注意：go-zero的redis没有封装Subscribe，订阅广播频道需要直接用go-redis
+	PubSubRedis *red.Client
+	Hub         *hub.Hub
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	return &ServiceContext{
+		Config:   c,
+		BizRedis: redis.MustNewRedis(c.BizRedis),
+		PubSubRedis: red.NewClient(&red.Options{
+			Addr:     c.BizRedis.Host,
+			Password: c.BizRedis.Pass,
+		}),
+		Hub: hub.New(),
+	}
+}
@@ -0,0 +1,21 @@
+package types
+
+const (
+	UserIdKey = "userId"
+)
+
+const (
+	// PushChannel 网关实例之间广播事件的redis频道
+	PushChannel = "biz#push#channel"
+	// 全局递增的事件ID
+	EventSeqKey = "biz#push#seq"
+	// 每个用户最近的事件，score是事件ID，用于断线重连后补发
+	PrefixUserEvents = "biz#push#events#%d"
+	// 用户事件缓存过期时间
+	UserEventsExpire = 3600 * 24
+
+	// 每个连接的事件缓冲，写满说明客户端太慢，直接断开让客户端重连补发
+	ConnBufferSize = 64
+	// 客户端断线后的重连间隔（毫秒）
+	ReconnectRetry = 3000
+)
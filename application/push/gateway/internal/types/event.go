@@ -0,0 +1,11 @@
+package types
+
+import "encoding/json"
+
+// Event 推送给客户端的事件，Id全局递增，客户端重连时通过Last-Event-ID补发断线期间的事件
+type Event struct {
+	Id     int64           `json:"id"`
+	UserId int64           `json:"userId"`
+	Type   string          `json:"type"`
+	Data   json.RawMessage `json:"data,omitempty"`
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"posta/application/push/gateway/internal/config"
+	"posta/application/push/gateway/internal/handler"
+	"posta/application/push/gateway/internal/logic"
+	"posta/application/push/gateway/internal/svc"
+	"posta/pkg/active"
+	"posta/pkg/xcode"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+var configFile = flag.String("f", "etc/push.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+
+	server := rest.MustNewServer(c.RestConf)
+	ctx := svc.NewServiceContext(c)
+	// 建立推送连接说明用户打开了应用，记录活跃时间
+	server.Use(active.Middleware(ctx.BizRedis, active.TouchInterval))
+	handler.RegisterHandlers(server, ctx)
+
+	httpx.SetErrorHandler(xcode.ErrHandler)
+
+	// SSE服务、kafka消费者和广播订阅在同一个进程中
+	serviceGroup := service.NewServiceGroup()
+	defer serviceGroup.Stop()
+	serviceGroup.Add(server)
+	for _, mq := range logic.Consumers(context.Background(), ctx) {
+		serviceGroup.Add(mq)
+	}
+
+	fmt.Printf("Starting server at %s:%d...\n", c.Host, c.Port)
+	serviceGroup.Start()
+}
@@ -0,0 +1,29 @@
+package config
+
+import (
+	"github.com/zeromicro/go-queue/kq"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	service.ServiceConf
+	FollowKqConsumerConf kq.KqConf
+	DataSourceFollow     string
+	DataSourceLike       string
+	DataSourceArticle    string
+	CacheRedis           cache.CacheConf
+	BizRedis             redis.RedisConf
+	FollowRPC            zrpc.RpcClientConf
+	// 关注推荐的打分权重，最终得分是各来源得分的加权和
+	UserRecommend struct {
+		SecondDegreeWeight float64 `json:",default=3"` // 每有一个我关注的人关注了他
+		LikedAuthorWeight  float64 `json:",default=2"` // 每赞过他一篇文章
+		PopularWeight      float64 `json:",default=1"` // 乘以log10(粉丝数+1)
+		PopularCount       int     `json:",default=100"`
+		MaxCount           int     `json:",default=200"`   // 每个用户最多保留的推荐数
+		RebuildInterval    int     `json:",default=86400"` // 离线全量重建的间隔，单位秒
+	}
+}
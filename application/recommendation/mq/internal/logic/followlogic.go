@@ -0,0 +1,152 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"posta/application/recommendation/mq/internal/svc"
+	"posta/application/recommendation/mq/internal/types"
+
+	"github.com/zeromicro/go-queue/kq"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+// FollowLogic 根据关注事件增量更新关注推荐。用户fan关注followed后：
+// 1. fan的推荐里去掉followed，加上followed关注的人；
+// 2. fan的粉丝的推荐里，followed的共同关注数加1。
+// 取消关注时反过来。推荐还没算过的用户在关注时直接全量计算，新用户关注第一个人后就能有推荐
+type FollowLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFollowLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FollowLogic {
+	return &FollowLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *FollowLogic) Consume(ctx context.Context, _, val string) error {
+	var msg *types.CanalFollowMsg
+	err := json.Unmarshal([]byte(val), &msg)
+	if err != nil {
+		logx.Errorf("Consume val: %s error: %v", val, err)
+		return err
+	}
+
+	for _, d := range msg.Data {
+		fanId, _ := strconv.ParseInt(d.UserId, 10, 64)
+		followedUserId, _ := strconv.ParseInt(d.FollowedUserID, 10, 64)
+		status, _ := strconv.Atoi(d.Status)
+		if fanId == 0 || followedUserId == 0 {
+			continue
+		}
+		if err = l.refreshFan(ctx, fanId, followedUserId, status); err != nil {
+			l.Logger.Errorf("[Follow] refreshFan fanId: %d followedUserId: %d error: %v", fanId, followedUserId, err)
+		}
+		if err = l.refreshFansOfFan(ctx, fanId, followedUserId, status); err != nil {
+			l.Logger.Errorf("[Follow] refreshFansOfFan fanId: %d followedUserId: %d error: %v", fanId, followedUserId, err)
+		}
+	}
+
+	return nil
+}
+
+func (l *FollowLogic) refreshFan(ctx context.Context, fanId, followedUserId int64, status int) error {
+	conf := l.svcCtx.Config.UserRecommend
+	followedIds, err := l.svcCtx.FollowModel.FollowedIds(ctx, fanId, types.MaxFollowedCount)
+	if err != nil {
+		return err
+	}
+	ids, err := l.svcCtx.FollowModel.FollowedIds(ctx, followedUserId, types.MaxCandidateCount)
+	if err != nil {
+		return err
+	}
+	ids = slices.DeleteFunc(ids, func(id int64) bool {
+		return id == fanId || slices.Contains(followedIds, id)
+	})
+
+	if status == types.FollowStatusFollow {
+		// 增量更新也要和全量计算一样去掉有拉黑关系的人
+		if ids, err = filterBlocked(ctx, l.svcCtx, fanId, ids); err != nil {
+			return err
+		}
+		ok, err := incrUserRecommend(ctx, l.svcCtx, fanId, conf.SecondDegreeWeight, 1, followedUserId, ids)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return buildUserRecommend(ctx, l.svcCtx, fanId, l.popular(ctx))
+		}
+		return nil
+	}
+	_, err = incrUserRecommend(ctx, l.svcCtx, fanId, -conf.SecondDegreeWeight, -1, 0, ids)
+	return err
+}
+
+// refreshFansOfFan 粉丝太多的用户只更新最近的MaxFanout个粉丝，其余的等离线重建
+func (l *FollowLogic) refreshFansOfFan(ctx context.Context, fanId, followedUserId int64, status int) error {
+	conf := l.svcCtx.Config.UserRecommend
+	fanIds, err := l.svcCtx.FollowModel.FanIds(ctx, fanId, types.MaxFanout)
+	if err != nil {
+		return err
+	}
+	// 已经关注了followed的粉丝不需要推荐
+	following, err := l.svcCtx.FollowModel.FollowersIn(ctx, fanIds, followedUserId)
+	if err != nil {
+		return err
+	}
+
+	fanIds = slices.DeleteFunc(fanIds, func(id int64) bool {
+		return id == followedUserId || slices.Contains(following, id)
+	})
+
+	score, mutual := conf.SecondDegreeWeight, int64(1)
+	if status == types.FollowStatusFollow {
+		// 拉黑是双向的，站在followed的角度过滤，和followed之间有拉黑关系的粉丝不推荐followed
+		if fanIds, err = filterBlocked(ctx, l.svcCtx, followedUserId, fanIds); err != nil {
+			return err
+		}
+	} else {
+		score, mutual = -score, -mutual
+	}
+	for _, id := range fanIds {
+		if _, err = incrUserRecommend(ctx, l.svcCtx, id, score, mutual, 0, []int64{followedUserId}); err != nil {
+			l.Logger.Errorf("[Follow] incrUserRecommend userId: %d error: %v", id, err)
+		}
+	}
+
+	return nil
+}
+
+// popular 从redis读取离线计算好的热门作者
+func (l *FollowLogic) popular(ctx context.Context) []popularAuthor {
+	pairs, err := l.svcCtx.BizRedis.ZrevrangeWithScoresByFloatCtx(ctx, userRecommendPopularKey, 0, int64(l.svcCtx.Config.UserRecommend.PopularCount)-1)
+	if err != nil {
+		l.Logger.Errorf("[Follow] ZrevrangeWithScoresByFloatCtx key: %s error: %v", userRecommendPopularKey, err)
+		return nil
+	}
+	popular := make([]popularAuthor, 0, len(pairs))
+	for _, pair := range pairs {
+		userId, err := strconv.ParseInt(pair.Key, 10, 64)
+		if err != nil {
+			continue
+		}
+		popular = append(popular, popularAuthor{userId: userId, score: pair.Score})
+	}
+
+	return popular
+}
+
+func Consumers(ctx context.Context, svcCtx *svc.ServiceContext) []service.Service {
+	return []service.Service{
+		kq.MustNewQueue(svcCtx.Config.FollowKqConsumerConf, NewFollowLogic(ctx, svcCtx)),
+		NewRebuildLogic(ctx, svcCtx),
+	}
+}
@@ -0,0 +1,123 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"posta/application/recommendation/mq/internal/model"
+	"posta/application/recommendation/mq/internal/svc"
+	"posta/application/recommendation/mq/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/mr"
+)
+
+// RebuildLogic 离线全量重建关注推荐，启动时执行一次，之后按RebuildInterval定时执行。
+// 两次重建之间靠关注事件增量更新
+type RebuildLogic struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRebuildLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RebuildLogic {
+	ctx, cancel := context.WithCancel(ctx)
+	return &RebuildLogic{
+		ctx:    ctx,
+		cancel: cancel,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *RebuildLogic) Start() {
+	ticker := time.NewTicker(time.Duration(l.svcCtx.Config.UserRecommend.RebuildInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		l.rebuild()
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (l *RebuildLogic) Stop() {
+	l.cancel()
+}
+
+func (l *RebuildLogic) rebuild() {
+	start := time.Now()
+	popular, err := rebuildPopular(l.ctx, l.svcCtx)
+	if err != nil {
+		l.Logger.Errorf("[Rebuild] rebuildPopular error: %v", err)
+		return
+	}
+
+	var cursorId, total int64
+	for {
+		select {
+		case <-l.ctx.Done():
+			return
+		default:
+		}
+		users, err := l.svcCtx.FollowCountModel.FindAfterId(l.ctx, cursorId, types.RebuildBatchSize)
+		if err != nil {
+			l.Logger.Errorf("[Rebuild] FollowCountModel.FindAfterId cursorId: %d error: %v", cursorId, err)
+			return
+		}
+		if len(users) == 0 {
+			break
+		}
+		cursorId = users[len(users)-1].Id
+
+		mr.ForEach(func(source chan<- *model.FollowCount) {
+			for _, user := range users {
+				source <- user
+			}
+		}, func(user *model.FollowCount) {
+			if err := buildUserRecommend(l.ctx, l.svcCtx, user.UserId, popular); err != nil {
+				l.Logger.Errorf("[Rebuild] buildUserRecommend error: %v", err)
+			}
+		}, mr.WithWorkers(8))
+		total += int64(len(users))
+	}
+
+	l.Logger.Infof("[Rebuild] rebuilt %d users in %s", total, time.Since(start))
+}
+
+// rebuildPopular 重建热门作者，返回给每个用户的推荐共用
+func rebuildPopular(ctx context.Context, svcCtx *svc.ServiceContext) ([]popularAuthor, error) {
+	conf := svcCtx.Config.UserRecommend
+	fcs, err := svcCtx.FollowCountModel.TopByFansCount(ctx, conf.PopularCount)
+	if err != nil {
+		return nil, err
+	}
+	if len(fcs) == 0 {
+		return nil, nil
+	}
+	popular := make([]popularAuthor, 0, len(fcs))
+	for _, fc := range fcs {
+		popular = append(popular, popularAuthor{
+			userId: fc.UserId,
+			score:  popularScore(conf.PopularWeight, fc.FansCount),
+		})
+	}
+
+	_, err = svcCtx.BizRedis.DelCtx(ctx, userRecommendPopularKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range popular {
+		_, err = svcCtx.BizRedis.ZaddFloatCtx(ctx, userRecommendPopularKey, p.score, strconv.FormatInt(p.userId, 10))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return popular, nil
+}
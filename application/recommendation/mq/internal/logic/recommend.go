@@ -0,0 +1,257 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+
+	"posta/application/follow/rpc/follow"
+	"posta/application/recommendation/mq/internal/svc"
+	"posta/application/recommendation/mq/internal/types"
+)
+
+const (
+	prefixUserRecommend       = "biz#recommend#user#%d"        // zset，推荐的用户和得分
+	prefixUserRecommendReason = "biz#recommend#user#reason#%d" // hash，推荐理由
+	prefixUserRecommendMutual = "biz#recommend#user#mutual#%d" // hash，我关注的人中有几个关注了他
+	userRecommendPopularKey   = "biz#recommend#user#popular"   // zset，热门作者，没有个人推荐时兜底
+	userRecommendExpire       = 3600 * 24 * 7
+	// 没有任何推荐时写入的占位成员，区分"算过但为空"和"还没算过"
+	userRecommendPlaceholder = "0"
+)
+
+// 整体替换一个用户的推荐，ARGV[1]是过期时间，之后每4个一组：推荐的用户、得分、理由、共同关注数
+const replaceRecommendScript = `
+redis.call("DEL", KEYS[1], KEYS[2], KEYS[3])
+redis.call("ZADD", KEYS[1], 0, "0")
+for i = 2, #ARGV, 4 do
+	redis.call("ZADD", KEYS[1], ARGV[i+1], ARGV[i])
+	redis.call("HSET", KEYS[2], ARGV[i], ARGV[i+2])
+	if tonumber(ARGV[i+3]) > 0 then
+		redis.call("HSET", KEYS[3], ARGV[i], ARGV[i+3])
+	end
+end
+for i = 1, 3 do
+	redis.call("EXPIRE", KEYS[i], ARGV[1])
+end
+return 1
+`
+
+// 关注事件的增量更新，只在推荐已存在时更新。
+// ARGV[1]得分增量，ARGV[2]共同关注数增量，ARGV[3]最多保留数，ARGV[4]要移除的用户（可以为空），之后是要更新的用户。
+// 共同关注数减到0时说明二度关系没了，直接移除，其它来源的得分等下次重建时再补回来。
+// 减少时只处理已有共同关注数的用户，否则会把只来自点赞、热门的推荐误删，或者写入负数
+const incrRecommendScript = `
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+if ARGV[4] ~= "" then
+	redis.call("ZREM", KEYS[1], ARGV[4])
+	redis.call("HDEL", KEYS[2], ARGV[4])
+	redis.call("HDEL", KEYS[3], ARGV[4])
+end
+local delta = tonumber(ARGV[2])
+for i = 5, #ARGV do
+	if delta > 0 or redis.call("HEXISTS", KEYS[3], ARGV[i]) == 1 then
+		local mutual = redis.call("HINCRBY", KEYS[3], ARGV[i], delta)
+		if mutual <= 0 then
+			redis.call("ZREM", KEYS[1], ARGV[i])
+			redis.call("HDEL", KEYS[2], ARGV[i])
+			redis.call("HDEL", KEYS[3], ARGV[i])
+		else
+			redis.call("ZINCRBY", KEYS[1], ARGV[1], ARGV[i])
+			redis.call("HSET", KEYS[2], ARGV[i], 1)
+		end
+	end
+end
+local overflow = redis.call("ZCARD", KEYS[1]) - tonumber(ARGV[3]) - 1
+if overflow > 0 then
+	local removed = redis.call("ZRANGE", KEYS[1], 0, overflow - 1)
+	for _, member in ipairs(removed) do
+		if member ~= "0" then
+			redis.call("ZREM", KEYS[1], member)
+			redis.call("HDEL", KEYS[2], member)
+			redis.call("HDEL", KEYS[3], member)
+		end
+	end
+end
+return 1
+`
+
+type popularAuthor struct {
+	userId int64
+	score  float64
+}
+
+type candidate struct {
+	userId int64
+	score  float64
+	reason int
+	mutual int64
+}
+
+// buildUserRecommend 计算一个用户的关注推荐并整体写入redis。
+// 候选来自三部分：我关注的人还关注了谁、我赞过的文章的作者、热门作者，去掉已关注的和有拉黑关系的
+func buildUserRecommend(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, popular []popularAuthor) error {
+	conf := svcCtx.Config.UserRecommend
+	followedIds, err := svcCtx.FollowModel.FollowedIds(ctx, userId, types.MaxFollowedCount)
+	if err != nil {
+		return fmt.Errorf("FollowedIds userId: %d error: %w", userId, err)
+	}
+	exclude := make(map[int64]struct{}, len(followedIds)+1)
+	exclude[userId] = struct{}{}
+	for _, id := range followedIds {
+		exclude[id] = struct{}{}
+	}
+
+	candidates := make(map[int64]*candidate)
+	add := func(id int64, score float64, reason int, mutual int64) {
+		if _, ok := exclude[id]; ok {
+			return
+		}
+		c, ok := candidates[id]
+		if !ok {
+			c = &candidate{userId: id, reason: reason}
+			candidates[id] = c
+		}
+		c.score += score
+		c.mutual += mutual
+		if reason < c.reason {
+			c.reason = reason
+		}
+	}
+
+	secondDegree, err := svcCtx.FollowModel.SecondDegree(ctx, userId, followedIds, types.MaxCandidateCount)
+	if err != nil {
+		return fmt.Errorf("SecondDegree userId: %d error: %w", userId, err)
+	}
+	for _, uc := range secondDegree {
+		add(uc.UserId, conf.SecondDegreeWeight*float64(uc.Cnt), types.ReasonSecondDegree, uc.Cnt)
+	}
+
+	likedIds, err := svcCtx.LikeRecordModel.RecentObjIds(ctx, userId, types.BizArticle, types.MaxLikedCount)
+	if err != nil {
+		return fmt.Errorf("RecentObjIds userId: %d error: %w", userId, err)
+	}
+	likedAuthors, err := svcCtx.ArticleModel.AuthorCountsByIds(ctx, likedIds)
+	if err != nil {
+		return fmt.Errorf("AuthorCountsByIds userId: %d error: %w", userId, err)
+	}
+	for _, uc := range likedAuthors {
+		add(uc.UserId, conf.LikedAuthorWeight*float64(uc.Cnt), types.ReasonLikedAuthor, 0)
+	}
+
+	for _, p := range popular {
+		add(p.userId, p.score, types.ReasonPopular, 0)
+	}
+
+	if err = removeBlocked(ctx, svcCtx, userId, candidates); err != nil {
+		return err
+	}
+
+	ranked := make([]*candidate, 0, len(candidates))
+	for _, c := range candidates {
+		ranked = append(ranked, c)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score == ranked[j].score {
+			return ranked[i].userId > ranked[j].userId
+		}
+		return ranked[i].score > ranked[j].score
+	})
+	if len(ranked) > conf.MaxCount {
+		ranked = ranked[:conf.MaxCount]
+	}
+
+	args := make([]any, 0, len(ranked)*4+1)
+	args = append(args, userRecommendExpire)
+	for _, c := range ranked {
+		args = append(args, strconv.FormatInt(c.userId, 10), strconv.FormatFloat(c.score, 'f', 4, 64), c.reason, c.mutual)
+	}
+	_, err = svcCtx.BizRedis.EvalCtx(ctx, replaceRecommendScript, userRecommendKeys(userId), args...)
+	if err != nil {
+		return fmt.Errorf("replaceRecommendScript userId: %d error: %w", userId, err)
+	}
+
+	return nil
+}
+
+// removeBlocked 去掉我拉黑的和拉黑了我的人，屏蔽的人本来就是已关注的，不用单独处理
+func removeBlocked(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, candidates map[int64]*candidate) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+	blocked, err := svcCtx.FollowRPC.BlockedIds(ctx, &follow.BlockedIdsRequest{UserId: userId})
+	if err != nil {
+		return fmt.Errorf("FollowRPC.BlockedIds userId: %d error: %w", userId, err)
+	}
+	for _, id := range blocked.BlockedIds {
+		delete(candidates, id)
+	}
+
+	ownerIds := make([]int64, 0, len(candidates))
+	for id := range candidates {
+		ownerIds = append(ownerIds, id)
+	}
+	checked, err := svcCtx.FollowRPC.CheckBlocked(ctx, &follow.CheckBlockedRequest{UserId: userId, OwnerIds: ownerIds})
+	if err != nil {
+		return fmt.Errorf("FollowRPC.CheckBlocked userId: %d error: %w", userId, err)
+	}
+	for _, id := range checked.BlockerIds {
+		delete(candidates, id)
+	}
+
+	return nil
+}
+
+// filterBlocked 从ids中去掉和userId之间有拉黑关系的用户
+func filterBlocked(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, ids []int64) ([]int64, error) {
+	candidates := make(map[int64]*candidate, len(ids))
+	for _, id := range ids {
+		candidates[id] = nil
+	}
+	if err := removeBlocked(ctx, svcCtx, userId, candidates); err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(ids, func(id int64) bool {
+		_, ok := candidates[id]
+		return !ok
+	}), nil
+}
+
+// incrUserRecommend 在推荐已存在时增量更新，推荐不存在时返回false
+func incrUserRecommend(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, score float64, mutual int64, removeId int64, ids []int64) (bool, error) {
+	remove := ""
+	if removeId > 0 {
+		remove = strconv.FormatInt(removeId, 10)
+	}
+	args := make([]any, 0, len(ids)+4)
+	args = append(args, strconv.FormatFloat(score, 'f', 4, 64), mutual, svcCtx.Config.UserRecommend.MaxCount, remove)
+	for _, id := range ids {
+		args = append(args, strconv.FormatInt(id, 10))
+	}
+	ret, err := svcCtx.BizRedis.EvalCtx(ctx, incrRecommendScript, userRecommendKeys(userId), args...)
+	if err != nil {
+		return false, err
+	}
+	updated, _ := ret.(int64)
+
+	return updated == 1, nil
+}
+
+// 粉丝数相差很大，取对数避免热门作者压过其它来源
+func popularScore(weight float64, fansCount uint64) float64 {
+	return weight * math.Log10(float64(fansCount)+1)
+}
+
+func userRecommendKeys(userId int64) []string {
+	return []string{
+		fmt.Sprintf(prefixUserRecommend, userId),
+		fmt.Sprintf(prefixUserRecommendReason, userId),
+		fmt.Sprintf(prefixUserRecommendMutual, userId),
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"posta/application/recommendation/mq/internal/types"
+)
+
+var _ ArticleModel = (*customArticleModel)(nil)
+
+type (
+	// ArticleModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customArticleModel.
+	ArticleModel interface {
+		articleModel
+		AuthorCountsByIds(ctx context.Context, ids []int64) ([]*types.UserCount, error)
+	}
+
+	customArticleModel struct {
+		*defaultArticleModel
+	}
+)
+
+// NewArticleModel returns a model for the database table.
+func NewArticleModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ArticleModel {
+	return &customArticleModel{
+		defaultArticleModel: newArticleModel(conn, c, opts...),
+	}
+}
+
+// AuthorCountsByIds 一批文章的作者，以及每个作者有几篇
+func (m *customArticleModel) AuthorCountsByIds(ctx context.Context, ids []int64) ([]*types.UserCount, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(ids)+1)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	args = append(args, types.ArticleStatusVisible)
+	query := fmt.Sprintf("select author_id as user_id, count(*) as cnt from %s where id in (%s) and status = ? group by author_id",
+		m.table, strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","))
+	var result []*types.UserCount
+	err := m.QueryRowsNoCacheCtx(ctx, &result, query, args...)
+	return result, err
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ FollowCountModel = (*customFollowCountModel)(nil)
+
+type (
+	// FollowCountModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customFollowCountModel.
+	FollowCountModel interface {
+		followCountModel
+		withSession(session sqlx.Session) FollowCountModel
+		TopByFansCount(ctx context.Context, limit int) ([]*FollowCount, error)
+		FindAfterId(ctx context.Context, cursorId int64, limit int) ([]*FollowCount, error)
+	}
+
+	customFollowCountModel struct {
+		*defaultFollowCountModel
+	}
+)
+
+// NewFollowCountModel returns a model for the database table.
+func NewFollowCountModel(conn sqlx.SqlConn) FollowCountModel {
+	return &customFollowCountModel{
+		defaultFollowCountModel: newFollowCountModel(conn),
+	}
+}
+
+func (m *customFollowCountModel) withSession(session sqlx.Session) FollowCountModel {
+	return NewFollowCountModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// TopByFansCount 粉丝数最多的用户
+func (m *customFollowCountModel) TopByFansCount(ctx context.Context, limit int) ([]*FollowCount, error) {
+	query := fmt.Sprintf("select %s from %s where fans_count > 0 order by fans_count desc limit ?", followCountRows, m.table)
+	var result []*FollowCount
+	err := m.conn.QueryRowsCtx(ctx, &result, query, limit)
+	return result, err
+}
+
+// FindAfterId 按id顺序遍历全部用户，用于离线重建推荐
+func (m *customFollowCountModel) FindAfterId(ctx context.Context, cursorId int64, limit int) ([]*FollowCount, error) {
+	query := fmt.Sprintf("select %s from %s where id > ? order by id asc limit ?", followCountRows, m.table)
+	var result []*FollowCount
+	err := m.conn.QueryRowsCtx(ctx, &result, query, cursorId, limit)
+	return result, err
+}
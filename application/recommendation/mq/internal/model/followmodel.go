@@ -0,0 +1,90 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"posta/application/recommendation/mq/internal/types"
+)
+
+var _ FollowModel = (*customFollowModel)(nil)
+
+type (
+	// FollowModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customFollowModel.
+	FollowModel interface {
+		followModel
+		withSession(session sqlx.Session) FollowModel
+		FollowedIds(ctx context.Context, userId int64, limit int) ([]int64, error)
+		FanIds(ctx context.Context, userId int64, limit int) ([]int64, error)
+		SecondDegree(ctx context.Context, userId int64, followedIds []int64, limit int) ([]*types.UserCount, error)
+		FollowersIn(ctx context.Context, userIds []int64, followedUserId int64) ([]int64, error)
+	}
+
+	customFollowModel struct {
+		*defaultFollowModel
+	}
+)
+
+// NewFollowModel returns a model for the database table.
+func NewFollowModel(conn sqlx.SqlConn) FollowModel {
+	return &customFollowModel{
+		defaultFollowModel: newFollowModel(conn),
+	}
+}
+
+func (m *customFollowModel) withSession(session sqlx.Session) FollowModel {
+	return NewFollowModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// FollowedIds 用户关注的人，按关注时间倒序
+func (m *customFollowModel) FollowedIds(ctx context.Context, userId int64, limit int) ([]int64, error) {
+	query := fmt.Sprintf("select followed_user_id from %s where user_id = ? and follow_status = ? order by id desc limit ?", m.table)
+	var ids []int64
+	err := m.conn.QueryRowsCtx(ctx, &ids, query, userId, types.FollowStatusFollow, limit)
+	return ids, err
+}
+
+// FanIds 用户的粉丝，按关注时间倒序
+func (m *customFollowModel) FanIds(ctx context.Context, userId int64, limit int) ([]int64, error) {
+	query := fmt.Sprintf("select user_id from %s where followed_user_id = ? and follow_status = ? order by id desc limit ?", m.table)
+	var ids []int64
+	err := m.conn.QueryRowsCtx(ctx, &ids, query, userId, types.FollowStatusFollow, limit)
+	return ids, err
+}
+
+// SecondDegree 二度关系：我关注的人还关注了谁，以及有几个我关注的人关注了他
+func (m *customFollowModel) SecondDegree(ctx context.Context, userId int64, followedIds []int64, limit int) ([]*types.UserCount, error) {
+	if len(followedIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(followedIds)+3)
+	for _, id := range followedIds {
+		args = append(args, id)
+	}
+	args = append(args, types.FollowStatusFollow, userId, limit)
+	query := fmt.Sprintf("select followed_user_id as user_id, count(*) as cnt from %s where user_id in (%s) and follow_status = ? and followed_user_id != ? group by followed_user_id order by cnt desc limit ?",
+		m.table, strings.TrimSuffix(strings.Repeat("?,", len(followedIds)), ","))
+	var result []*types.UserCount
+	err := m.conn.QueryRowsCtx(ctx, &result, query, args...)
+	return result, err
+}
+
+// FollowersIn userIds中关注了followedUserId的人
+func (m *customFollowModel) FollowersIn(ctx context.Context, userIds []int64, followedUserId int64) ([]int64, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(userIds)+2)
+	for _, id := range userIds {
+		args = append(args, id)
+	}
+	args = append(args, followedUserId, types.FollowStatusFollow)
+	query := fmt.Sprintf("select user_id from %s where user_id in (%s) and followed_user_id = ? and follow_status = ?",
+		m.table, strings.TrimSuffix(strings.Repeat("?,", len(userIds)), ","))
+	var ids []int64
+	err := m.conn.QueryRowsCtx(ctx, &ids, query, args...)
+	return ids, err
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LikeRecordModel = (*customLikeRecordModel)(nil)
+
+type (
+	// LikeRecordModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLikeRecordModel.
+	LikeRecordModel interface {
+		likeRecordModel
+		withSession(session sqlx.Session) LikeRecordModel
+		RecentObjIds(ctx context.Context, userId, bizId int64, limit int) ([]int64, error)
+	}
+
+	customLikeRecordModel struct {
+		*defaultLikeRecordModel
+	}
+)
+
+// NewLikeRecordModel returns a model for the database table.
+func NewLikeRecordModel(conn sqlx.SqlConn) LikeRecordModel {
+	return &customLikeRecordModel{
+		defaultLikeRecordModel: newLikeRecordModel(conn),
+	}
+}
+
+func (m *customLikeRecordModel) withSession(session sqlx.Session) LikeRecordModel {
+	return NewLikeRecordModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// RecentObjIds 用户最近点赞的对象，走ix_user_biz_ctime索引
+func (m *customLikeRecordModel) RecentObjIds(ctx context.Context, userId, bizId int64, limit int) ([]int64, error) {
+	query := fmt.Sprintf("select obj_id from %s where user_id = ? and biz_id = ? order by create_time desc limit ?", m.table)
+	var ids []int64
+	err := m.conn.QueryRowsCtx(ctx, &ids, query, userId, bizId, limit)
+	return ids, err
+}
@@ -0,0 +1,43 @@
+package svc
+
+import (
+	"posta/application/follow/rpc/follow"
+	"posta/application/recommendation/mq/internal/config"
+	"posta/application/recommendation/mq/internal/model"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type ServiceContext struct {
+	Config           config.Config
+	FollowModel      model.FollowModel
+	FollowCountModel model.FollowCountModel
+	LikeRecordModel  model.LikeRecordModel
+	ArticleModel     model.ArticleModel
+	BizRedis         *redis.Redis
+	FollowRPC        follow.Follow
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	rds, err := redis.NewRedis(redis.RedisConf{
+		Host: c.BizRedis.Host,
+		Pass: c.BizRedis.Pass,
+		Type: c.BizRedis.Type,
+	})
+	if err != nil {
+		panic(err)
+	}
+	followConn := sqlx.NewMysql(c.DataSourceFollow)
+
+	return &ServiceContext{
+		Config:           c,
+		FollowModel:      model.NewFollowModel(followConn),
+		FollowCountModel: model.NewFollowCountModel(followConn),
+		LikeRecordModel:  model.NewLikeRecordModel(sqlx.NewMysql(c.DataSourceLike)),
+		ArticleModel:     model.NewArticleModel(sqlx.NewMysql(c.DataSourceArticle), c.CacheRedis),
+		BizRedis:         rds,
+		FollowRPC:        follow.NewFollow(zrpc.MustNewClient(c.FollowRPC)),
+	}
+}
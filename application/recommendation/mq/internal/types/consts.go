@@ -0,0 +1,32 @@
+package types
+
+const (
+	FollowStatusFollow   = iota + 1 // 关注
+	FollowStatusUnfollow            // 取消关注
+)
+
+// ArticleStatusVisible 文章可见
+const ArticleStatusVisible = 2
+
+// BizArticle 点赞业务：文章
+const BizArticle = 0
+
+// 推荐理由，一个用户同时满足多个时取靠前的
+const (
+	ReasonSecondDegree = iota + 1 // 你关注的人也关注了他
+	ReasonLikedAuthor             // 你赞过他的文章
+	ReasonPopular                 // 热门作者
+)
+
+const (
+	// MaxFollowedCount 计算二度关系时最多取的关注数
+	MaxFollowedCount = 1000
+	// MaxCandidateCount 每个来源最多取的候选数
+	MaxCandidateCount = 500
+	// MaxLikedCount 计算点赞作者时最多取的点赞数
+	MaxLikedCount = 500
+	// MaxFanout 有新的关注时，最多更新关注者的多少个粉丝的推荐
+	MaxFanout = 1000
+	// RebuildBatchSize 离线重建时每批处理的用户数
+	RebuildBatchSize = 500
+)
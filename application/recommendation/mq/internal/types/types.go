@@ -0,0 +1,15 @@
+package types
+
+type UserCount struct {
+	UserId int64 `db:"user_id"`
+	Cnt    int64 `db:"cnt"`
+}
+
+type CanalFollowMsg struct {
+	Data []struct {
+		ID             string `json:"id"`
+		UserId         string `json:"user_id"`
+		FollowedUserID string `json:"followed_user_id"`
+		Status         string `json:"follow_status"`
+	}
+}
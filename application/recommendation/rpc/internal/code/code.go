@@ -0,0 +1,8 @@
+package code
+
+import "posta/pkg/xcode"
+
+var (
+	UserIdInvalid    = xcode.New(120001, "用户ID无效")
+	PageTokenInvalid = xcode.New(120002, "分页游标无效")
+)
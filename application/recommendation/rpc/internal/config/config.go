@@ -0,0 +1,30 @@
+package config
+
+import (
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	zrpc.RpcServerConf
+	DataSourceArticle string
+	DataSourceLike    string
+	DataSourceFollow  string
+	CacheRedis        cache.CacheConf
+	BizRedis          redis.RedisConf
+	FollowRPC         zrpc.RpcClientConf
+	// CursorSecret 分页游标的签名密钥
+	CursorSecret string
+	// 推荐流的打分权重，每项特征都归一化到0~1之后加权求和
+	RecommendFeed struct {
+		HotWeight     float64 `json:",default=1"`   // 热度
+		TagWeight     float64 `json:",default=2"`   // 和我赞过的文章的标签重合度
+		SimilarWeight float64 `json:",default=1.5"` // 作者被和我口味相似的用户关注的程度
+		FreshWeight   float64 `json:",default=0.5"` // 新鲜度
+		PoolDays      int     `json:",default=7"`   // 候选文章池只取最近几天发布的
+		PoolSize      int     `json:",default=1000"`
+		SessionSize   int     `json:",default=200"`    // 每次刷新生成多少条推荐
+		SeenBits      uint    `json:",default=262144"` // 已看过文章的布隆过滤器大小
+	}
+}
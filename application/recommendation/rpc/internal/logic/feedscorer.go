@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"posta/application/recommendation/rpc/internal/config"
+	"posta/application/recommendation/rpc/internal/types"
+)
+
+// feedFeatures 候选文章的各项特征，都已经归一化到0~1
+type feedFeatures struct {
+	hot     float64
+	tag     float64
+	similar float64
+	fresh   float64
+}
+
+// feedScorer 按配置的权重对特征加权求和，调整推荐效果只需要改配置
+type feedScorer struct {
+	hotWeight     float64
+	tagWeight     float64
+	similarWeight float64
+	freshWeight   float64
+}
+
+func newFeedScorer(c config.Config) *feedScorer {
+	return &feedScorer{
+		hotWeight:     c.RecommendFeed.HotWeight,
+		tagWeight:     c.RecommendFeed.TagWeight,
+		similarWeight: c.RecommendFeed.SimilarWeight,
+		freshWeight:   c.RecommendFeed.FreshWeight,
+	}
+}
+
+func (s *feedScorer) score(f feedFeatures) float64 {
+	return s.hotWeight*f.hot + s.tagWeight*f.tag + s.similarWeight*f.similar + s.freshWeight*f.fresh
+}
+
+// hotScore 互动数加权后按发布时长衰减，发布越久衰减越多
+func hotScore(a *types.ArticleLite, now int64) float64 {
+	interactions := float64(a.LikeNum) + 2*float64(a.CommentNum) + 3*float64(a.CollectNum) + 3*float64(a.ShareNum) + 0.1*float64(a.ViewNum)
+	hours := float64(now-a.PublishTime) / 3600
+	if hours < 0 {
+		hours = 0
+	}
+	return interactions / math.Pow(hours+2, 1.5)
+}
+
+// freshScore 刚发布为1，一天后为0.5
+func freshScore(publishTime, now int64) float64 {
+	hours := float64(now-publishTime) / 3600
+	if hours < 0 {
+		hours = 0
+	}
+	return 1 / (1 + hours/24)
+}
+
+// tagAffinity 文章的标签在用户偏好中的平均权重
+func tagAffinity(tagIds []int64, profile map[int64]float64) float64 {
+	if len(tagIds) == 0 || len(profile) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, tagId := range tagIds {
+		sum += profile[tagId]
+	}
+	return sum / float64(len(tagIds))
+}
+
+// parseTagIds 文章的tag_ids以逗号分隔
+func parseTagIds(s string) []int64 {
+	s = strings.Trim(s, "[] ")
+	if s == "" {
+		return nil
+	}
+	var tagIds []int64
+	for _, part := range strings.Split(s, ",") {
+		tagId, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil || tagId <= 0 {
+			continue
+		}
+		tagIds = append(tagIds, tagId)
+	}
+	return tagIds
+}
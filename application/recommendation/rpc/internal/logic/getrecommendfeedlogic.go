@@ -0,0 +1,346 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"posta/application/follow/rpc/follow"
+	"posta/application/recommendation/rpc/internal/code"
+	"posta/application/recommendation/rpc/internal/svc"
+	"posta/application/recommendation/rpc/internal/types"
+	"posta/application/recommendation/rpc/pb"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/bloom"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	prefixFeedSession = "biz#recommend#feed#%d"      // zset，本次刷新生成的推荐，按排名分页
+	prefixFeedSeen    = "biz#recommend#feed#seen#%d" // 布隆过滤器，已经推荐过的文章
+	feedPoolKey       = "biz#recommend#feed#pool"    // 全站最近的文章，所有用户共用的候选池
+)
+
+type GetRecommendFeedLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRecommendFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRecommendFeedLogic {
+	return &GetRecommendFeedLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetRecommendFeed 推荐流。第一页时重新召回和打分，结果存成一个会话，之后按偏移量分页；
+// 返回过的文章记入布隆过滤器，下次刷新时不再推荐
+func (l *GetRecommendFeedLogic) GetRecommendFeed(in *pb.GetRecommendFeedRequest) (*pb.GetRecommendFeedResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+
+	scope := fmt.Sprintf("recommendFeed#%d", in.UserId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	// Sort是下一页在会话中的偏移量
+	offset := pageCursor.Sort
+
+	key := fmt.Sprintf(prefixFeedSession, in.UserId)
+	var exist bool
+	if offset > 0 {
+		exist, err = l.svcCtx.BizRedis.ExistsCtx(l.ctx, key)
+		if err != nil {
+			l.Logger.Errorf("[GetRecommendFeed] BizRedis.ExistsCtx key: %s error: %v", key, err)
+		}
+	}
+	// 会话过期后从头开始
+	if !exist {
+		offset = 0
+		if err := l.buildSession(in.UserId); err != nil {
+			l.Logger.Errorf("[GetRecommendFeed] buildSession userId: %d error: %v", in.UserId, err)
+			return nil, err
+		}
+	}
+
+	members, err := l.svcCtx.BizRedis.ZrevrangeCtx(l.ctx, key, offset, offset+in.PageSize-1)
+	if err != nil {
+		l.Logger.Errorf("[GetRecommendFeed] BizRedis.ZrevrangeCtx key: %s error: %v", key, err)
+		return nil, err
+	}
+	ret := &pb.GetRecommendFeedResponse{
+		IsEnd:         len(members) < int(in.PageSize),
+		NextPageToken: l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: offset + int64(len(members))}),
+	}
+
+	var articleIds []int64
+	for _, member := range members {
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		article, err := l.svcCtx.ArticleModel.FindOne(l.ctx, id)
+		if err != nil {
+			l.Logger.Errorf("[GetRecommendFeed] ArticleModel.FindOne id: %d error: %v", id, err)
+			continue
+		}
+		if article.Status != types.ArticleStatusVisible {
+			continue
+		}
+		articleIds = append(articleIds, id)
+		ret.Items = append(ret.Items, &pb.GetRecommendFeedItem{
+			Id:           article.Id,
+			Title:        article.Title,
+			Content:      article.Content,
+			Description:  article.Description,
+			Cover:        article.Cover,
+			CommentCount: article.CommentNum,
+			LikeCount:    article.LikeNum,
+			PublishTime:  article.PublishTime.Local().Unix(),
+			AuthorId:     article.AuthorId,
+		})
+	}
+	l.markSeen(in.UserId, articleIds)
+
+	return ret, nil
+}
+
+// buildSession 召回、打分、过滤已看过的，取前SessionSize条存入会话
+func (l *GetRecommendFeedLogic) buildSession(userId int64) error {
+	conf := l.svcCtx.Config.RecommendFeed
+	now := time.Now().Unix()
+	since := time.Now().AddDate(0, 0, -conf.PoolDays)
+
+	// 1. 召回：全站热门 + 和我口味相似的用户关注的作者的文章
+	pool, err := l.articlePool(since)
+	if err != nil {
+		return err
+	}
+	likedIds, err := l.svcCtx.LikeRecordModel.RecentObjIds(l.ctx, userId, types.BizArticle, types.MaxLikedCount)
+	if err != nil {
+		return err
+	}
+	similarAuthors, err := l.similarAuthors(userId, likedIds)
+	if err != nil {
+		l.Logger.Errorf("[GetRecommendFeed] similarAuthors userId: %d error: %v", userId, err)
+	}
+	authorIds := make([]int64, 0, len(similarAuthors))
+	for authorId := range similarAuthors {
+		authorIds = append(authorIds, authorId)
+	}
+	similarArticles, err := l.svcCtx.ArticleModel.LitesByAuthorIds(l.ctx, authorIds, since, types.MaxSimilarArticleCount)
+	if err != nil {
+		l.Logger.Errorf("[GetRecommendFeed] ArticleModel.LitesByAuthorIds error: %v", err)
+	}
+
+	// 2. 去掉自己的、赞过的、拉黑和屏蔽的作者的文章
+	exclude := make(map[int64]struct{}, len(likedIds))
+	for _, id := range likedIds {
+		exclude[id] = struct{}{}
+	}
+	hiddenAuthors := l.hiddenAuthorIds(userId)
+	hiddenAuthors[userId] = struct{}{}
+	candidates := make(map[int64]*types.ArticleLite, len(pool)+len(similarArticles))
+	for _, articles := range [][]*types.ArticleLite{pool, similarArticles} {
+		for _, a := range articles {
+			if _, ok := exclude[a.Id]; ok {
+				continue
+			}
+			if _, ok := hiddenAuthors[a.AuthorId]; ok {
+				continue
+			}
+			candidates[a.Id] = a
+		}
+	}
+
+	// 3. 打分
+	profile, err := l.tagProfile(likedIds)
+	if err != nil {
+		l.Logger.Errorf("[GetRecommendFeed] tagProfile userId: %d error: %v", userId, err)
+	}
+	var maxHot float64
+	var maxSimilar int64
+	for _, a := range candidates {
+		maxHot = max(maxHot, hotScore(a, now))
+		maxSimilar = max(maxSimilar, similarAuthors[a.AuthorId])
+	}
+	scorer := newFeedScorer(l.svcCtx.Config)
+	type scored struct {
+		id    int64
+		score float64
+	}
+	ranked := make([]scored, 0, len(candidates))
+	for _, a := range candidates {
+		f := feedFeatures{
+			tag:   tagAffinity(parseTagIds(a.TagIds), profile),
+			fresh: freshScore(a.PublishTime, now),
+		}
+		if maxHot > 0 {
+			f.hot = hotScore(a, now) / maxHot
+		}
+		if maxSimilar > 0 {
+			f.similar = float64(similarAuthors[a.AuthorId]) / float64(maxSimilar)
+		}
+		ranked = append(ranked, scored{id: a.Id, score: scorer.score(f)})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score == ranked[j].score {
+			return ranked[i].id > ranked[j].id
+		}
+		return ranked[i].score > ranked[j].score
+	})
+
+	// 4. 过滤看过的，按排名存入会话
+	seen := l.seenFilter(userId)
+	pairs := make([]redis.Pair, 0, conf.SessionSize)
+	for _, r := range ranked {
+		if len(pairs) >= conf.SessionSize {
+			break
+		}
+		isSeen, err := seen.ExistsCtx(l.ctx, []byte(strconv.FormatInt(r.id, 10)))
+		if err != nil {
+			l.Logger.Errorf("[GetRecommendFeed] seen.ExistsCtx userId: %d error: %v", userId, err)
+		}
+		if isSeen {
+			continue
+		}
+		pairs = append(pairs, redis.Pair{
+			Key:   strconv.FormatInt(r.id, 10),
+			Score: int64(conf.SessionSize - len(pairs)),
+		})
+	}
+
+	key := fmt.Sprintf(prefixFeedSession, userId)
+	if _, err = l.svcCtx.BizRedis.DelCtx(l.ctx, key); err != nil {
+		return err
+	}
+	if len(pairs) == 0 {
+		return nil
+	}
+	if _, err = l.svcCtx.BizRedis.ZaddsCtx(l.ctx, key, pairs...); err != nil {
+		return err
+	}
+	return l.svcCtx.BizRedis.ExpireCtx(l.ctx, key, types.FeedSessionExpire)
+}
+
+// articlePool 全站最近的文章，所有用户共用，缓存几分钟
+func (l *GetRecommendFeedLogic) articlePool(since time.Time) ([]*types.ArticleLite, error) {
+	var pool []*types.ArticleLite
+	val, err := l.svcCtx.BizRedis.GetCtx(l.ctx, feedPoolKey)
+	if err != nil {
+		l.Logger.Errorf("[GetRecommendFeed] BizRedis.GetCtx key: %s error: %v", feedPoolKey, err)
+	}
+	if val != "" && json.Unmarshal([]byte(val), &pool) == nil {
+		return pool, nil
+	}
+
+	pool, err = l.svcCtx.ArticleModel.RecentLites(l.ctx, since, l.svcCtx.Config.RecommendFeed.PoolSize)
+	if err != nil {
+		return nil, err
+	}
+	if b, err := json.Marshal(pool); err == nil {
+		if err = l.svcCtx.BizRedis.SetexCtx(l.ctx, feedPoolKey, string(b), types.ArticlePoolExpire); err != nil {
+			l.Logger.Errorf("[GetRecommendFeed] BizRedis.SetexCtx key: %s error: %v", feedPoolKey, err)
+		}
+	}
+
+	return pool, nil
+}
+
+// similarAuthors 和我赞过相同文章的用户共同关注的作者，value是关注他的相似用户数
+func (l *GetRecommendFeedLogic) similarAuthors(userId int64, likedIds []int64) (map[int64]int64, error) {
+	ret := make(map[int64]int64)
+	similarUsers, err := l.svcCtx.LikeRecordModel.SimilarUsers(l.ctx, userId, types.BizArticle, likedIds, types.MaxSimilarUserCount)
+	if err != nil || len(similarUsers) == 0 {
+		return ret, err
+	}
+	userIds := make([]int64, 0, len(similarUsers))
+	for _, u := range similarUsers {
+		userIds = append(userIds, u.UserId)
+	}
+	authors, err := l.svcCtx.FollowModel.TopFollowedBy(l.ctx, userIds, types.MaxSimilarAuthorCount)
+	if err != nil {
+		return ret, err
+	}
+	for _, a := range authors {
+		if a.UserId != userId {
+			ret[a.UserId] = a.Cnt
+		}
+	}
+
+	return ret, nil
+}
+
+// tagProfile 我赞过的文章中每个标签出现的比例，出现最多的标签为1
+func (l *GetRecommendFeedLogic) tagProfile(likedIds []int64) (map[int64]float64, error) {
+	profile := make(map[int64]float64)
+	articles, err := l.svcCtx.ArticleModel.LitesByIds(l.ctx, likedIds)
+	if err != nil {
+		return profile, err
+	}
+	var maxCount float64
+	for _, a := range articles {
+		for _, tagId := range parseTagIds(a.TagIds) {
+			profile[tagId]++
+			maxCount = max(maxCount, profile[tagId])
+		}
+	}
+	for tagId := range profile {
+		profile[tagId] /= maxCount
+	}
+
+	return profile, nil
+}
+
+// hiddenAuthorIds 拉黑和屏蔽的作者，查询失败时不过滤
+func (l *GetRecommendFeedLogic) hiddenAuthorIds(userId int64) map[int64]struct{} {
+	hidden := make(map[int64]struct{})
+	ret, err := l.svcCtx.FollowRPC.BlockedIds(l.ctx, &follow.BlockedIdsRequest{UserId: userId})
+	if err != nil {
+		l.Logger.Errorf("[GetRecommendFeed] FollowRPC.BlockedIds userId: %d error: %v", userId, err)
+		return hidden
+	}
+	for _, id := range ret.BlockedIds {
+		hidden[id] = struct{}{}
+	}
+	for _, id := range ret.MutedIds {
+		hidden[id] = struct{}{}
+	}
+
+	return hidden
+}
+
+func (l *GetRecommendFeedLogic) seenFilter(userId int64) *bloom.Filter {
+	return bloom.New(l.svcCtx.BizRedis, fmt.Sprintf(prefixFeedSeen, userId), l.svcCtx.Config.RecommendFeed.SeenBits)
+}
+
+// markSeen 返回给用户的文章记入布隆过滤器，有误判时少推荐一篇没看过的文章，不影响体验
+func (l *GetRecommendFeedLogic) markSeen(userId int64, articleIds []int64) {
+	if len(articleIds) == 0 {
+		return
+	}
+	seen := l.seenFilter(userId)
+	for _, id := range articleIds {
+		if err := seen.AddCtx(l.ctx, []byte(strconv.FormatInt(id, 10))); err != nil {
+			l.Logger.Errorf("[GetRecommendFeed] seen.AddCtx userId: %d error: %v", userId, err)
+			return
+		}
+	}
+	key := fmt.Sprintf(prefixFeedSeen, userId)
+	if err := l.svcCtx.BizRedis.ExpireCtx(l.ctx, key, types.SeenExpire); err != nil {
+		l.Logger.Errorf("[GetRecommendFeed] BizRedis.ExpireCtx key: %s error: %v", key, err)
+	}
+}
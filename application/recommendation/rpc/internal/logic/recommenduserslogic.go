@@ -0,0 +1,176 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"posta/application/follow/rpc/follow"
+	"posta/application/recommendation/rpc/internal/code"
+	"posta/application/recommendation/rpc/internal/svc"
+	"posta/application/recommendation/rpc/internal/types"
+	"posta/application/recommendation/rpc/pb"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 和recommendation-mq中的key保持一致
+const (
+	prefixUserRecommend       = "biz#recommend#user#%d"
+	prefixUserRecommendReason = "biz#recommend#user#reason#%d"
+	prefixUserRecommendMutual = "biz#recommend#user#mutual#%d"
+	userRecommendPopularKey   = "biz#recommend#user#popular"
+	userRecommendPlaceholder  = "0"
+)
+
+type RecommendUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRecommendUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecommendUsersLogic {
+	return &RecommendUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RecommendUsers 关注推荐，读取recommendation-mq预先算好的结果。还没算过的用户返回热门作者。
+// 预计算和关注之间有延迟，返回前再按实时的关注和拉黑关系过滤一次，过滤后一页可能不足pageSize条
+func (l *RecommendUsersLogic) RecommendUsers(in *pb.RecommendUsersRequest) (*pb.RecommendUsersResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+	scope := fmt.Sprintf("recommendUsers#%d", in.UserId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	// Sort是下一页在推荐结果中的偏移量
+	offset := pageCursor.Sort
+
+	key := fmt.Sprintf(prefixUserRecommend, in.UserId)
+	exist, err := l.svcCtx.BizRedis.ExistsCtx(l.ctx, key)
+	if err != nil {
+		l.Logger.Errorf("[RecommendUsers] BizRedis.ExistsCtx key: %s error: %v", key, err)
+		return nil, err
+	}
+	if !exist {
+		key = userRecommendPopularKey
+	}
+	members, err := l.svcCtx.BizRedis.ZrevrangeCtx(l.ctx, key, offset, offset+in.PageSize-1)
+	if err != nil {
+		l.Logger.Errorf("[RecommendUsers] BizRedis.ZrevrangeCtx key: %s error: %v", key, err)
+		return nil, err
+	}
+
+	ret := &pb.RecommendUsersResponse{
+		IsEnd:         len(members) < int(in.PageSize),
+		NextPageToken: l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: offset + int64(len(members))}),
+	}
+	var userIds []int64
+	for _, member := range members {
+		if member == userRecommendPlaceholder {
+			continue
+		}
+		userId, err := strconv.ParseInt(member, 10, 64)
+		if err != nil || userId == in.UserId {
+			continue
+		}
+		userIds = append(userIds, userId)
+	}
+	userIds = l.filter(in.UserId, userIds)
+	if len(userIds) == 0 {
+		return ret, nil
+	}
+
+	reasons, mutuals := l.details(in.UserId, userIds, exist)
+	for i, userId := range userIds {
+		ret.Items = append(ret.Items, &pb.RecommendUserItem{
+			UserId:      userId,
+			Reason:      int32(reasons[i]),
+			MutualCount: mutuals[i],
+		})
+	}
+
+	return ret, nil
+}
+
+// filter 去掉已经关注的和我拉黑的，查询失败时不过滤
+func (l *RecommendUsersLogic) filter(userId int64, userIds []int64) []int64 {
+	if len(userIds) == 0 {
+		return nil
+	}
+	hidden := make(map[int64]struct{})
+	relations, err := l.svcCtx.FollowRPC.Relations(l.ctx, &follow.RelationsRequest{UserId: userId, TargetIds: userIds})
+	if err != nil {
+		l.Logger.Errorf("[RecommendUsers] FollowRPC.Relations userId: %d error: %v", userId, err)
+	} else {
+		for _, item := range relations.Items {
+			if item.IsFollowing {
+				hidden[item.TargetId] = struct{}{}
+			}
+		}
+	}
+	blocked, err := l.svcCtx.FollowRPC.BlockedIds(l.ctx, &follow.BlockedIdsRequest{UserId: userId})
+	if err != nil {
+		l.Logger.Errorf("[RecommendUsers] FollowRPC.BlockedIds userId: %d error: %v", userId, err)
+	} else {
+		for _, id := range blocked.BlockedIds {
+			hidden[id] = struct{}{}
+		}
+	}
+
+	ret := make([]int64, 0, len(userIds))
+	for _, id := range userIds {
+		if _, ok := hidden[id]; !ok {
+			ret = append(ret, id)
+		}
+	}
+
+	return ret
+}
+
+// details 推荐理由和共同关注数，热门作者兜底时没有个人数据
+func (l *RecommendUsersLogic) details(userId int64, userIds []int64, personal bool) ([]int, []int64) {
+	reasons := make([]int, len(userIds))
+	mutuals := make([]int64, len(userIds))
+	for i := range reasons {
+		reasons[i] = types.ReasonPopular
+	}
+	if !personal {
+		return reasons, mutuals
+	}
+
+	fields := make([]string, 0, len(userIds))
+	for _, id := range userIds {
+		fields = append(fields, strconv.FormatInt(id, 10))
+	}
+	reasonKey := fmt.Sprintf(prefixUserRecommendReason, userId)
+	values, err := l.svcCtx.BizRedis.HmgetCtx(l.ctx, reasonKey, fields...)
+	if err != nil {
+		l.Logger.Errorf("[RecommendUsers] BizRedis.HmgetCtx key: %s error: %v", reasonKey, err)
+	}
+	for i, v := range values {
+		if reason, err := strconv.Atoi(v); err == nil {
+			reasons[i] = reason
+		}
+	}
+	mutualKey := fmt.Sprintf(prefixUserRecommendMutual, userId)
+	values, err = l.svcCtx.BizRedis.HmgetCtx(l.ctx, mutualKey, fields...)
+	if err != nil {
+		l.Logger.Errorf("[RecommendUsers] BizRedis.HmgetCtx key: %s error: %v", mutualKey, err)
+	}
+	for i, v := range values {
+		mutuals[i], _ = strconv.ParseInt(v, 10, 64)
+	}
+
+	return reasons, mutuals
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"posta/application/recommendation/rpc/internal/types"
+)
+
+var _ ArticleModel = (*customArticleModel)(nil)
+
+const articleLiteRows = "id, author_id, tag_ids, like_num, comment_num, collect_num, share_num, view_num, UNIX_TIMESTAMP(publish_time) AS publish_time"
+
+type (
+	// ArticleModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customArticleModel.
+	ArticleModel interface {
+		articleModel
+		RecentLites(ctx context.Context, since time.Time, limit int) ([]*types.ArticleLite, error)
+		LitesByAuthorIds(ctx context.Context, authorIds []int64, since time.Time, limit int) ([]*types.ArticleLite, error)
+		LitesByIds(ctx context.Context, ids []int64) ([]*types.ArticleLite, error)
+	}
+
+	customArticleModel struct {
+		*defaultArticleModel
+	}
+)
+
+// NewArticleModel returns a model for the database table.
+func NewArticleModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ArticleModel {
+	return &customArticleModel{
+		defaultArticleModel: newArticleModel(conn, c, opts...),
+	}
+}
+
+// RecentLites since之后发布的可见文章，按发布时间倒序
+func (m *customArticleModel) RecentLites(ctx context.Context, since time.Time, limit int) ([]*types.ArticleLite, error) {
+	query := fmt.Sprintf("select %s from %s where publish_time > ? and status = ? order by publish_time desc limit ?", articleLiteRows, m.table)
+	var result []*types.ArticleLite
+	err := m.QueryRowsNoCacheCtx(ctx, &result, query, since, types.ArticleStatusVisible, limit)
+	return result, err
+}
+
+// LitesByAuthorIds 一批作者since之后发布的可见文章，按发布时间倒序
+func (m *customArticleModel) LitesByAuthorIds(ctx context.Context, authorIds []int64, since time.Time, limit int) ([]*types.ArticleLite, error) {
+	if len(authorIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(authorIds)+3)
+	for _, id := range authorIds {
+		args = append(args, id)
+	}
+	args = append(args, since, types.ArticleStatusVisible, limit)
+	query := fmt.Sprintf("select %s from %s where author_id in (%s) and publish_time > ? and status = ? order by publish_time desc limit ?",
+		articleLiteRows, m.table, strings.TrimSuffix(strings.Repeat("?,", len(authorIds)), ","))
+	var result []*types.ArticleLite
+	err := m.QueryRowsNoCacheCtx(ctx, &result, query, args...)
+	return result, err
+}
+
+func (m *customArticleModel) LitesByIds(ctx context.Context, ids []int64) ([]*types.ArticleLite, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	query := fmt.Sprintf("select %s from %s where id in (%s)",
+		articleLiteRows, m.table, strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","))
+	var result []*types.ArticleLite
+	err := m.QueryRowsNoCacheCtx(ctx, &result, query, args...)
+	return result, err
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"posta/application/recommendation/rpc/internal/types"
+)
+
+var _ FollowModel = (*customFollowModel)(nil)
+
+type (
+	// FollowModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customFollowModel.
+	FollowModel interface {
+		followModel
+		withSession(session sqlx.Session) FollowModel
+		TopFollowedBy(ctx context.Context, userIds []int64, limit int) ([]*types.UserCount, error)
+	}
+
+	customFollowModel struct {
+		*defaultFollowModel
+	}
+)
+
+// NewFollowModel returns a model for the database table.
+func NewFollowModel(conn sqlx.SqlConn) FollowModel {
+	return &customFollowModel{
+		defaultFollowModel: newFollowModel(conn),
+	}
+}
+
+func (m *customFollowModel) withSession(session sqlx.Session) FollowModel {
+	return NewFollowModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// TopFollowedBy userIds共同关注最多的人
+func (m *customFollowModel) TopFollowedBy(ctx context.Context, userIds []int64, limit int) ([]*types.UserCount, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(userIds)+2)
+	for _, id := range userIds {
+		args = append(args, id)
+	}
+	args = append(args, types.FollowStatusFollow, limit)
+	query := fmt.Sprintf("select followed_user_id as user_id, count(*) as cnt from %s where user_id in (%s) and follow_status = ? group by followed_user_id order by cnt desc limit ?",
+		m.table, strings.TrimSuffix(strings.Repeat("?,", len(userIds)), ","))
+	var result []*types.UserCount
+	err := m.conn.QueryRowsCtx(ctx, &result, query, args...)
+	return result, err
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"posta/application/recommendation/rpc/internal/types"
+)
+
+var _ LikeRecordModel = (*customLikeRecordModel)(nil)
+
+type (
+	// LikeRecordModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLikeRecordModel.
+	LikeRecordModel interface {
+		likeRecordModel
+		withSession(session sqlx.Session) LikeRecordModel
+		RecentObjIds(ctx context.Context, userId, bizId int64, limit int) ([]int64, error)
+		SimilarUsers(ctx context.Context, userId, bizId int64, objIds []int64, limit int) ([]*types.UserCount, error)
+	}
+
+	customLikeRecordModel struct {
+		*defaultLikeRecordModel
+	}
+)
+
+// NewLikeRecordModel returns a model for the database table.
+func NewLikeRecordModel(conn sqlx.SqlConn) LikeRecordModel {
+	return &customLikeRecordModel{
+		defaultLikeRecordModel: newLikeRecordModel(conn),
+	}
+}
+
+func (m *customLikeRecordModel) withSession(session sqlx.Session) LikeRecordModel {
+	return NewLikeRecordModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// RecentObjIds 用户最近点赞的对象，走ix_user_biz_ctime索引
+func (m *customLikeRecordModel) RecentObjIds(ctx context.Context, userId, bizId int64, limit int) ([]int64, error) {
+	query := fmt.Sprintf("select obj_id from %s where user_id = ? and biz_id = ? order by create_time desc limit ?", m.table)
+	var ids []int64
+	err := m.conn.QueryRowsCtx(ctx, &ids, query, userId, bizId, limit)
+	return ids, err
+}
+
+// SimilarUsers 和userId赞过相同对象的用户，以及赞过的相同对象的个数
+func (m *customLikeRecordModel) SimilarUsers(ctx context.Context, userId, bizId int64, objIds []int64, limit int) ([]*types.UserCount, error) {
+	if len(objIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(objIds)+3)
+	args = append(args, bizId)
+	for _, id := range objIds {
+		args = append(args, id)
+	}
+	args = append(args, userId, limit)
+	query := fmt.Sprintf("select user_id, count(*) as cnt from %s where biz_id = ? and obj_id in (%s) and user_id != ? group by user_id order by cnt desc limit ?",
+		m.table, strings.TrimSuffix(strings.Repeat("?,", len(objIds)), ","))
+	var result []*types.UserCount
+	err := m.conn.QueryRowsCtx(ctx, &result, query, args...)
+	return result, err
+}
@@ -0,0 +1,41 @@
+package svc
+
+import (
+	"posta/application/follow/rpc/follow"
+	"posta/application/recommendation/rpc/internal/config"
+	"posta/application/recommendation/rpc/internal/model"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type ServiceContext struct {
+	Config          config.Config
+	ArticleModel    model.ArticleModel
+	LikeRecordModel model.LikeRecordModel
+	FollowModel     model.FollowModel
+	BizRedis        *redis.Redis
+	FollowRPC       follow.Follow
+	CursorCodec     *cursor.Codec
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	rds, _ := redis.NewRedis(redis.RedisConf{
+		Host:     c.BizRedis.Host,
+		Pass:     c.BizRedis.Pass,
+		Type:     c.BizRedis.Type,
+		NonBlock: true,
+	})
+
+	return &ServiceContext{
+		Config:          c,
+		ArticleModel:    model.NewArticleModel(sqlx.NewMysql(c.DataSourceArticle), c.CacheRedis),
+		LikeRecordModel: model.NewLikeRecordModel(sqlx.NewMysql(c.DataSourceLike)),
+		FollowModel:     model.NewFollowModel(sqlx.NewMysql(c.DataSourceFollow)),
+		BizRedis:        rds,
+		FollowRPC:       follow.NewFollow(zrpc.MustNewClient(c.FollowRPC)),
+		CursorCodec:     cursor.NewCodec(c.CursorSecret),
+	}
+}
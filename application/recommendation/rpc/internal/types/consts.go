@@ -0,0 +1,38 @@
+package types
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 50
+)
+
+// 推荐理由，和recommendation-mq保持一致
+const (
+	ReasonSecondDegree = iota + 1 // 你关注的人也关注了他
+	ReasonLikedAuthor             // 你赞过他的文章
+	ReasonPopular                 // 热门作者
+)
+
+const (
+	FollowStatusFollow = 1 // 关注
+	// ArticleStatusVisible 文章可见
+	ArticleStatusVisible = 2
+	// BizArticle 点赞业务：文章
+	BizArticle = 0
+)
+
+const (
+	// MaxLikedCount 计算标签偏好和相似用户时最多取的点赞数
+	MaxLikedCount = 200
+	// MaxSimilarUserCount 最多取的相似用户数
+	MaxSimilarUserCount = 50
+	// MaxSimilarAuthorCount 相似用户关注的作者最多取多少个
+	MaxSimilarAuthorCount = 100
+	// MaxSimilarArticleCount 相似用户关注的作者的文章最多取多少篇
+	MaxSimilarArticleCount = 300
+	// FeedSessionExpire 推荐流会话的过期时间，过期后从头开始
+	FeedSessionExpire = 1800
+	// ArticlePoolExpire 候选文章池缓存的过期时间
+	ArticlePoolExpire = 300
+	// SeenExpire 已看过文章的过滤器的过期时间
+	SeenExpire = 3600 * 24 * 30
+)
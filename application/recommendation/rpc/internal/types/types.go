@@ -0,0 +1,19 @@
+package types
+
+type UserCount struct {
+	UserId int64 `db:"user_id"`
+	Cnt    int64 `db:"cnt"`
+}
+
+// ArticleLite 打分需要的文章字段
+type ArticleLite struct {
+	Id          int64  `db:"id" json:"id"`
+	AuthorId    int64  `db:"author_id" json:"authorId"`
+	TagIds      string `db:"tag_ids" json:"tagIds"`
+	LikeNum     int64  `db:"like_num" json:"likeNum"`
+	CommentNum  int64  `db:"comment_num" json:"commentNum"`
+	CollectNum  int64  `db:"collect_num" json:"collectNum"`
+	ShareNum    int64  `db:"share_num" json:"shareNum"`
+	ViewNum     int64  `db:"view_num" json:"viewNum"`
+	PublishTime int64  `db:"publish_time" json:"publishTime"`
+}
@@ -0,0 +1,528 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.6.1
+// source: recommendation.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecommendUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	PageSize      int64                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendUsersRequest) Reset() {
+	*x = RecommendUsersRequest{}
+	mi := &file_recommendation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendUsersRequest) ProtoMessage() {}
+
+func (x *RecommendUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendUsersRequest.ProtoReflect.Descriptor instead.
+func (*RecommendUsersRequest) Descriptor() ([]byte, []int) {
+	return file_recommendation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecommendUsersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecommendUsersRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *RecommendUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type RecommendUserItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Reason        int32                  `protobuf:"varint,2,opt,name=reason,proto3" json:"reason,omitempty"`           // 推荐理由：1你关注的人也关注了他 2你赞过他的文章 3热门作者
+	MutualCount   int64                  `protobuf:"varint,3,opt,name=mutualCount,proto3" json:"mutualCount,omitempty"` // 你关注的人中有几个关注了他
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendUserItem) Reset() {
+	*x = RecommendUserItem{}
+	mi := &file_recommendation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendUserItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendUserItem) ProtoMessage() {}
+
+func (x *RecommendUserItem) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendUserItem.ProtoReflect.Descriptor instead.
+func (*RecommendUserItem) Descriptor() ([]byte, []int) {
+	return file_recommendation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecommendUserItem) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecommendUserItem) GetReason() int32 {
+	if x != nil {
+		return x.Reason
+	}
+	return 0
+}
+
+func (x *RecommendUserItem) GetMutualCount() int64 {
+	if x != nil {
+		return x.MutualCount
+	}
+	return 0
+}
+
+type RecommendUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RecommendUserItem   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	IsEnd         bool                   `protobuf:"varint,3,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendUsersResponse) Reset() {
+	*x = RecommendUsersResponse{}
+	mi := &file_recommendation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendUsersResponse) ProtoMessage() {}
+
+func (x *RecommendUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendUsersResponse.ProtoReflect.Descriptor instead.
+func (*RecommendUsersResponse) Descriptor() ([]byte, []int) {
+	return file_recommendation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecommendUsersResponse) GetItems() []*RecommendUserItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RecommendUsersResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *RecommendUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetRecommendFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	PageSize      int64                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，传空时重新生成推荐
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendFeedRequest) Reset() {
+	*x = GetRecommendFeedRequest{}
+	mi := &file_recommendation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendFeedRequest) ProtoMessage() {}
+
+func (x *GetRecommendFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendFeedRequest) Descriptor() ([]byte, []int) {
+	return file_recommendation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRecommendFeedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetRecommendFeedRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRecommendFeedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetRecommendFeedItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Cover         string                 `protobuf:"bytes,5,opt,name=cover,proto3" json:"cover,omitempty"`
+	CommentCount  int64                  `protobuf:"varint,6,opt,name=commentCount,proto3" json:"commentCount,omitempty"`
+	LikeCount     int64                  `protobuf:"varint,7,opt,name=likeCount,proto3" json:"likeCount,omitempty"`
+	PublishTime   int64                  `protobuf:"varint,8,opt,name=publishTime,proto3" json:"publishTime,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,9,opt,name=authorId,proto3" json:"authorId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendFeedItem) Reset() {
+	*x = GetRecommendFeedItem{}
+	mi := &file_recommendation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendFeedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendFeedItem) ProtoMessage() {}
+
+func (x *GetRecommendFeedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendFeedItem.ProtoReflect.Descriptor instead.
+func (*GetRecommendFeedItem) Descriptor() ([]byte, []int) {
+	return file_recommendation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRecommendFeedItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetRecommendFeedItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetRecommendFeedItem) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GetRecommendFeedItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetRecommendFeedItem) GetCover() string {
+	if x != nil {
+		return x.Cover
+	}
+	return ""
+}
+
+func (x *GetRecommendFeedItem) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *GetRecommendFeedItem) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *GetRecommendFeedItem) GetPublishTime() int64 {
+	if x != nil {
+		return x.PublishTime
+	}
+	return 0
+}
+
+func (x *GetRecommendFeedItem) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+type GetRecommendFeedResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*GetRecommendFeedItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	IsEnd         bool                    `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendFeedResponse) Reset() {
+	*x = GetRecommendFeedResponse{}
+	mi := &file_recommendation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendFeedResponse) ProtoMessage() {}
+
+func (x *GetRecommendFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendFeedResponse) Descriptor() ([]byte, []int) {
+	return file_recommendation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRecommendFeedResponse) GetItems() []*GetRecommendFeedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetRecommendFeedResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *GetRecommendFeedResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_recommendation_proto protoreflect.FileDescriptor
+
+const file_recommendation_proto_rawDesc = "" +
+	"\n" +
+	"\x14recommendation.proto\x12\x02pb\"w\n" +
+	"\x15RecommendUsersRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x04 \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03R\x06cursor\"e\n" +
+	"\x11RecommendUserItem\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\x05R\x06reason\x12 \n" +
+	"\vmutualCount\x18\x03 \x01(\x03R\vmutualCount\"\x8f\x01\n" +
+	"\x16RecommendUsersResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.pb.RecommendUserItemR\x05items\x12\x14\n" +
+	"\x05isEnd\x18\x03 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x04 \x01(\tR\rnextPageTokenJ\x04\b\x02\x10\x03R\x06cursor\"y\n" +
+	"\x17GetRecommendFeedRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x04 \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03R\x06cursor\"\x8e\x02\n" +
+	"\x14GetRecommendFeedItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05cover\x18\x05 \x01(\tR\x05cover\x12\"\n" +
+	"\fcommentCount\x18\x06 \x01(\x03R\fcommentCount\x12\x1c\n" +
+	"\tlikeCount\x18\a \x01(\x03R\tlikeCount\x12 \n" +
+	"\vpublishTime\x18\b \x01(\x03R\vpublishTime\x12\x1a\n" +
+	"\bauthorId\x18\t \x01(\x03R\bauthorId\"\x94\x01\n" +
+	"\x18GetRecommendFeedResponse\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.pb.GetRecommendFeedItemR\x05items\x12\x14\n" +
+	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x04 \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04R\x06cursor2\xa8\x01\n" +
+	"\x0eRecommendation\x12G\n" +
+	"\x0eRecommendUsers\x12\x19.pb.RecommendUsersRequest\x1a\x1a.pb.RecommendUsersResponse\x12M\n" +
+	"\x10GetRecommendFeed\x12\x1b.pb.GetRecommendFeedRequest\x1a\x1c.pb.GetRecommendFeedResponseB\x06Z\x04./pbb\x06proto3"
+
+var (
+	file_recommendation_proto_rawDescOnce sync.Once
+	file_recommendation_proto_rawDescData []byte
+)
+
+func file_recommendation_proto_rawDescGZIP() []byte {
+	file_recommendation_proto_rawDescOnce.Do(func() {
+		file_recommendation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_recommendation_proto_rawDesc), len(file_recommendation_proto_rawDesc)))
+	})
+	return file_recommendation_proto_rawDescData
+}
+
+var file_recommendation_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_recommendation_proto_goTypes = []any{
+	(*RecommendUsersRequest)(nil),    // 0: pb.RecommendUsersRequest
+	(*RecommendUserItem)(nil),        // 1: pb.RecommendUserItem
+	(*RecommendUsersResponse)(nil),   // 2: pb.RecommendUsersResponse
+	(*GetRecommendFeedRequest)(nil),  // 3: pb.GetRecommendFeedRequest
+	(*GetRecommendFeedItem)(nil),     // 4: pb.GetRecommendFeedItem
+	(*GetRecommendFeedResponse)(nil), // 5: pb.GetRecommendFeedResponse
+}
+var file_recommendation_proto_depIdxs = []int32{
+	1, // 0: pb.RecommendUsersResponse.items:type_name -> pb.RecommendUserItem
+	4, // 1: pb.GetRecommendFeedResponse.items:type_name -> pb.GetRecommendFeedItem
+	0, // 2: pb.Recommendation.RecommendUsers:input_type -> pb.RecommendUsersRequest
+	3, // 3: pb.Recommendation.GetRecommendFeed:input_type -> pb.GetRecommendFeedRequest
+	2, // 4: pb.Recommendation.RecommendUsers:output_type -> pb.RecommendUsersResponse
+	5, // 5: pb.Recommendation.GetRecommendFeed:output_type -> pb.GetRecommendFeedResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_recommendation_proto_init() }
+func file_recommendation_proto_init() {
+	if File_recommendation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_recommendation_proto_rawDesc), len(file_recommendation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_recommendation_proto_goTypes,
+		DependencyIndexes: file_recommendation_proto_depIdxs,
+		MessageInfos:      file_recommendation_proto_msgTypes,
+	}.Build()
+	File_recommendation_proto = out.File
+	file_recommendation_proto_goTypes = nil
+	file_recommendation_proto_depIdxs = nil
+}
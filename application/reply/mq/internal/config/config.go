@@ -0,0 +1,16 @@
+package config
+
+import (
+	"github.com/zeromicro/go-queue/kq"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+type Config struct {
+	service.ServiceConf
+	LikeKqConsumerConf kq.KqConf
+	Datasource         string
+	CacheRedis         cache.CacheConf
+	BizRedis           redis.RedisConf
+}
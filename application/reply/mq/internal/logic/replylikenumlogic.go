@@ -0,0 +1,138 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"posta/application/reply/mq/internal/model"
+	"posta/application/reply/mq/internal/svc"
+	"posta/application/reply/mq/internal/types"
+	"posta/pkg/zsetcache"
+
+	"github.com/zeromicro/go-queue/kq"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+// 和reply-rpc中的缓存key保持一致
+const (
+	prefixFirstReplies  = "biz#firstReplies#v2#%d#%d"
+	prefixSecondReplies = "biz#secondReplies#v2#%d#%d"
+
+	hotScoreScale = 1000000
+)
+
+type ReplyLikeNumLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReplyLikeNumLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReplyLikeNumLogic {
+	return &ReplyLikeNumLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ReplyLikeNumLogic) Consume(ctx context.Context, _, val string) error {
+	var msg *types.CanalLikeMsg
+	err := json.Unmarshal([]byte(val), &msg)
+	if err != nil {
+		logx.Errorf("Consume val: %s error: %v", val, err)
+		return err
+	}
+
+	return l.updateReplyLikeNum(ctx, msg)
+}
+
+func (l *ReplyLikeNumLogic) updateReplyLikeNum(ctx context.Context, msg *types.CanalLikeMsg) error {
+	if len(msg.Data) == 0 {
+		return nil
+	}
+
+	for _, d := range msg.Data {
+		bizId, err := strconv.ParseInt(d.BizID, 10, 64)
+		if err != nil {
+			logx.Errorf("strconv.ParseInt bizid: %s error: %v", d.BizID, err)
+			continue
+		}
+		// 只处理评论的点赞和点踩，文章的点赞由article-mq处理
+		if bizId != types.BizReply && bizId != types.BizReplyDislike {
+			continue
+		}
+		id, err := strconv.ParseInt(d.ObjID, 10, 64)
+		if err != nil {
+			logx.Errorf("strconv.ParseInt id: %s error: %v", d.ObjID, err)
+			continue
+		}
+		num, err := strconv.ParseInt(d.LikeNum, 10, 64)
+		if err != nil {
+			logx.Errorf("strconv.ParseInt likeNum: %s error: %v", d.LikeNum, err)
+			continue
+		}
+
+		// 热度分需要同时用到点赞数和点踩数，在更新这一个数的事务里用更新后的评论记录计算
+		reply, err := l.svcCtx.ReplyModel.UpdateVoteNum(ctx, id, bizId == types.BizReplyDislike, num, wilsonScore)
+		if err != nil {
+			logx.Errorf("UpdateVoteNum id: %d bizId: %d num: %d error: %v", id, bizId, num, err)
+			continue
+		}
+
+		// 已删除的评论不需要再放回缓存
+		if reply.Status != types.ReplyStatusOk {
+			continue
+		}
+		if bizId == types.BizReply {
+			l.rescoreReply(ctx, reply, types.SortLikeCount, reply.LikeNum)
+		}
+		l.rescoreReply(ctx, reply, types.SortHot, reply.HotScore)
+	}
+
+	return nil
+}
+
+// rescoreReply 更新评论在按点赞数或热度排序的zset中的分数，一级评论在文章的一级评论列表中，二级评论在所属一级评论的二级评论列表中
+func (l *ReplyLikeNumLogic) rescoreReply(ctx context.Context, reply *model.Reply, sortType int, score int64) {
+	var key string
+	if reply.ParentId == 0 {
+		key = fmt.Sprintf(prefixFirstReplies, reply.TargetId, sortType)
+	} else {
+		key = fmt.Sprintf(prefixSecondReplies, reply.ParentId, sortType)
+	}
+	// 只有缓存存在时才更新，评论的分数变低后排到了不完整的缓存尾部之后时会从缓存中去掉
+	err := l.svcCtx.RepliesCache.Add(ctx, key, zsetcache.Item{Id: reply.Id, Score: score})
+	if err != nil {
+		logx.Errorf("rescoreReply key: %s id: %d error: %v", key, reply.Id, err)
+	}
+}
+
+// wilsonScore 计算点赞率的威尔逊置信区间下界（95%置信度），票数少时得分会被压低，
+// 避免只有一两个赞的新评论排在前面，也不会像直接按点赞数那样偏向老评论。
+// zset的score用的是int64，所以放大hotScoreScale倍后取整。
+func wilsonScore(up, down int64) int64 {
+	if up < 0 {
+		up = 0
+	}
+	if down < 0 {
+		down = 0
+	}
+	n := float64(up + down)
+	if n == 0 {
+		return 0
+	}
+	const z = 1.96
+	p := float64(up) / n
+	lower := (p + z*z/(2*n) - z*math.Sqrt(p*(1-p)/n+z*z/(4*n*n))) / (1 + z*z/n)
+	return int64(lower * hotScoreScale)
+}
+
+func Consumers(ctx context.Context, svcCtx *svc.ServiceContext) []service.Service {
+	return []service.Service{
+		kq.MustNewQueue(svcCtx.Config.LikeKqConsumerConf, NewReplyLikeNumLogic(ctx, svcCtx)),
+	}
+}
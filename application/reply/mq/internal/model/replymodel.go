@@ -0,0 +1,65 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ReplyModel = (*customReplyModel)(nil)
+
+type (
+	// ReplyModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customReplyModel.
+	ReplyModel interface {
+		replyModel
+		UpdateVoteNum(ctx context.Context, id int64, dislike bool, num int64, hotScore func(likeNum, dislikeNum int64) int64) (*Reply, error)
+	}
+
+	customReplyModel struct {
+		*defaultReplyModel
+	}
+)
+
+// NewReplyModel returns a model for the database table.
+func NewReplyModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ReplyModel {
+	return &customReplyModel{
+		defaultReplyModel: newReplyModel(conn, c, opts...),
+	}
+}
+
+// UpdateVoteNum 在同一个事务里只更新点赞数或点踩数中的一个，再用更新后的行计算热度分，返回更新后的评论。
+// 点赞和点踩的消息并发处理时，后更新的事务要等先更新的提交，热度分总是用两个最新的数计算。
+// 提交后删除reply-rpc中缓存的评论行记录
+func (m *customReplyModel) UpdateVoteNum(ctx context.Context, id int64, dislike bool, num int64, hotScore func(likeNum, dislikeNum int64) int64) (*Reply, error) {
+	column := "like_num"
+	if dislike {
+		column = "dislike_num"
+	}
+	var reply Reply
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		// 更新后这一行一直加着锁，直到事务提交
+		query := fmt.Sprintf("update %s set %s = ? where `id` = ?", m.table, column)
+		if _, err := session.ExecCtx(ctx, query, num, id); err != nil {
+			return err
+		}
+		query = fmt.Sprintf("select %s from %s where `id` = ?", replyRows, m.table)
+		if err := session.QueryRowCtx(ctx, &reply, query, id); err != nil {
+			return err
+		}
+		reply.HotScore = hotScore(reply.LikeNum, reply.DislikeNum)
+		query = fmt.Sprintf("update %s set hot_score = ? where `id` = ?", m.table)
+		_, err := session.ExecCtx(ctx, query, reply.HotScore, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = m.DelCacheCtx(ctx, fmt.Sprintf("%s%v", cachePostaReplyReplyIdPrefix, id)); err != nil {
+		logx.WithContext(ctx).Errorf("UpdateVoteNum DelCacheCtx id: %d error: %v", id, err)
+	}
+	return &reply, nil
+}
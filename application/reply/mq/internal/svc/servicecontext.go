@@ -0,0 +1,41 @@
+package svc
+
+import (
+	"posta/application/reply/mq/internal/config"
+	"posta/application/reply/mq/internal/model"
+	"posta/application/reply/mq/internal/types"
+	"posta/pkg/zsetcache"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type ServiceContext struct {
+	Config     config.Config
+	ReplyModel model.ReplyModel
+	BizRedis   *redis.Redis
+	// RepliesCache 按点赞数和热度排序的评论列表缓存，由reply-rpc回源写入，这里只更新已经存在的缓存
+	RepliesCache *zsetcache.Cache
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	rds, err := redis.NewRedis(redis.RedisConf{
+		Host: c.BizRedis.Host,
+		Pass: c.BizRedis.Pass,
+		Type: c.BizRedis.Type,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return &ServiceContext{
+		Config:     c,
+		ReplyModel: model.NewReplyModel(sqlx.NewMysql(c.Datasource), c.CacheRedis),
+		BizRedis:   rds,
+		RepliesCache: zsetcache.New(rds, zsetcache.Conf{
+			Order:   zsetcache.Desc,
+			MaxSize: types.RepliesCacheMaxSize,
+			Expire:  types.RepliesCacheExpire,
+		}),
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+// 和like-rpc中的业务ID保持一致
+const (
+	BizArticle = iota
+	BizReply
+	BizReplyDislike
+)
+
+// 和reply-rpc中的排序类型保持一致
+const (
+	SortPublishTime = iota
+	SortLikeCount
+	SortHot
+)
+
+const (
+	ReplyStatusOk = iota
+	ReplyStatusDelete
+)
+
+// 评论列表缓存的配置，和reply-rpc中的定义保持一致
+const (
+	RepliesCacheMaxSize = 200
+	RepliesCacheExpire  = 3600 * 24 * 2
+)
@@ -0,0 +1,14 @@
+package types
+
+// CanalLikeMsg canal解析like_count binlog消息.
+type CanalLikeMsg struct {
+	Data []struct {
+		// 这里全都是string类型，因为canal发送出来的就是string类型
+		ID         string `json:"id"`
+		BizID      string `json:"biz_id"`
+		ObjID      string `json:"obj_id"`
+		LikeNum    string `json:"like_num"`
+		CreateTime string `json:"create_time"`
+		UpdateTime string `json:"update_time"`
+	} `json:"data"`
+}
@@ -10,4 +10,10 @@ var (
 	ReplyIdInvalid    = xcode.New(700003, "评论ID无效")
 	SortTypeInvalid   = xcode.New(700004, "评论排序类型无效")
 	ArticleIdInvalid  = xcode.New(700005, "文章ID无效")
+	ArticleNotExist   = xcode.New(700006, "文章不存在")
+	NotArticleAuthor  = xcode.New(700007, "只有文章作者才能操作")
+	ReplyNotExist     = xcode.New(700008, "评论不存在")
+	CannotPinSubReply = xcode.New(700009, "只能置顶一级评论")
+	ReplyBlocked      = xcode.New(700010, "对方已将你拉黑，不能评论")
+	PageTokenInvalid  = xcode.New(700011, "分页游标无效")
 )
@@ -13,4 +13,9 @@ type Config struct {
 	CacheRedis cache.CacheConf
 	BizRedis   redis.RedisConf
 	Consul     consul.Conf
+	ArticleRPC zrpc.RpcClientConf
+	UserRPC    zrpc.RpcClientConf
+	FollowRPC  zrpc.RpcClientConf
+	// CursorSecret 分页游标的签名密钥
+	CursorSecret string
 }
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"posta/application/reply/rpc/internal/code"
+	"posta/application/reply/rpc/internal/model"
+	"posta/application/reply/rpc/internal/svc"
+	"posta/application/reply/rpc/internal/types"
+	"posta/application/reply/rpc/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AuthorLikeReplyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAuthorLikeReplyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AuthorLikeReplyLogic {
+	return &AuthorLikeReplyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 文章作者给评论点赞或取消点赞，评论会展示"作者赞过"
+func (l *AuthorLikeReplyLogic) AuthorLikeReply(in *service.AuthorLikeReplyRequest) (*service.AuthorLikeReplyResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.ReplyId <= 0 {
+		return nil, code.ReplyIdInvalid
+	}
+
+	reply, err := l.svcCtx.ReplyModel.FindOne(l.ctx, in.ReplyId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, code.ReplyNotExist
+		}
+		l.Logger.Errorf("AuthorLikeReply FindOne req: %v error: %v", in, err)
+		return nil, err
+	}
+	if reply.Status != types.ReplyStatusOk {
+		return nil, code.ReplyNotExist
+	}
+	if err = checkArticleAuthor(l.ctx, l.svcCtx, reply.TargetId, in.UserId); err != nil {
+		return nil, err
+	}
+
+	var authorLiked int64
+	if in.Action == 0 {
+		authorLiked = 1
+	}
+	err = l.svcCtx.ReplyModel.UpdateAuthorLiked(l.ctx, in.ReplyId, authorLiked)
+	if err != nil {
+		l.Logger.Errorf("UpdateAuthorLiked req: %v error: %v", in, err)
+		return nil, err
+	}
+
+	return &service.AuthorLikeReplyResponse{}, nil
+}
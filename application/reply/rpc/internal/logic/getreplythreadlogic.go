@@ -2,8 +2,10 @@ package logic
 
 import (
 	"context"
+	"fmt"
 	"posta/application/reply/rpc/internal/code"
 	"posta/application/reply/rpc/internal/types"
+	"posta/pkg/cursor"
 	"time"
 
 	"posta/application/reply/rpc/internal/svc"
@@ -36,18 +38,24 @@ func (l *GetReplyThreadLogic) GetReplyThread(in *service.GetReplyThreadRequest)
 		return nil, code.ReplyIdInvalid
 	}
 
-	if in.PageSize == 0 {
+	if in.PageSize <= 0 {
 		in.PageSize = types.DefaultPageSize
 	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
 
-	if in.Cursor == 0 {
-		in.Cursor = 1
+	scope := fmt.Sprintf("replyThread#%d", in.RootReplyId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	if pageCursor.Sort == 0 {
+		pageCursor.Sort = 1
 	}
 
-	sortPublishTime := time.Unix(in.Cursor, 0).Format("2006-01-02 15:04:05")
+	sortPublishTime := time.Unix(pageCursor.Sort, 0).Format("2006-01-02 15:04:05")
 	replies, err := l.svcCtx.ReplyModel.RepliesByRootReplyId(l.ctx, in.RootReplyId, sortPublishTime, types.DefaultLimit)
 	if err != nil {
-		logx.Errorf("RepliesByRootReplyId %d error: %v", in.GetRootReplyId, err)
+		logx.Errorf("RepliesByRootReplyId %d error: %v", in.GetRootReplyId(), err)
 		return nil, err
 	}
 	if replies == nil {
@@ -55,9 +63,9 @@ func (l *GetReplyThreadLogic) GetReplyThread(in *service.GetReplyThreadRequest)
 	}
 
 	var (
-		isEnd          bool
-		cursor, lastId int64
-		curPage        []*service.ReplyItem
+		isEnd            bool
+		nextSort, lastId int64
+		curPage          []*service.ReplyItem
 	)
 	for _, reply := range replies {
 		curPage = append(curPage, &service.ReplyItem{
@@ -67,29 +75,35 @@ func (l *GetReplyThreadLogic) GetReplyThread(in *service.GetReplyThreadRequest)
 			ParentId:      reply.ParentId,
 			Content:       reply.Content,
 			LikeCount:     reply.LikeNum,
+			DislikeCount:  reply.DislikeNum,
+			HotScore:      reply.HotScore,
+			AuthorLiked:   reply.AuthorLiked == 1,
 			CreateTime:    reply.CreateTime.Unix(),
 		})
 	}
 
+	fillMentions(l.ctx, l.svcCtx, curPage)
+
 	if len(replies) < int(in.PageSize) {
 		isEnd = true
 	}
 
 	if len(curPage) > 0 {
 		pageLast := curPage[len(curPage)-1]
-		cursor = pageLast.CreateTime
+		nextSort = pageLast.CreateTime
 		lastId = pageLast.Id
-		if cursor < 0 {
-			cursor = 0
+		if nextSort < 0 {
+			nextSort = 0
 		}
 	}
 
 	ret := &service.GetReplyThreadResponse{
 		IsEnd:   isEnd,
-		Cursor:  cursor,
-		ReplyId: lastId,
 		Replies: curPage,
 	}
+	if lastId != 0 {
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: nextSort, Id: lastId})
+	}
 
 	return ret, nil
 }
@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"posta/application/article/rpc/article"
+	"posta/application/reply/rpc/internal/code"
+	"posta/application/reply/rpc/internal/model"
+	"posta/application/reply/rpc/internal/svc"
+	"posta/application/reply/rpc/internal/types"
+	"posta/application/reply/rpc/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PinReplyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPinReplyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PinReplyLogic {
+	return &PinReplyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 文章作者置顶一条一级评论，已有置顶时替换
+func (l *PinReplyLogic) PinReply(in *service.PinReplyRequest) (*service.PinReplyResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.TargetId <= 0 {
+		return nil, code.ArticleIdInvalid
+	}
+	if in.ReplyId <= 0 {
+		return nil, code.ReplyIdInvalid
+	}
+	if err := checkArticleAuthor(l.ctx, l.svcCtx, in.TargetId, in.UserId); err != nil {
+		return nil, err
+	}
+
+	reply, err := l.svcCtx.ReplyModel.FindOne(l.ctx, in.ReplyId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, code.ReplyNotExist
+		}
+		l.Logger.Errorf("PinReply FindOne req: %v error: %v", in, err)
+		return nil, err
+	}
+	if reply.TargetId != in.TargetId || reply.Status != types.ReplyStatusOk {
+		return nil, code.ReplyNotExist
+	}
+	if reply.ParentId != 0 {
+		return nil, code.CannotPinSubReply
+	}
+
+	err = l.svcCtx.ReplyPinModel.Upsert(l.ctx, types.ReplyBizArticle, in.TargetId, in.ReplyId)
+	if err != nil {
+		l.Logger.Errorf("ReplyPinModel.Upsert req: %v error: %v", in, err)
+		return nil, err
+	}
+
+	return &service.PinReplyResponse{}, nil
+}
+
+// checkArticleAuthor 通过article-rpc查询文章详情，校验用户是不是文章作者
+func checkArticleAuthor(ctx context.Context, svcCtx *svc.ServiceContext, articleId, userId int64) error {
+	detail, err := svcCtx.ArticleRPC.ArticleDetail(ctx, &article.ArticleDetailRequest{ArticleId: articleId})
+	if err != nil {
+		logx.Errorf("ArticleRPC.ArticleDetail articleId: %d error: %v", articleId, err)
+		return err
+	}
+	if detail.Article == nil {
+		return code.ArticleNotExist
+	}
+	if detail.Article.AuthorId != userId {
+		return code.NotArticleAuthor
+	}
+	return nil
+}
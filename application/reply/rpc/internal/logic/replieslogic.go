@@ -1,18 +1,20 @@
 package logic
 
 import (
-	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/zeromicro/go-zero/core/mr"
-	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/threading"
-	"math"
+	"posta/application/follow/rpc/follow"
 	"posta/application/reply/rpc/internal/code"
 	"posta/application/reply/rpc/internal/model"
 	"posta/application/reply/rpc/internal/types"
-	"slices"
-	"strconv"
+	"posta/application/user/rpc/user"
+	"posta/pkg/cursor"
+	"posta/pkg/mention"
+	"posta/pkg/zsetcache"
+	"strings"
 	"time"
 
 	"posta/application/reply/rpc/internal/svc"
@@ -21,11 +23,10 @@ import (
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// 缓存改用zsetcache的格式后换了key，旧格式的缓存不再读取
 const (
-	prefixFirstReplies  = "biz#firstReplies#%d#%d"
-	prefixSecondReplies = "biz#secondReplies#%d#%d"
-	// 两天的时间
-	repliesExpire = 3600 * 24 * 2
+	prefixFirstReplies  = "biz#firstReplies#v2#%d#%d"
+	prefixSecondReplies = "biz#secondReplies#v2#%d#%d"
 )
 
 type RepliesLogic struct {
@@ -44,285 +45,199 @@ func NewRepliesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RepliesLo
 
 // 可以查看文章的一级评论，也可以查看一级评论下的二级评论
 func (l *RepliesLogic) Replies(in *service.RepliesRequest) (*service.RepliesResponse, error) {
-	if in.SortType != types.SortPublishTime && in.SortType != types.SortLikeCount {
+	if in.SortType != types.SortPublishTime && in.SortType != types.SortLikeCount && in.SortType != types.SortHot {
 		return nil, code.SortTypeInvalid
 	}
 	if in.TargetId <= 0 {
 		return nil, code.ArticleIdInvalid
 	}
-	if in.PageSize == 0 {
+	if in.PageSize <= 0 {
 		in.PageSize = types.DefaultPageSize
 	}
-	if in.Cursor == 0 {
-		if in.SortType == types.SortPublishTime {
-			if in.ParentId == 0 {
-				// 一级评论按时间排序是从新到旧，相当于开启一个新的话题
-				in.Cursor = time.Now().Unix()
-			} else {
-				// 二级评论按时间排序是从旧到新，相当于看讨论过程
-				in.Cursor = 1
-			}
-		} else {
-			in.Cursor = types.DefaultSortLikeCursor
-		}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+	scope := repliesCursorScope(in.TargetId, in.ParentId, in.SortType)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
 	}
-
-	var (
-		sortField       string
-		sortLikeNum     int64
-		sortPublishTime string
-	)
-
-	if in.SortType == types.SortLikeCount {
-		sortField = "like_num"
-		sortLikeNum = in.Cursor
-	} else {
-		sortField = "create_time"
-		// 将时间从int64的cursor转化为string形式
-		sortPublishTime = time.Unix(in.Cursor, 0).Format("2006-01-02 15:04:05")
+	// Sort是上一页最后一条评论的发布时间、点赞数或热度分，Id是这条评论的id，第一页时为空
+	isFirstPage := pageCursor.Id == 0
+	var after *zsetcache.Item
+	if !isFirstPage {
+		after = &zsetcache.Item{Id: pageCursor.Id, Score: pageCursor.Sort}
 	}
 
 	var (
-		err            error
-		isCache, isEnd bool
-		lastId, cursor int64
-		// []*T 表示 “T 类型的指针组成的切片”，切片可以理解为动态数组
-		curPage []*service.ReplyItem
+		isEnd bool
 		// 这是数据库的一条行记录的指针
 		replies []*model.Reply
+		// 下一页从这一页最后一条之后开始。缓存命中时用缓存中的score，和数据库中最新的点赞数、热度分可能不一样
+		last *zsetcache.Item
 	)
-
-	replyIds, _ := l.cacheReplies(l.ctx, in.TargetId, in.ParentId, in.Cursor, in.PageSize, in.SortType)
-
-	// 这里的in.ReplyId != replyIds[0]是为了防止缓存中本来有数据
-	if len(replyIds) > 0 && in.ReplyId != replyIds[0] {
-		isCache = true
-		if replyIds[len(replyIds)-1] == -1 || replyIds[len(replyIds)-1] == math.MaxInt64 {
-			if in.SortType == types.SortLikeCount || in.ParentId == 0 {
-				isEnd = true
-			} else {
-				// 如果是查看一级评论下的二级评论，那么必须保证redis的zset中-1对应的score为replyIds[len(replyIds)-2])对应的score才意味着读取完了。
-				// 注意：理论上应该再对比一下id的，毕竟两条评论的createtime可能相同。
-				publishTimeKey := secondRepliesKey(in.ParentId, types.SortPublishTime)
-
-				// mysqlMax表示mysql存储最大score
-				mysqlMax, _ := l.svcCtx.BizRedis.ZscoreCtx(l.ctx, publishTimeKey, strconv.Itoa(int(replyIds[len(replyIds)-1])))
-				// redisMax表示redis的zset中存储的member不为MaxInt64的最大score
-				redisMax, _ := l.svcCtx.BizRedis.ZscoreCtx(l.ctx, publishTimeKey, strconv.Itoa(int(replyIds[len(replyIds)-2])))
-				if len(replyIds) > 2 && redisMax == mysqlMax {
-					isEnd = true
-				}
-			}
+	cache, key := repliesCache(l.svcCtx, in.TargetId, in.ParentId, in.SortType)
+	page, err := cache.Read(l.ctx, key, after, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("RepliesCache.Read key: %s error: %v", key, err)
+	}
+	if page != nil {
+		replyIds := make([]int64, 0, len(page.Items))
+		for _, item := range page.Items {
+			replyIds = append(replyIds, item.Id)
 		}
-
-		// goroutine加速查询，但返回的replies是无序的，
+		// goroutine加速查询，返回的replies和replyIds的顺序一致
 		replies, err = l.replyByIds(l.ctx, replyIds)
 		if err != nil {
 			return nil, err
 		}
-
-		// 再进行排序
-		var cmpFunc func(a, b *model.Reply) int
-		if sortField == "like_num" {
-			cmpFunc = func(a, b *model.Reply) int {
-				// 大的在前，表示降序（点赞从高到低）
-				return cmp.Compare(b.LikeNum, a.LikeNum)
-			}
-		} else if in.ParentId == 0 {
-			cmpFunc = func(a, b *model.Reply) int {
-				return cmp.Compare(b.CreateTime.Unix(), a.CreateTime.Unix())
-			}
-		} else {
-			cmpFunc = func(a, b *model.Reply) int {
-				return cmp.Compare(a.CreateTime.Unix(), b.CreateTime.Unix())
-			}
-		}
-		slices.SortFunc(replies, cmpFunc)
-
-		for _, reply := range replies {
-			curPage = append(curPage, &service.ReplyItem{
-				Id:            reply.Id,
-				ReplyUserId:   reply.ReplyUserId,
-				BeReplyUserId: reply.BeReplyUserId,
-				ParentId:      reply.ParentId,
-				Content:       reply.Content,
-				LikeCount:     reply.LikeNum,
-				CreateTime:    reply.CreateTime.Unix(),
-			})
+		isEnd = page.IsEnd
+		if len(page.Items) > 0 {
+			last = &page.Items[len(page.Items)-1]
 		}
 	} else {
-		var (
-			v   interface{}
-			err error
-		)
-		// 如果是根据文章id查一级评论。
-		if in.ParentId == 0 {
-			// SingleFlight（请求合并）机制，避免同一个 key 被并发重复请求数据库，只执行一次，其他请求等待复用结果。
-			// 但是SingleFlight控制范围为单个进程内，多个goroutine并发请求。
-			// key：fmt.Sprintf("ArticlesBuUserId:%d:%d", in.TargetId, in.SortType)
-			// 函数体：要被合并执行的函数逻辑
-			v, err, _ = l.svcCtx.SingleFlightGroup.Do(fmt.Sprintf("FirstRepliesByArticleId:%d:%d", in.TargetId, in.SortType), func() (interface{}, error) {
-				// 这里DefaultLimit设置得比较大为200，可以提前加载进缓存
-				return l.svcCtx.ReplyModel.FirstRepliesByArticleId(l.ctx, in.TargetId, sortLikeNum, sortPublishTime, sortField, types.DefaultLimit)
-			})
-		} else {
-			v, err, _ = l.svcCtx.SingleFlightGroup.Do(fmt.Sprintf("SecondRepliesByFristReplyId:%d:%d", in.ParentId, in.SortType), func() (interface{}, error) {
-				return l.svcCtx.ReplyModel.SecondRepliesByFirstReplyId(l.ctx, in.ParentId, sortLikeNum, sortPublishTime, sortField, types.DefaultLimit)
-			})
-		}
-
+		replies, isEnd, err = l.repliesFromDB(in, after)
 		if err != nil {
-			logx.Errorf("RepliesByArticleId error: %d sortField: %s error: %v", in.TargetId, sortField, err)
 			return nil, err
 		}
-		if v == nil {
-			return &service.RepliesResponse{}, nil
-		}
-		replies = v.([]*model.Reply)
-		var firstPageReplies []*model.Reply
-		if len(replies) > int(in.PageSize) {
-			firstPageReplies = replies[:int(in.PageSize)]
-		} else {
-			firstPageReplies = replies
-			isEnd = true
-		}
-
-		// 这里虽然给BeReplyUserId赋值了，但前端保证BeReplyUserId为0时不显示就可以了。
-		for _, reply := range firstPageReplies {
-			curPage = append(curPage, &service.ReplyItem{
-				Id:            reply.Id,
-				ReplyUserId:   reply.ReplyUserId,
-				BeReplyUserId: reply.BeReplyUserId,
-				ParentId:      reply.ParentId,
-				Content:       reply.Content,
-				LikeCount:     reply.LikeNum,
-				CreateTime:    reply.CreateTime.Unix(),
-			})
+		if len(replies) > 0 {
+			reply := replies[len(replies)-1]
+			last = &zsetcache.Item{Id: reply.Id, Score: replyScore(reply, in.SortType)}
 		}
 	}
 
-	// 更新cursor和lastId
-	if len(curPage) > 0 {
-		pageLast := curPage[len(curPage)-1]
-		lastId = pageLast.Id
-		if in.SortType == types.SortPublishTime {
-			cursor = pageLast.CreateTime
-		} else {
-			cursor = pageLast.LikeCount
-		}
-		if cursor < 0 {
-			cursor = 0
-		}
+	// []*T 表示 “T 类型的指针组成的切片”，切片可以理解为动态数组
+	// 这里虽然给BeReplyUserId赋值了，但前端保证BeReplyUserId为0时不显示就可以了。
+	curPage := make([]*service.ReplyItem, 0, len(replies))
+	for _, reply := range replies {
+		curPage = append(curPage, &service.ReplyItem{
+			Id:            reply.Id,
+			ReplyUserId:   reply.ReplyUserId,
+			BeReplyUserId: reply.BeReplyUserId,
+			ParentId:      reply.ParentId,
+			Content:       reply.Content,
+			LikeCount:     reply.LikeNum,
+			DislikeCount:  reply.DislikeNum,
+			HotScore:      reply.HotScore,
+			AuthorLiked:   reply.AuthorLiked == 1,
+			CreateTime:    reply.CreateTime.Unix(),
+		})
+	}
 
-		for k, reply := range curPage {
-			if in.SortType == types.SortPublishTime {
-				// 遍历 curPage 找到与上一页最后一条 相同 Cursor + 相同 Id 的那条评论；
-				// 从它的下标 k 开始往后截取 curPage，丢弃它之前的内容（包括它本身）；
-				// 用 curPage[k:] 作为新的结果，避免重复展示这条评论。
-				if reply.CreateTime == in.Cursor && reply.Id == in.ReplyId {
-					curPage = curPage[k:]
-					break
-				}
-			} else {
-				if reply.LikeCount == in.Cursor && reply.Id == in.ReplyId {
-				}
-				curPage = curPage[k:]
-				break
-			}
-		}
+	// 注意：游标在置顶和过滤之前计算，被过滤的评论只是不展示，不影响翻页
+	var nextPageToken string
+	if last != nil {
+		nextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: last.Score, Id: last.Id})
 	}
 
-	ret := &service.RepliesResponse{
-		IsEnd:   isEnd,
-		Cursor:  cursor,
-		ReplyId: lastId,
-		Replies: curPage,
+	// 置顶评论不管按什么排序都放在第一页的最前面，其他页中不再出现
+	if in.ParentId == 0 {
+		curPage = l.pinReply(in.TargetId, curPage, isFirstPage)
 	}
 
-	if !isCache {
-		// 异步写缓存
-		threading.GoSafe(func() {
-			ctx := context.Background() // Debug: 使用新上下文，避免主流程已退出
-			var err error
-
-			// 对于一级评论按时间排序
-			if len(replies) < types.DefaultLimit && len(replies) > 0 {
-				// 注意：这里加入redis中的是zset的东西，只是id而已。省去了排序过程，但是没有将整个查到的数据都放进redis中，避免redis数据量太大了。
-				// 之前考虑到可能存在“假尾”问题，但是这里的逻辑是len(replies) < types.DefaultLimit时才会在redis中缓存-1。
-				// 如果大于的话，那就仅仅是将这些数据加入redis，并不设置结束符号，所以没问题。
-				if in.SortType == types.SortLikeCount || in.ParentId == 0 {
-					replies = append(replies, &model.Reply{Id: -1})
-				}
-			}
+	blockedIds := l.blockedIds(in.UserId)
+	curPage = filterBlocked(curPage, blockedIds)
 
-			// 对于二级评论按时间排序
-			if in.SortType == types.SortPublishTime && in.ParentId != 0 {
-				maxTime, err := l.svcCtx.ReplyModel.MaxCreateTimeByParentId(ctx, in.ParentId)
-				if err != nil {
-					logx.Errorf("MaxCreateTimeByParentId for parentId %d error: %v", in.ParentId, err)
-				} else {
-					replies = append(replies, &model.Reply{Id: math.MaxInt64, CreateTime: maxTime})
-				}
-			}
+	fillMentions(l.ctx, l.svcCtx, curPage)
 
-			err = l.addCacheReplies(ctx, replies, in.TargetId, in.ParentId, in.SortType)
-			if err != nil {
-				logx.Errorf("addCacheReplies error: %v", err)
-			}
-		})
+	// 一级评论需要展示下面有多少条回复
+	if in.ParentId == 0 {
+		l.fillSubReplyCount(curPage)
+		if in.PreviewSize > 0 {
+			l.fillPreviewReplies(in, curPage, blockedIds)
+		}
 	}
 
-	return ret, nil
+	return &service.RepliesResponse{
+		IsEnd:         isEnd,
+		Replies:       curPage,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
-// 从 Redis 缓存中，获取某个文章的一级评论ID列表（按某种排序方式）或者一级评论的二级ID列表，用于分页展示。
-func (l *RepliesLogic) cacheReplies(ctx context.Context, articleid, parentid, cursor, ps int64, sortType int32) ([]int64, error) {
-	var key string
-	if parentid == 0 {
-		key = firstRepliesKey(articleid, sortType)
-	} else {
-		key = secondRepliesKey(articleid, sortType)
+// firstPageReplies 回源查询的第一页和查询前的缓存版本号
+type firstPageReplies struct {
+	replies []*model.Reply
+	version int64
+	canFill bool
+}
+
+// repliesFromDB 缓存没有命中时查数据库。第一页多查DefaultLimit条用于写缓存，之后的页只查一页，
+// 不能用中间的一段写缓存，否则缓存中的列表前面会缺一段
+func (l *RepliesLogic) repliesFromDB(in *service.RepliesRequest, after *zsetcache.Item) ([]*model.Reply, bool, error) {
+	sortField := "create_time"
+	if in.SortType == types.SortLikeCount {
+		sortField = "like_num"
+	} else if in.SortType == types.SortHot {
+		sortField = "hot_score"
 	}
-	b, err := l.svcCtx.BizRedis.ExistsCtx(ctx, key)
-	if err != nil {
-		logx.Errorf("ExistsCtx key: %s error: %v", key, err)
+	queryReplies := func(sortValue any, lastId int64, limit int) ([]*model.Reply, error) {
+		// 如果是根据文章id查一级评论。
+		if in.ParentId == 0 {
+			return l.svcCtx.ReplyModel.FirstRepliesByArticleId(l.ctx, in.TargetId, sortField, sortValue, lastId, limit)
+		}
+		return l.svcCtx.ReplyModel.SecondRepliesByFirstReplyId(l.ctx, in.ParentId, sortField, sortValue, lastId, limit)
 	}
 
-	// 注意：更新缓存缓存过期时间，防止缓存击穿。
-	if b {
-		err = l.svcCtx.BizRedis.ExpireCtx(ctx, key, repliesExpire)
+	if after != nil {
+		var sortValue any = after.Score
+		if in.SortType == types.SortPublishTime {
+			// 将时间从int64的cursor转化为string形式
+			sortValue = time.Unix(after.Score, 0).Format("2006-01-02 15:04:05")
+		}
+		// 多查一条用于判断是否还有下一页
+		replies, err := queryReplies(sortValue, after.Id, int(in.PageSize)+1)
 		if err != nil {
-			logx.Errorf("ExpireCtx key: %s error: %v", key, err)
+			logx.Errorf("RepliesByArticleId error: %d sortField: %s error: %v", in.TargetId, sortField, err)
+			return nil, false, err
 		}
+		if len(replies) > int(in.PageSize) {
+			return replies[:in.PageSize], false, nil
+		}
+		return replies, true, nil
 	}
 
-	// 按分数从大到小（ZREVRANGEBYSCORE）取分数在 [0, cursor] 范围内的元素
-	// 返回元素和它的分数
-	// 只返回分页内的元素（偏移量 0，数量 ps）
-	var pairs []redis.Pair
-	if parentid != 0 && sortType == types.SortPublishTime {
-		// 一级评论下的二级评论，按时间排序，应该是时间越旧分数越低。所以这里应该是从低往高读。
-		pairs, err = l.svcCtx.BizRedis.ZrangebyscoreWithScoresAndLimitCtx(ctx, key, cursor, math.MaxInt64, 0, int(ps))
-	} else {
-		pairs, err = l.svcCtx.BizRedis.ZrevrangebyscoreWithScoresAndLimitCtx(ctx, key, 0, cursor, 0, int(ps))
-	}
+	// SingleFlight（请求合并）机制，避免同一个 key 被并发重复请求数据库，只执行一次，其他请求等待复用结果。
+	// 但是SingleFlight控制范围为单个进程内，多个goroutine并发请求。
+	// 合并的请求共用同一次查询的结果，版本号也要在这次查询之前取
+	cache, key := repliesCache(l.svcCtx, in.TargetId, in.ParentId, in.SortType)
+	v, err, _ := l.svcCtx.SingleFlightGroup.Do(fmt.Sprintf("RepliesFromDB:%d:%d:%d", in.TargetId, in.ParentId, in.SortType), func() (interface{}, error) {
+		version, verErr := cache.Version(l.ctx, key)
+		if verErr != nil {
+			logx.Errorf("RepliesCache.Version key: %s error: %v", key, verErr)
+		}
+		// 这里DefaultLimit设置得比较大为200，可以提前加载进缓存
+		replies, err := queryReplies(nil, 0, types.DefaultLimit)
+		if err != nil {
+			return nil, err
+		}
+		return &firstPageReplies{replies: replies, version: version, canFill: verErr == nil}, nil
+	})
 	if err != nil {
-		logx.Errorf("ZrevrangebyscoreWithScoresAndLimit key: %s error: %v", key, err)
-		return nil, err
+		logx.Errorf("RepliesByArticleId error: %d sortField: %s error: %v", in.TargetId, sortField, err)
+		return nil, false, err
 	}
+	firstPage := v.(*firstPageReplies)
+	replies := firstPage.replies
 
-	var ids []int64
-	for _, pair := range pairs {
-		// redis的key只能为字符串，需要转化为int64
-		id, err := strconv.ParseInt(pair.Key, 10, 64)
-		if err != nil {
-			logx.Errorf("strconv.ParseInt key: %s error: %v", pair.Key, err)
-			return nil, err
+	if firstPage.canFill {
+		// 注意：这里加入redis中的是zset的东西，只是id而已。省去了排序过程，但是没有将整个查到的数据都放进redis中，避免redis数据量太大了。
+		items := make([]zsetcache.Item, 0, len(replies))
+		for _, reply := range replies {
+			items = append(items, zsetcache.Item{Id: reply.Id, Score: replyScore(reply, in.SortType)})
 		}
-		ids = append(ids, id)
+		// 异步写缓存
+		threading.GoSafe(func() {
+			// 不到DefaultLimit条说明已经查到了全部评论，缓存中会带上结束标记
+			err := cache.Fill(context.Background(), key, firstPage.version, items, len(replies) < types.DefaultLimit)
+			if err != nil {
+				logx.Errorf("RepliesCache.Fill key: %s error: %v", key, err)
+			}
+		})
 	}
 
-	return ids, nil
+	if len(replies) > int(in.PageSize) {
+		return replies[:in.PageSize], false, nil
+	}
+	return replies, true, nil
 }
 
 // 接收一个 articleIds 的整数 ID 列表，然后并发查询每一个文章详情，最后组合成列表返回。
@@ -344,13 +259,10 @@ func (l *RepliesLogic) replyByIds(ctx context.Context, replyIds []int64) ([]*mod
 	// chan int64：一个可以发送和接收int64 的通道。
 	// chan<- int64：一个只能发送int64 的通道（写通道）。
 	// <-chan int64：一个只能接收int64 的通道（读通道）。
-	replies, err := mr.MapReduce[int64, *model.Reply, []*model.Reply](func(source chan<- int64) {
+	replies, err := mr.MapReduce[int64, *model.Reply, map[int64]*model.Reply](func(source chan<- int64) {
 		// 把每个 replyId 发到 source channel，作为每个 Map 的输入。
 		// 第一次出现，用:=。声明并赋值。
 		for _, rid := range replyIds {
-			if rid == -1 || rid == math.MaxInt64 {
-				continue
-			}
 			source <- rid
 		}
 	}, func(id int64, writer mr.Writer[*model.Reply], cancel func(error)) {
@@ -364,13 +276,12 @@ func (l *RepliesLogic) replyByIds(ctx context.Context, replyIds []int64) ([]*mod
 			return
 		}
 		writer.Write(p)
-	}, func(pipe <-chan *model.Reply, writer mr.Writer[[]*model.Reply], cancel func(error)) {
+	}, func(pipe <-chan *model.Reply, writer mr.Writer[map[int64]*model.Reply], cancel func(error)) {
 		// 从 Map 阶段输出的所有评论中读取
-		// 把它们合并成一个 []*model.Reply 切片
-		// 返回给调用方
-		var replies []*model.Reply
+		// 把它们按id放进map，map阶段是并发的，输出的顺序和输入不一致
+		replies := make(map[int64]*model.Reply, len(replyIds))
 		for reply := range pipe {
-			replies = append(replies, reply)
+			replies[reply.Id] = reply
 		}
 		writer.Write(replies)
 	})
@@ -378,39 +289,199 @@ func (l *RepliesLogic) replyByIds(ctx context.Context, replyIds []int64) ([]*mod
 	if err != nil {
 		return nil, err
 	}
-	return replies, nil
+	// 按replyIds的顺序返回给调用方
+	ret := make([]*model.Reply, 0, len(replies))
+	for _, rid := range replyIds {
+		if reply, ok := replies[rid]; ok {
+			ret = append(ret, reply)
+		}
+	}
+	return ret, nil
 }
 
-func (l *RepliesLogic) addCacheReplies(ctx context.Context, replies []*model.Reply, articleId, parentId int64, sortType int32) error {
-	if len(replies) == 0 {
-		return nil
-	}
-	var key string
-	if parentId == 0 {
-		key = firstRepliesKey(articleId, sortType)
-	} else {
-		key = secondRepliesKey(parentId, sortType)
+// replyScore 评论在按sortType排序的列表中的score
+func replyScore(reply *model.Reply, sortType int32) int64 {
+	if sortType == types.SortLikeCount {
+		return reply.LikeNum
+	} else if sortType == types.SortHot {
+		return reply.HotScore
 	}
+	return reply.CreateTime.Unix()
+}
 
-	for _, reply := range replies {
-		var score int64
-		if sortType == types.SortLikeCount {
-			score = reply.LikeNum
-		} else if sortType == types.SortPublishTime {
-			score = reply.CreateTime.Local().Unix()
+// pinReply 把置顶评论从正常列表中去掉，第一页时放到最前面
+func (l *RepliesLogic) pinReply(targetId int64, items []*service.ReplyItem, isFirstPage bool) []*service.ReplyItem {
+	pin, err := l.svcCtx.ReplyPinModel.FindOneByBizIdTargetId(l.ctx, types.ReplyBizArticle, targetId)
+	if err != nil {
+		if !errors.Is(err, model.ErrNotFound) {
+			l.Logger.Errorf("ReplyPinModel.FindOneByBizIdTargetId targetId: %d error: %v", targetId, err)
 		}
-		// 对于id为-1的虚拟评论，score可能为负值，比如time.time的0值转成Unix。
-		if score < 0 {
-			score = 0
+		return items
+	}
+
+	// 不能直接用curPage[:0]原地过滤，否则会改到缓存回填用的数据
+	pageItems := make([]*service.ReplyItem, 0, len(items)+1)
+	for _, item := range items {
+		if item.Id != pin.ReplyId {
+			pageItems = append(pageItems, item)
 		}
-		// itoa 将 int 转成字符串（string）
-		_, err := l.svcCtx.BizRedis.ZaddCtx(ctx, key, score, strconv.Itoa(int(reply.Id)))
+	}
+	if !isFirstPage {
+		return pageItems
+	}
+
+	reply, err := l.svcCtx.ReplyModel.FindOne(l.ctx, pin.ReplyId)
+	if err != nil {
+		l.Logger.Errorf("pinReply FindOne replyId: %d error: %v", pin.ReplyId, err)
+		return pageItems
+	}
+	// 置顶的评论已经被删除
+	if reply.Status != types.ReplyStatusOk {
+		return pageItems
+	}
+	pinned := &service.ReplyItem{
+		Id:            reply.Id,
+		ReplyUserId:   reply.ReplyUserId,
+		BeReplyUserId: reply.BeReplyUserId,
+		ParentId:      reply.ParentId,
+		Content:       reply.Content,
+		LikeCount:     reply.LikeNum,
+		DislikeCount:  reply.DislikeNum,
+		HotScore:      reply.HotScore,
+		AuthorLiked:   reply.AuthorLiked == 1,
+		CreateTime:    reply.CreateTime.Unix(),
+		IsPinned:      true,
+	}
+	return append([]*service.ReplyItem{pinned}, pageItems...)
+}
 
+func (l *RepliesLogic) fillSubReplyCount(items []*service.ReplyItem) {
+	if len(items) == 0 {
+		return
+	}
+	parentIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		parentIds = append(parentIds, item.Id)
+	}
+	counts, err := l.svcCtx.ReplySubCountModel.FindByParentIds(l.ctx, types.ReplyBizArticle, parentIds)
+	if err != nil {
+		// 子评论数查询失败不影响评论列表返回
+		l.Logger.Errorf("ReplySubCountModel.FindByParentIds parentIds: %v error: %v", parentIds, err)
+		return
+	}
+	subReplyCount := make(map[int64]int64, len(counts))
+	for _, count := range counts {
+		subReplyCount[count.ParentId] = count.SubReplyNum
+	}
+	for _, item := range items {
+		item.SubReplyCount = subReplyCount[item.Id]
+	}
+}
+
+// fillPreviewReplies 并发查询每条一级评论的前几条二级评论，走和查看二级评论一样的缓存和数据库回源逻辑
+func (l *RepliesLogic) fillPreviewReplies(in *service.RepliesRequest, items []*service.ReplyItem, blockedIds map[int64]struct{}) {
+	previewSize := min(in.PreviewSize, types.MaxPreviewSize)
+	mr.ForEach(func(source chan<- *service.ReplyItem) {
+		for _, item := range items {
+			// 没有回复的一级评论不用查
+			if item.SubReplyCount == 0 {
+				continue
+			}
+			source <- item
+		}
+	}, func(item *service.ReplyItem) {
+		resp, err := NewRepliesLogic(l.ctx, l.svcCtx).Replies(&service.RepliesRequest{
+			TargetId: in.TargetId,
+			ParentId: item.Id,
+			PageSize: previewSize,
+			SortType: in.PreviewSortType,
+		})
 		if err != nil {
-			return err
+			// 内嵌评论查询失败不影响一级评论列表返回
+			l.Logger.Errorf("fillPreviewReplies parentId: %d error: %v", item.Id, err)
+			return
+		}
+		item.PreviewReplies = filterBlocked(resp.Replies, blockedIds)
+	})
+}
+
+// blockedIds 查询用户拉黑的人，未登录或者查询失败时不过滤
+func (l *RepliesLogic) blockedIds(userId int64) map[int64]struct{} {
+	if userId <= 0 {
+		return nil
+	}
+	ret, err := l.svcCtx.FollowRPC.BlockedIds(l.ctx, &follow.BlockedIdsRequest{UserId: userId})
+	if err != nil {
+		l.Logger.Errorf("FollowRPC.BlockedIds userId: %d error: %v", userId, err)
+		return nil
+	}
+	blockedIds := make(map[int64]struct{}, len(ret.BlockedIds))
+	for _, id := range ret.BlockedIds {
+		blockedIds[id] = struct{}{}
+	}
+
+	return blockedIds
+}
+
+// filterBlocked 去掉被拉黑的用户发的评论，返回新的切片，不改动缓存回填用的数据
+func filterBlocked(items []*service.ReplyItem, blockedIds map[int64]struct{}) []*service.ReplyItem {
+	if len(blockedIds) == 0 {
+		return items
+	}
+	filtered := make([]*service.ReplyItem, 0, len(items))
+	for _, item := range items {
+		if _, ok := blockedIds[item.ReplyUserId]; ok {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+
+	return filtered
+}
+
+// fillMentions 通过user-rpc批量查询评论中@到的用户，并生成渲染用的@片段
+func fillMentions(ctx context.Context, svcCtx *svc.ServiceContext, items []*service.ReplyItem) {
+	var replyIds []int64
+	for _, item := range items {
+		// 内容中没有@的评论不用查
+		if strings.Contains(item.Content, "@") {
+			replyIds = append(replyIds, item.Id)
+		}
+	}
+	if len(replyIds) == 0 {
+		return
+	}
+	resp, err := svcCtx.UserRPC.MentionsByObjs(ctx, &user.MentionsByObjsRequest{
+		BizId:  mention.BizReply,
+		ObjIds: replyIds,
+	})
+	if err != nil {
+		// @片段查询失败不影响评论列表返回，前端按纯文本展示
+		logx.WithContext(ctx).Errorf("UserRPC.MentionsByObjs replyIds: %v error: %v", replyIds, err)
+		return
+	}
+	userIds := make(map[int64]map[string]int64)
+	for _, m := range resp.Mentions {
+		if userIds[m.ObjId] == nil {
+			userIds[m.ObjId] = make(map[string]int64)
+		}
+		userIds[m.ObjId][m.Username] = m.UserId
+	}
+	for _, item := range items {
+		for _, span := range mention.BuildSpans(item.Content, userIds[item.Id]) {
+			item.Mentions = append(item.Mentions, &service.MentionSpan{
+				UserId:   span.UserId,
+				Username: span.Username,
+				Offset:   int32(span.Offset),
+				Length:   int32(span.Length),
+			})
 		}
 	}
-	return l.svcCtx.BizRedis.ExpireCtx(ctx, key, repliesExpire)
+}
+
+// repliesCursorScope 分页游标绑定评论列表和排序方式，换了排序方式要从第一页开始
+func repliesCursorScope(targetId, parentId int64, sortType int32) string {
+	return fmt.Sprintf("replies#%d#%d#%d", targetId, parentId, sortType)
 }
 
 func firstRepliesKey(articleid int64, sortType int32) string {
@@ -420,3 +491,15 @@ func firstRepliesKey(articleid int64, sortType int32) string {
 func secondRepliesKey(replyid int64, sortType int32) string {
 	return fmt.Sprintf(prefixSecondReplies, replyid, sortType)
 }
+
+// repliesCache 评论列表对应的缓存和key，一级评论按文章缓存，二级评论按所属的一级评论缓存。
+// 二级评论按时间排序是从旧到新，用升序的缓存
+func repliesCache(svcCtx *svc.ServiceContext, targetId, parentId int64, sortType int32) (*zsetcache.Cache, string) {
+	if parentId == 0 {
+		return svcCtx.RepliesCache, firstRepliesKey(targetId, sortType)
+	}
+	if sortType == types.SortPublishTime {
+		return svcCtx.AscRepliesCache, secondRepliesKey(parentId, sortType)
+	}
+	return svcCtx.RepliesCache, secondRepliesKey(parentId, sortType)
+}
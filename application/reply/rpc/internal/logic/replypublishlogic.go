@@ -2,11 +2,14 @@ package logic
 
 import (
 	"context"
-	"math"
+	"posta/application/article/rpc/article"
+	"posta/application/follow/rpc/follow"
 	"posta/application/reply/rpc/internal/code"
 	"posta/application/reply/rpc/internal/model"
 	"posta/application/reply/rpc/internal/types"
-	"strconv"
+	"posta/application/user/rpc/user"
+	"posta/pkg/mention"
+	"posta/pkg/zsetcache"
 	"time"
 
 	"posta/application/reply/rpc/internal/svc"
@@ -36,7 +39,11 @@ func (l *ReplyPublishLogic) ReplyPublish(in *service.ReplyPublishRequest) (*serv
 	if len(in.Content) == 0 {
 		return nil, code.ReplyContentEmpty
 	}
+	if err := l.checkBlocked(in); err != nil {
+		return nil, err
+	}
 	reply := &model.Reply{
+		BizId:         types.ReplyBizArticle,
 		ReplyUserId:   in.ReplyUserId,
 		TargetId:      in.TargetId,
 		BeReplyUserId: in.BeReplyUserId,
@@ -46,7 +53,8 @@ func (l *ReplyPublishLogic) ReplyPublish(in *service.ReplyPublishRequest) (*serv
 		Status:        types.ReplyStatusOk,
 		CreateTime:    time.Now(),
 	}
-	ret, err := l.svcCtx.ReplyModel.Insert(l.ctx, reply)
+	// 插入评论的同时更新评论总数和子评论数
+	ret, err := l.svcCtx.ReplyModel.InsertWithCount(l.ctx, reply)
 
 	if err != nil {
 		l.Logger.Errorf("Reply Insert req: %v error: %v", in, err)
@@ -58,75 +66,75 @@ func (l *ReplyPublishLogic) ReplyPublish(in *service.ReplyPublishRequest) (*serv
 		l.Logger.Errorf("LastInsertId error: %v", in, err)
 	}
 
+	// 解析评论中的@，记录后由user-rpc发送通知
+	l.addMentions(replyId, in.ReplyUserId, in.Content)
+
 	// 注意：为了保证缓存和数据库的一致性，只有当缓存存在时，才会往缓存中加入数据。
 	// 如果缓存不存在，说明没人调用过articles方法，我们只需要改变数据库就行，如果仍然执行zadd的话那缓存中就只有这个值了。
-	// 这里分了两类讨论，分别是in.ParentId为0，即一级评论；或是in.ParentId不为0，即二级评论。
-	if in.ParentId == 0 {
-		var (
-			publishTimeScore = reply.CreateTime.Unix()
-			replyIdStr       = strconv.FormatInt(replyId, 10)
-			publishTimeKey   = firstRepliesKey(in.TargetId, types.SortPublishTime)
-			likeNumKey       = firstRepliesKey(in.TargetId, types.SortLikeCount)
-		)
-		// 如果 Redis ZSet 存在
-		b, _ := l.svcCtx.BizRedis.ExistsCtx(l.ctx, publishTimeKey)
-		if b {
-			_, err = l.svcCtx.BizRedis.ZaddCtx(l.ctx, publishTimeKey, publishTimeScore, replyIdStr)
-			if err != nil {
-				l.Logger.Errorf("ZaddCtx req: %v error: %v", in, err)
-			}
+	// 二级评论按时间是从旧到新，缓存不完整时新评论排在缓存的尾部之后，不会加进去，翻到那里时再从数据库查。
+	for _, sortType := range []int32{types.SortPublishTime, types.SortLikeCount, types.SortHot} {
+		// 新评论没有点赞点踩，点赞数和热度分都为0
+		var score int64
+		if sortType == types.SortPublishTime {
+			score = reply.CreateTime.Unix()
 		}
-		b, _ = l.svcCtx.BizRedis.ExistsCtx(l.ctx, likeNumKey)
-		if b {
-			_, err = l.svcCtx.BizRedis.ZaddCtx(l.ctx, likeNumKey, 0, replyIdStr)
-			if err != nil {
-				logx.Errorf("ZaddCtx req: %v error: %v", in, err)
-			}
+		cache, key := repliesCache(l.svcCtx, in.TargetId, in.ParentId, sortType)
+		err = cache.Add(l.ctx, key, zsetcache.Item{Id: replyId, Score: score})
+		if err != nil {
+			l.Logger.Errorf("RepliesCache.Add key: %s req: %v error: %v", key, in, err)
 		}
-	} else {
-		var (
-			replyIdStr     = strconv.FormatInt(replyId, 10)
-			publishTimeKey = secondRepliesKey(in.ParentId, types.SortPublishTime)
-			likeNumKey     = secondRepliesKey(in.ParentId, types.SortLikeCount)
-		)
-		// 注意：这里只有一级评论才需要将最新评论加入到zset中，因为是按时间排是从新到旧。而二级评论是从旧到新，如果将最新评论加入到zset中会有问题。
-		b, _ := l.svcCtx.BizRedis.ExistsCtx(l.ctx, publishTimeKey)
-		if b {
-			// 查询当前缓存中 "math.MaxInt64" 的 score（可能没有）。
-			existScore, err := l.svcCtx.BizRedis.ZscoreCtx(l.ctx, publishTimeKey, strconv.Itoa(math.MaxInt64))
-			// 如果redis中没有缓存"math.MaxInt64"，则从数据库里去查。
-			if err != nil {
-				existTime, errDB := l.svcCtx.ReplyModel.MaxCreateTimeByParentId(l.ctx, in.ParentId)
-				if errDB != nil {
-					l.Logger.Errorf("Reply findmaxcreatetimebyparentid error: %v", errDB)
-				}
-				existScore = existTime.Unix()
-			}
+	}
 
-			newScore := reply.CreateTime.Unix()
+	return &service.ReplyPublishResponse{ReplyId: replyId}, nil
+}
 
-			// 只有当新评论时间更大时，才更新math.MaxInt64标记
-			if newScore > existScore {
-				// 在zSet中，Member是唯一的如果 "math.MaxInt64" 已经存在于 ZSet 中，它会被自动更新为新的 newScore，无需你手动删除原来的。
-				_, err = l.svcCtx.BizRedis.ZaddCtx(l.ctx, publishTimeKey, newScore, strconv.Itoa(math.MaxInt64))
-				if err != nil {
-					l.Logger.Errorf("ZaddCtx update MaxInt64 for key %s failed: %v", publishTimeKey, err)
-				}
-			} else {
-				_, err = l.svcCtx.BizRedis.ZaddCtx(l.ctx, publishTimeKey, existScore, strconv.Itoa(math.MaxInt64))
-				if err != nil {
-					l.Logger.Errorf("ZaddCtx update MaxInt64 for key %s failed: %v", publishTimeKey, err)
-				}
-			}
-		}
-		b, _ = l.svcCtx.BizRedis.ExistsCtx(l.ctx, likeNumKey)
-		if b {
-			_, err = l.svcCtx.BizRedis.ZaddCtx(l.ctx, likeNumKey, 0, replyIdStr)
-			if err != nil {
-				logx.Errorf("ZaddCtx req: %v error: %v", in, err)
-			}
-		}
+// addMentions 把评论中的@username通过user-rpc解析成用户ID并记录下来，失败不影响评论发布
+func (l *ReplyPublishLogic) addMentions(replyId, replyUserId int64, content string) {
+	usernames := mention.Usernames(content)
+	if len(usernames) == 0 {
+		return
+	}
+	users, err := l.svcCtx.UserRPC.FindByUsernames(l.ctx, &user.FindByUsernamesRequest{Usernames: usernames})
+	if err != nil {
+		l.Logger.Errorf("UserRPC.FindByUsernames usernames: %v error: %v", usernames, err)
+		return
+	}
+	if len(users.Users) == 0 {
+		return
 	}
+	_, err = l.svcCtx.UserRPC.AddMentions(l.ctx, &user.AddMentionsRequest{
+		BizId:      mention.BizReply,
+		ObjId:      replyId,
+		FromUserId: replyUserId,
+		Users:      users.Users,
+	})
+	if err != nil {
+		l.Logger.Errorf("UserRPC.AddMentions replyId: %d error: %v", replyId, err)
+	}
+}
 
-	return &service.ReplyPublishResponse{ReplyId: replyId}, nil
+// checkBlocked 文章作者或者被回复的人拉黑了评论者时不能评论
+func (l *ReplyPublishLogic) checkBlocked(in *service.ReplyPublishRequest) error {
+	detail, err := l.svcCtx.ArticleRPC.ArticleDetail(l.ctx, &article.ArticleDetailRequest{ArticleId: in.TargetId})
+	if err != nil {
+		l.Logger.Errorf("ArticleRPC.ArticleDetail articleId: %d error: %v", in.TargetId, err)
+		return err
+	}
+	if detail.Article == nil {
+		return code.ArticleNotExist
+	}
+
+	ret, err := l.svcCtx.FollowRPC.CheckBlocked(l.ctx, &follow.CheckBlockedRequest{
+		UserId:   in.ReplyUserId,
+		OwnerIds: []int64{detail.Article.AuthorId, in.BeReplyUserId},
+	})
+	if err != nil {
+		l.Logger.Errorf("FollowRPC.CheckBlocked req: %v error: %v", in, err)
+		return err
+	}
+	if len(ret.BlockerIds) > 0 {
+		return code.ReplyBlocked
+	}
+
+	return nil
 }
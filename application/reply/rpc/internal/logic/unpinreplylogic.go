@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"posta/application/reply/rpc/internal/code"
+	"posta/application/reply/rpc/internal/model"
+	"posta/application/reply/rpc/internal/svc"
+	"posta/application/reply/rpc/internal/types"
+	"posta/application/reply/rpc/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnpinReplyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnpinReplyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnpinReplyLogic {
+	return &UnpinReplyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 文章作者取消置顶
+func (l *UnpinReplyLogic) UnpinReply(in *service.UnpinReplyRequest) (*service.UnpinReplyResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.TargetId <= 0 {
+		return nil, code.ArticleIdInvalid
+	}
+	if err := checkArticleAuthor(l.ctx, l.svcCtx, in.TargetId, in.UserId); err != nil {
+		return nil, err
+	}
+
+	pin, err := l.svcCtx.ReplyPinModel.FindOneByBizIdTargetId(l.ctx, types.ReplyBizArticle, in.TargetId)
+	if err != nil {
+		// 本来就没有置顶
+		if errors.Is(err, model.ErrNotFound) {
+			return &service.UnpinReplyResponse{}, nil
+		}
+		l.Logger.Errorf("ReplyPinModel.FindOneByBizIdTargetId req: %v error: %v", in, err)
+		return nil, err
+	}
+	err = l.svcCtx.ReplyPinModel.Delete(l.ctx, pin.Id)
+	if err != nil {
+		l.Logger.Errorf("ReplyPinModel.Delete req: %v error: %v", in, err)
+		return nil, err
+	}
+
+	return &service.UnpinReplyResponse{}, nil
+}
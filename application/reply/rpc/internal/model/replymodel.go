@@ -7,21 +7,28 @@ import (
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
-	"time"
 )
 
 var _ ReplyModel = (*customReplyModel)(nil)
 
+// 评论状态 0:正常 1:删除
+const (
+	replyStatusOk     = 0
+	replyStatusDelete = 1
+)
+
 type (
 	// ReplyModel is an interface to be customized, add more methods here,
 	// and implement the added methods in customReplyModel.
 	ReplyModel interface {
 		replyModel
 		UpdateReplyStatus(ctx context.Context, id int64, status int) error
-		FirstRepliesByArticleId(ctx context.Context, articleId, likeNum int64, createTime, sortField string, limit int) ([]*Reply, error)
-		SecondRepliesByFirstReplyId(ctx context.Context, parentId, likeNum int64, createTime, sortField string, limit int) ([]*Reply, error)
-		MaxCreateTimeByParentId(ctx context.Context, parentId int64) (time.Time, error)
+		FirstRepliesByArticleId(ctx context.Context, articleId int64, sortField string, sortValue any, lastId int64, limit int) ([]*Reply, error)
+		SecondRepliesByFirstReplyId(ctx context.Context, parentId int64, sortField string, sortValue any, lastId int64, limit int) ([]*Reply, error)
 		RepliesByRootReplyId(ctx context.Context, rootReplyId int64, createTime string, limit int) ([]*Reply, error)
+		InsertWithCount(ctx context.Context, data *Reply) (sql.Result, error)
+		DeleteWithCount(ctx context.Context, data *Reply) (bool, error)
+		UpdateAuthorLiked(ctx context.Context, id int64, authorLiked int64) error
 	}
 
 	customReplyModel struct {
@@ -60,80 +67,167 @@ func (m *customReplyModel) UpdateReplyStatus(ctx context.Context, id int64, stat
 	return err
 }
 
-func (m *customReplyModel) FirstRepliesByArticleId(ctx context.Context, articleId, likeNum int64, createTime, sortField string, limit int) ([]*Reply, error) {
+// FirstRepliesByArticleId 按sortField和id倒序查询文章的一级评论，lastId大于0时从上一页最后一条(sortValue, lastId)之后开始查
+func (m *customReplyModel) FirstRepliesByArticleId(ctx context.Context, articleId int64, sortField string, sortValue any, lastId int64, limit int) ([]*Reply, error) {
 	var (
-		err error
-		sql string
-		// any可以接受任意类型的值
-		anyField any
-		replies  []*Reply
+		err     error
+		replies []*Reply
 	)
 
-	if sortField == "like_num" {
-		anyField = likeNum
-		// replyRows指的是字段名拼成的一个 SQL 用的字段列表，"`id`,`content`,`reply_user_id`,`target_id`,..."。
-		sql = fmt.Sprintf("select "+replyRows+" from "+m.table+" where target_id=? and parent_id=0 and status=0 and like_num < ? order by %s desc limit ?", sortField)
+	// 直接查数据库，因为我们已经在RepliesByArticleId外面查过缓存没找到了，这里就直接去数据库找就可以
+	// replyRows指的是字段名拼成的一个 SQL 用的字段列表，"`id`,`content`,`reply_user_id`,`target_id`,..."。
+	// 排序字段相同时按id排序，保证翻页时不重复也不遗漏
+	if lastId > 0 {
+		sql := fmt.Sprintf("select "+replyRows+" from "+m.table+" where target_id=? and parent_id=0 and status=0 and (%[1]s < ? or (%[1]s = ? and id < ?)) order by %[1]s desc, id desc limit ?", sortField)
+		err = m.QueryRowsNoCacheCtx(ctx, &replies, sql, articleId, sortValue, sortValue, lastId, limit)
 	} else {
-		anyField = createTime
-		sql = fmt.Sprintf("select "+replyRows+" from "+m.table+" where target_id=? and parent_id=0 and status=0 and create_time < ? order by %s desc limit ?", sortField)
+		sql := fmt.Sprintf("select "+replyRows+" from "+m.table+" where target_id=? and parent_id=0 and status=0 order by %s desc, id desc limit ?", sortField)
+		err = m.QueryRowsNoCacheCtx(ctx, &replies, sql, articleId, limit)
 	}
 
-	// 直接查数据库，因为我们已经在RepliesByArticleId外面查过缓存没找到了，这里就直接去数据库找就可以
-	err = m.QueryRowsNoCacheCtx(ctx, &replies, sql, articleId, anyField, limit)
-
 	if err != nil {
 		return nil, err
 	}
 	return replies, nil
 }
 
-func (m *customReplyModel) SecondRepliesByFirstReplyId(ctx context.Context, parentId, likeNum int64, createTime, sortField string, limit int) ([]*Reply, error) {
+// SecondRepliesByFirstReplyId 查询一级评论下的二级评论，按时间排序时从旧到新，按点赞数和热度排序时从高到低
+func (m *customReplyModel) SecondRepliesByFirstReplyId(ctx context.Context, parentId int64, sortField string, sortValue any, lastId int64, limit int) ([]*Reply, error) {
 	var (
-		err error
-		sql string
-		// any可以接受任意类型的值
-		anyField any
-		replies  []*Reply
+		err     error
+		replies []*Reply
 	)
 
-	if sortField == "like_num" {
-		anyField = likeNum
-		// replyRows指的是字段名拼成的一个 SQL 用的字段列表，"`id`,`content`,`reply_user_id`,`target_id`,..."。
-		sql = fmt.Sprintf("select "+replyRows+" from "+m.table+" where parent_id=? and status=0 and like_num < ? order by %s desc limit ?", sortField)
+	// 二级评论按时间查询要升序
+	order, cmp := "desc", "<"
+	if sortField == "create_time" {
+		order, cmp = "asc", ">"
+	}
+	if lastId > 0 {
+		sql := fmt.Sprintf("select "+replyRows+" from "+m.table+" where parent_id=? and status=0 and (%[1]s %[2]s ? or (%[1]s = ? and id %[2]s ?)) order by %[1]s %[3]s, id %[3]s limit ?", sortField, cmp, order)
+		err = m.QueryRowsNoCacheCtx(ctx, &replies, sql, parentId, sortValue, sortValue, lastId, limit)
 	} else {
-		anyField = createTime
-		// 二级评论查询要升序
-		sql = fmt.Sprintf("select "+replyRows+" from "+m.table+" where parent_id=? and status=0 and create_time > ? order by %s asc limit ?", sortField)
+		sql := fmt.Sprintf("select "+replyRows+" from "+m.table+" where parent_id=? and status=0 order by %[1]s %[2]s, id %[2]s limit ?", sortField, order)
+		err = m.QueryRowsNoCacheCtx(ctx, &replies, sql, parentId, limit)
 	}
 
-	// 直接查数据库，因为我们已经在RepliesByArticleId外面查过缓存没找到了，这里就直接去数据库找就可以
-	err = m.QueryRowsNoCacheCtx(ctx, &replies, sql, parentId, anyField, limit)
+	if err != nil {
+		return nil, err
+	}
+	return replies, nil
+}
 
+func (m *customReplyModel) RepliesByRootReplyId(ctx context.Context, rootReplyId int64, createTime string, limit int) ([]*Reply, error) {
+	var replies []*Reply
+	sql := fmt.Sprintf("select " + replyRows + " from " + m.table + " where root_reply_id=? and status=0 and create_time > ? order by create_time asc limit ?")
+	err := m.QueryRowsNoCacheCtx(ctx, &replies, sql, rootReplyId, createTime, limit)
 	if err != nil {
 		return nil, err
 	}
 	return replies, nil
 }
 
-// 注意：这个函数需要检验一下正不正确
-func (m *customReplyModel) MaxCreateTimeByParentId(ctx context.Context, parentId int64) (time.Time, error) {
-	var reply Reply
-	logx.Infof("parentId: %d", parentId)
-	query := fmt.Sprintf("SELECT * FROM %s WHERE parent_id = ? and status=0 ORDER BY create_time DESC LIMIT 1", m.table)
+// InsertWithCount 在同一个事务里插入评论并更新评论总数和子评论数，保证计数和评论一致
+func (m *customReplyModel) InsertWithCount(ctx context.Context, data *Reply) (sql.Result, error) {
+	var (
+		ret       sql.Result
+		cacheKeys []string
+	)
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		var err error
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, replyRowsExpectAutoSet)
+		ret, err = session.ExecCtx(ctx, query, data.BizId, data.TargetId, data.ReplyUserId, data.BeReplyUserId, data.ParentId, data.RootReplyId, data.Content, data.Status, data.LikeNum, data.DislikeNum, data.HotScore, data.AuthorLiked)
+		if err != nil {
+			return err
+		}
+		cacheKeys, err = m.incrCount(ctx, session, data, 1)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 计数表是带缓存的model，事务提交后再删除缓存
+	if err = m.DelCacheCtx(ctx, cacheKeys...); err != nil {
+		logx.Errorf("InsertWithCount DelCacheCtx keys: %v error: %v", cacheKeys, err)
+	}
+	return ret, nil
+}
 
-	err := m.QueryRowNoCacheCtx(ctx, &reply, query, parentId)
+// DeleteWithCount 在同一个事务里把评论标记为删除并减少计数，评论已经是删除状态时返回false
+func (m *customReplyModel) DeleteWithCount(ctx context.Context, data *Reply) (bool, error) {
+	var (
+		deleted   bool
+		cacheKeys = []string{fmt.Sprintf("%s%v", cachePostaReplyReplyIdPrefix, data.Id)}
+	)
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("update %s set status = ? where `id` = ? and status = ?", m.table)
+		ret, err := session.ExecCtx(ctx, query, replyStatusDelete, data.Id, replyStatusOk)
+		if err != nil {
+			return err
+		}
+		affected, err := ret.RowsAffected()
+		if err != nil {
+			return err
+		}
+		// 防止重复删除时计数被多减
+		if affected == 0 {
+			return nil
+		}
+		deleted = true
+		keys, err := m.incrCount(ctx, session, data, -1)
+		cacheKeys = append(cacheKeys, keys...)
+		return err
+	})
 	if err != nil {
-		return time.Time{}, err
+		return false, err
 	}
-	return reply.CreateTime, nil
+	if err = m.DelCacheCtx(ctx, cacheKeys...); err != nil {
+		logx.Errorf("DeleteWithCount DelCacheCtx keys: %v error: %v", cacheKeys, err)
+	}
+	return deleted, nil
 }
 
-func (m *customReplyModel) RepliesByRootReplyId(ctx context.Context, rootReplyId int64, createTime string, limit int) ([]*Reply, error) {
-	var replies []*Reply
-	sql := fmt.Sprintf("select " + replyRows + " from " + m.table + " where root_reply_id=? and status=0 and create_time > ? order by create_time asc limit ?")
-	err := m.QueryRowsNoCacheCtx(ctx, &replies, sql, rootReplyId, createTime, limit)
+// incrCount 更新评论目标的评论总数，如果是二级评论还要更新一级评论的子评论数，返回需要删除的缓存key
+func (m *customReplyModel) incrCount(ctx context.Context, session sqlx.Session, data *Reply, delta int64) ([]string, error) {
+	query := "insert into `reply_count` (biz_id, target_id, reply_num) values (?, ?, ?) on duplicate key update reply_num = greatest(reply_num + ?, 0)"
+	_, err := session.ExecCtx(ctx, query, data.BizId, data.TargetId, max(delta, 0), delta)
 	if err != nil {
 		return nil, err
 	}
-	return replies, nil
+	var countId int64
+	err = session.QueryRowCtx(ctx, &countId, "select id from `reply_count` where biz_id = ? and target_id = ? limit 1", data.BizId, data.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	cacheKeys := []string{
+		fmt.Sprintf("%s%v", cachePostaReplyReplyCountIdPrefix, countId),
+		fmt.Sprintf("%s%v:%v", cachePostaReplyReplyCountBizIdTargetIdPrefix, data.BizId, data.TargetId),
+	}
+	if data.ParentId == 0 {
+		return cacheKeys, nil
+	}
+
+	query = "insert into `reply_sub_count` (biz_id, parent_id, sub_reply_num) values (?, ?, ?) on duplicate key update sub_reply_num = greatest(sub_reply_num + ?, 0)"
+	_, err = session.ExecCtx(ctx, query, data.BizId, data.ParentId, max(delta, 0), delta)
+	if err != nil {
+		return nil, err
+	}
+	var subCountId int64
+	err = session.QueryRowCtx(ctx, &subCountId, "select id from `reply_sub_count` where biz_id = ? and parent_id = ? limit 1", data.BizId, data.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	return append(cacheKeys,
+		fmt.Sprintf("%s%v", cachePostaReplyReplySubCountIdPrefix, subCountId),
+		fmt.Sprintf("%s%v:%v", cachePostaReplyReplySubCountBizIdParentIdPrefix, data.BizId, data.ParentId),
+	), nil
+}
+
+func (m *customReplyModel) UpdateAuthorLiked(ctx context.Context, id int64, authorLiked int64) error {
+	postaReplyReplyIdKey := fmt.Sprintf("%s%v", cachePostaReplyReplyIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set author_liked = ? where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, authorLiked, id)
+	}, postaReplyReplyIdKey)
+	return err
 }
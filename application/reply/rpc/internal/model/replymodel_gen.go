@@ -51,6 +51,9 @@ type (
 		Content       string    `db:"content"`          // 内容
 		Status        int64     `db:"status"`           // 状态 0:正常 1:删除
 		LikeNum       int64     `db:"like_num"`         // 点赞数
+		DislikeNum    int64     `db:"dislike_num"`      // 点踩数
+		HotScore      int64     `db:"hot_score"`        // 热度分，点赞点踩的威尔逊置信区间下界*1000000
+		AuthorLiked   int64     `db:"author_liked"`     // 是否被作者赞过 0:否 1:是
 		CreateTime    time.Time `db:"create_time"`      // 创建时间
 	}
 )
@@ -91,8 +94,8 @@ func (m *defaultReplyModel) FindOne(ctx context.Context, id int64) (*Reply, erro
 func (m *defaultReplyModel) Insert(ctx context.Context, data *Reply) (sql.Result, error) {
 	postaReplyReplyIdKey := fmt.Sprintf("%s%v", cachePostaReplyReplyIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, replyRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.BizId, data.TargetId, data.ReplyUserId, data.BeReplyUserId, data.ParentId, data.RootReplyId, data.Content, data.Status, data.LikeNum)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, replyRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.BizId, data.TargetId, data.ReplyUserId, data.BeReplyUserId, data.ParentId, data.RootReplyId, data.Content, data.Status, data.LikeNum, data.DislikeNum, data.HotScore, data.AuthorLiked)
 	}, postaReplyReplyIdKey)
 	return ret, err
 }
@@ -101,7 +104,7 @@ func (m *defaultReplyModel) Update(ctx context.Context, data *Reply) error {
 	postaReplyReplyIdKey := fmt.Sprintf("%s%v", cachePostaReplyReplyIdPrefix, data.Id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, replyRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, data.BizId, data.TargetId, data.ReplyUserId, data.BeReplyUserId, data.ParentId, data.RootReplyId, data.Content, data.Status, data.LikeNum, data.Id)
+		return conn.ExecCtx(ctx, query, data.BizId, data.TargetId, data.ReplyUserId, data.BeReplyUserId, data.ParentId, data.RootReplyId, data.Content, data.Status, data.LikeNum, data.DislikeNum, data.HotScore, data.AuthorLiked, data.Id)
 	}, postaReplyReplyIdKey)
 	return err
 }
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ReplyPinModel = (*customReplyPinModel)(nil)
+
+type (
+	// ReplyPinModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customReplyPinModel.
+	ReplyPinModel interface {
+		replyPinModel
+		Upsert(ctx context.Context, bizId string, targetId, replyId int64) error
+	}
+
+	customReplyPinModel struct {
+		*defaultReplyPinModel
+	}
+)
+
+// NewReplyPinModel returns a model for the database table.
+func NewReplyPinModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ReplyPinModel {
+	return &customReplyPinModel{
+		defaultReplyPinModel: newReplyPinModel(conn, c, opts...),
+	}
+}
+
+// Upsert 每个评论目标只能置顶一条评论，已有置顶时替换成新的评论
+func (m *customReplyPinModel) Upsert(ctx context.Context, bizId string, targetId, replyId int64) error {
+	postaReplyReplyPinBizIdTargetIdKey := fmt.Sprintf("%s%v:%v", cachePostaReplyReplyPinBizIdTargetIdPrefix, bizId, targetId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("insert into %s (biz_id, target_id, reply_id) values (?, ?, ?) on duplicate key update reply_id = values(reply_id)", m.table)
+		return conn.ExecCtx(ctx, query, bizId, targetId, replyId)
+	}, postaReplyReplyPinBizIdTargetIdKey)
+	if err != nil {
+		return err
+	}
+	// 行缓存是按主键缓存的，替换置顶后也要删掉
+	pin, err := m.FindOneByBizIdTargetId(ctx, bizId, targetId)
+	if err != nil {
+		return err
+	}
+	return m.DelCacheCtx(ctx, m.formatPrimary(pin.Id))
+}
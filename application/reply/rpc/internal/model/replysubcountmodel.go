@@ -1,6 +1,10 @@
 package model
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
@@ -12,6 +16,7 @@ type (
 	// and implement the added methods in customReplySubCountModel.
 	ReplySubCountModel interface {
 		replySubCountModel
+		FindByParentIds(ctx context.Context, bizId string, parentIds []int64) ([]*ReplySubCount, error)
 	}
 
 	customReplySubCountModel struct {
@@ -25,3 +30,22 @@ func NewReplySubCountModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.O
 		defaultReplySubCountModel: newReplySubCountModel(conn, c, opts...),
 	}
 }
+
+// FindByParentIds 批量查询一级评论的子评论数，用于评论列表展示。biz_id放在最前面，走uk_biz_rootreply索引
+func (m *customReplySubCountModel) FindByParentIds(ctx context.Context, bizId string, parentIds []int64) ([]*ReplySubCount, error) {
+	if len(parentIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(parentIds)+1)
+	args = append(args, bizId)
+	for _, id := range parentIds {
+		args = append(args, id)
+	}
+	var counts []*ReplySubCount
+	query := fmt.Sprintf("select %s from %s where `biz_id` = ? and `parent_id` in (%s)", replySubCountRows, m.table, strings.TrimSuffix(strings.Repeat("?,", len(parentIds)), ","))
+	err := m.QueryRowsNoCacheCtx(ctx, &counts, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
@@ -44,3 +44,21 @@ func (s *ReplyServer) GetReplyThread(ctx context.Context, in *service.GetReplyTh
 	l := logic.NewGetReplyThreadLogic(ctx, s.svcCtx)
 	return l.GetReplyThread(in)
 }
+
+// 文章作者置顶一条一级评论，已有置顶时替换
+func (s *ReplyServer) PinReply(ctx context.Context, in *service.PinReplyRequest) (*service.PinReplyResponse, error) {
+	l := logic.NewPinReplyLogic(ctx, s.svcCtx)
+	return l.PinReply(in)
+}
+
+// 文章作者取消置顶
+func (s *ReplyServer) UnpinReply(ctx context.Context, in *service.UnpinReplyRequest) (*service.UnpinReplyResponse, error) {
+	l := logic.NewUnpinReplyLogic(ctx, s.svcCtx)
+	return l.UnpinReply(in)
+}
+
+// 文章作者给评论点赞或取消点赞，评论会展示"作者赞过"
+func (s *ReplyServer) AuthorLikeReply(ctx context.Context, in *service.AuthorLikeReplyRequest) (*service.AuthorLikeReplyResponse, error) {
+	l := logic.NewAuthorLikeReplyLogic(ctx, s.svcCtx)
+	return l.AuthorLikeReply(in)
+}
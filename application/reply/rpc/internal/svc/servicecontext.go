@@ -3,16 +3,32 @@ package svc
 import (
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
 	"golang.org/x/sync/singleflight"
+	"posta/application/article/rpc/article"
+	"posta/application/follow/rpc/follow"
 	"posta/application/reply/rpc/internal/config"
 	"posta/application/reply/rpc/internal/model"
+	"posta/application/reply/rpc/internal/types"
+	"posta/application/user/rpc/user"
+	"posta/pkg/cursor"
+	"posta/pkg/zsetcache"
 )
 
 type ServiceContext struct {
-	Config            config.Config
-	ReplyModel        model.ReplyModel
-	BizRedis          *redis.Redis
-	SingleFlightGroup singleflight.Group
+	Config             config.Config
+	ReplyModel         model.ReplyModel
+	ReplySubCountModel model.ReplySubCountModel
+	ReplyPinModel      model.ReplyPinModel
+	BizRedis           *redis.Redis
+	SingleFlightGroup  singleflight.Group
+	ArticleRPC         article.Article
+	UserRPC            user.User
+	FollowRPC          follow.Follow
+	CursorCodec        *cursor.Codec
+	// RepliesCache 从高到低排列的评论列表缓存，AscRepliesCache 用于按时间从旧到新排列的二级评论
+	RepliesCache    *zsetcache.Cache
+	AscRepliesCache *zsetcache.Cache
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -24,8 +40,24 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	})
 
 	return &ServiceContext{
-		Config:     c,
-		ReplyModel: model.NewReplyModel(sqlx.NewMysql(c.DataSource), c.CacheRedis),
-		BizRedis:   rds,
+		Config:             c,
+		ReplyModel:         model.NewReplyModel(sqlx.NewMysql(c.DataSource), c.CacheRedis),
+		ReplySubCountModel: model.NewReplySubCountModel(sqlx.NewMysql(c.DataSource), c.CacheRedis),
+		ReplyPinModel:      model.NewReplyPinModel(sqlx.NewMysql(c.DataSource), c.CacheRedis),
+		ArticleRPC:         article.NewArticle(zrpc.MustNewClient(c.ArticleRPC)),
+		UserRPC:            user.NewUser(zrpc.MustNewClient(c.UserRPC)),
+		FollowRPC:          follow.NewFollow(zrpc.MustNewClient(c.FollowRPC)),
+		BizRedis:           rds,
+		CursorCodec:        cursor.NewCodec(c.CursorSecret),
+		RepliesCache: zsetcache.New(rds, zsetcache.Conf{
+			Order:   zsetcache.Desc,
+			MaxSize: types.DefaultLimit,
+			Expire:  types.RepliesCacheExpire,
+		}),
+		AscRepliesCache: zsetcache.New(rds, zsetcache.Conf{
+			Order:   zsetcache.Asc,
+			MaxSize: types.DefaultLimit,
+			Expire:  types.RepliesCacheExpire,
+		}),
 	}
 }
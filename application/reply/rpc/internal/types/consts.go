@@ -3,15 +3,26 @@ package types
 const (
 	SortPublishTime = iota
 	SortLikeCount
+	// SortHot 按热度排序，热度为点赞点踩的威尔逊置信区间下界，由reply-mq在点赞数变化时计算
+	SortHot
 )
 
 const (
 	DefaultPageSize = 20
-	DefaultLimit    = 200
+	// MaxPageSize 每页最多的条数，第一页回源时多查的条数要比它大，否则判断不了是否到底
+	MaxPageSize  = 50
+	DefaultLimit = 200
 
-	DefaultSortLikeCursor = 1 << 30
+	// RepliesCacheExpire 评论列表缓存的过期时间，单位秒
+	RepliesCacheExpire = 3600 * 24 * 2
+
+	// 一级评论内嵌二级评论的最大条数
+	MaxPreviewSize = 10
 )
 
+// ReplyBizArticle 文章评论的业务ID
+const ReplyBizArticle = "article"
+
 const (
 	ReplyStatusOk = iota
 	ReplyStatusDelete
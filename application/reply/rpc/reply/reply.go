@@ -14,15 +14,22 @@ import (
 )
 
 type (
-	GetReplyThreadRequest  = service.GetReplyThreadRequest
-	GetReplyThreadResponse = service.GetReplyThreadResponse
-	RepliesRequest         = service.RepliesRequest
-	RepliesResponse        = service.RepliesResponse
-	ReplyDeleteRequest     = service.ReplyDeleteRequest
-	ReplyDeleteResponse    = service.ReplyDeleteResponse
-	ReplyItem              = service.ReplyItem
-	ReplyPublishRequest    = service.ReplyPublishRequest
-	ReplyPublishResponse   = service.ReplyPublishResponse
+	AuthorLikeReplyRequest  = service.AuthorLikeReplyRequest
+	AuthorLikeReplyResponse = service.AuthorLikeReplyResponse
+	GetReplyThreadRequest   = service.GetReplyThreadRequest
+	GetReplyThreadResponse  = service.GetReplyThreadResponse
+	MentionSpan             = service.MentionSpan
+	PinReplyRequest         = service.PinReplyRequest
+	PinReplyResponse        = service.PinReplyResponse
+	RepliesRequest          = service.RepliesRequest
+	RepliesResponse         = service.RepliesResponse
+	ReplyDeleteRequest      = service.ReplyDeleteRequest
+	ReplyDeleteResponse     = service.ReplyDeleteResponse
+	ReplyItem               = service.ReplyItem
+	ReplyPublishRequest     = service.ReplyPublishRequest
+	ReplyPublishResponse    = service.ReplyPublishResponse
+	UnpinReplyRequest       = service.UnpinReplyRequest
+	UnpinReplyResponse      = service.UnpinReplyResponse
 
 	Reply interface {
 		ReplyPublish(ctx context.Context, in *ReplyPublishRequest, opts ...grpc.CallOption) (*ReplyPublishResponse, error)
@@ -31,6 +38,12 @@ type (
 		Replies(ctx context.Context, in *RepliesRequest, opts ...grpc.CallOption) (*RepliesResponse, error)
 		// 可以查看二级评论下的对话链。
 		GetReplyThread(ctx context.Context, in *GetReplyThreadRequest, opts ...grpc.CallOption) (*GetReplyThreadResponse, error)
+		// 文章作者置顶一条一级评论，已有置顶时替换
+		PinReply(ctx context.Context, in *PinReplyRequest, opts ...grpc.CallOption) (*PinReplyResponse, error)
+		// 文章作者取消置顶
+		UnpinReply(ctx context.Context, in *UnpinReplyRequest, opts ...grpc.CallOption) (*UnpinReplyResponse, error)
+		// 文章作者给评论点赞或取消点赞，评论会展示"作者赞过"
+		AuthorLikeReply(ctx context.Context, in *AuthorLikeReplyRequest, opts ...grpc.CallOption) (*AuthorLikeReplyResponse, error)
 	}
 
 	defaultReply struct {
@@ -65,3 +78,21 @@ func (m *defaultReply) GetReplyThread(ctx context.Context, in *GetReplyThreadReq
 	client := service.NewReplyClient(m.cli.Conn())
 	return client.GetReplyThread(ctx, in, opts...)
 }
+
+// 文章作者置顶一条一级评论，已有置顶时替换
+func (m *defaultReply) PinReply(ctx context.Context, in *PinReplyRequest, opts ...grpc.CallOption) (*PinReplyResponse, error) {
+	client := service.NewReplyClient(m.cli.Conn())
+	return client.PinReply(ctx, in, opts...)
+}
+
+// 文章作者取消置顶
+func (m *defaultReply) UnpinReply(ctx context.Context, in *UnpinReplyRequest, opts ...grpc.CallOption) (*UnpinReplyResponse, error) {
+	client := service.NewReplyClient(m.cli.Conn())
+	return client.UnpinReply(ctx, in, opts...)
+}
+
+// 文章作者给评论点赞或取消点赞，评论会展示"作者赞过"
+func (m *defaultReply) AuthorLikeReply(ctx context.Context, in *AuthorLikeReplyRequest, opts ...grpc.CallOption) (*AuthorLikeReplyResponse, error) {
+	client := service.NewReplyClient(m.cli.Conn())
+	return client.AuthorLikeReply(ctx, in, opts...)
+}
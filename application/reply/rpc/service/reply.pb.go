@@ -257,11 +257,16 @@ type RepliesRequest struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	TargetId int64                  `protobuf:"varint,1,opt,name=targetId,proto3" json:"targetId,omitempty"`
 	ParentId int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	Cursor   int64                  `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	PageSize int64                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
-	SortType int32                  `protobuf:"varint,5,opt,name=sortType,proto3" json:"sortType,omitempty"`
-	// 用于和cursor一起区分到哪一页了的
-	ReplyId       int64 `protobuf:"varint,6,opt,name=replyId,proto3" json:"replyId,omitempty"`
+	SortType int32                  `protobuf:"varint,5,opt,name=sortType,proto3" json:"sortType,omitempty"` // 0按发布时间，1按点赞数，2按热度（威尔逊得分）
+	// 查看一级评论时，大于0表示在每条一级评论中内嵌前previewSize条二级评论，最多10条
+	PreviewSize int64 `protobuf:"varint,7,opt,name=previewSize,proto3" json:"previewSize,omitempty"`
+	// 内嵌二级评论的排序方式，取值和sortType一样
+	PreviewSortType int32 `protobuf:"varint,8,opt,name=previewSortType,proto3" json:"previewSortType,omitempty"`
+	// 查看评论的用户，大于0时过滤掉他拉黑的用户的评论
+	UserId int64 `protobuf:"varint,9,opt,name=userId,proto3" json:"userId,omitempty"`
+	// 上一页返回的nextPageToken，第一页传空
+	PageToken     string `protobuf:"bytes,10,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -310,45 +315,66 @@ func (x *RepliesRequest) GetParentId() int64 {
 	return 0
 }
 
-func (x *RepliesRequest) GetCursor() int64 {
+func (x *RepliesRequest) GetPageSize() int64 {
 	if x != nil {
-		return x.Cursor
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *RepliesRequest) GetPageSize() int64 {
+func (x *RepliesRequest) GetSortType() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.SortType
 	}
 	return 0
 }
 
-func (x *RepliesRequest) GetSortType() int32 {
+func (x *RepliesRequest) GetPreviewSize() int64 {
 	if x != nil {
-		return x.SortType
+		return x.PreviewSize
 	}
 	return 0
 }
 
-func (x *RepliesRequest) GetReplyId() int64 {
+func (x *RepliesRequest) GetPreviewSortType() int32 {
 	if x != nil {
-		return x.ReplyId
+		return x.PreviewSortType
 	}
 	return 0
 }
 
+func (x *RepliesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RepliesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ReplyItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	ReplyUserId   int64                  `protobuf:"varint,2,opt,name=replyUserId,proto3" json:"replyUserId,omitempty"`
-	BeReplyUserId int64                  `protobuf:"varint,3,opt,name=beReplyUserId,proto3" json:"beReplyUserId,omitempty"`       //用于二级评论展示在回复谁
-	ParentId      int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` //拥有查看一级评论的二级评论能在最上面看到一级评论
-	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	LikeCount     int64                  `protobuf:"varint,6,opt,name=likeCount,proto3" json:"likeCount,omitempty"`
-	CreateTime    int64                  `protobuf:"varint,7,opt,name=createTime,proto3" json:"createTime,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ReplyUserId    int64                  `protobuf:"varint,2,opt,name=replyUserId,proto3" json:"replyUserId,omitempty"`
+	BeReplyUserId  int64                  `protobuf:"varint,3,opt,name=beReplyUserId,proto3" json:"beReplyUserId,omitempty"`       //用于二级评论展示在回复谁
+	ParentId       int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` //拥有查看一级评论的二级评论能在最上面看到一级评论
+	Content        string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	LikeCount      int64                  `protobuf:"varint,6,opt,name=likeCount,proto3" json:"likeCount,omitempty"`
+	CreateTime     int64                  `protobuf:"varint,7,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	DislikeCount   int64                  `protobuf:"varint,8,opt,name=dislikeCount,proto3" json:"dislikeCount,omitempty"`
+	HotScore       int64                  `protobuf:"varint,9,opt,name=hotScore,proto3" json:"hotScore,omitempty"`             // 热度分，sortType为热度时作为cursor
+	SubReplyCount  int64                  `protobuf:"varint,10,opt,name=subReplyCount,proto3" json:"subReplyCount,omitempty"`  // 一级评论下的回复数，二级评论为0
+	PreviewReplies []*ReplyItem           `protobuf:"bytes,11,rep,name=previewReplies,proto3" json:"previewReplies,omitempty"` // 内嵌的前几条二级评论，只有请求了previewSize才有
+	IsPinned       bool                   `protobuf:"varint,12,opt,name=isPinned,proto3" json:"isPinned,omitempty"`            // 是否是作者置顶的评论
+	AuthorLiked    bool                   `protobuf:"varint,13,opt,name=authorLiked,proto3" json:"authorLiked,omitempty"`      // 是否被作者赞过
+	Mentions       []*MentionSpan         `protobuf:"bytes,14,rep,name=mentions,proto3" json:"mentions,omitempty"`             // 内容中的@片段
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ReplyItem) Reset() {
@@ -430,20 +456,137 @@ func (x *ReplyItem) GetCreateTime() int64 {
 	return 0
 }
 
+func (x *ReplyItem) GetDislikeCount() int64 {
+	if x != nil {
+		return x.DislikeCount
+	}
+	return 0
+}
+
+func (x *ReplyItem) GetHotScore() int64 {
+	if x != nil {
+		return x.HotScore
+	}
+	return 0
+}
+
+func (x *ReplyItem) GetSubReplyCount() int64 {
+	if x != nil {
+		return x.SubReplyCount
+	}
+	return 0
+}
+
+func (x *ReplyItem) GetPreviewReplies() []*ReplyItem {
+	if x != nil {
+		return x.PreviewReplies
+	}
+	return nil
+}
+
+func (x *ReplyItem) GetIsPinned() bool {
+	if x != nil {
+		return x.IsPinned
+	}
+	return false
+}
+
+func (x *ReplyItem) GetAuthorLiked() bool {
+	if x != nil {
+		return x.AuthorLiked
+	}
+	return false
+}
+
+func (x *ReplyItem) GetMentions() []*MentionSpan {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+// MentionSpan 内容中的一个@片段，offset和length按字符计算
+type MentionSpan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length        int32                  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MentionSpan) Reset() {
+	*x = MentionSpan{}
+	mi := &file_reply_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MentionSpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MentionSpan) ProtoMessage() {}
+
+func (x *MentionSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_reply_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MentionSpan.ProtoReflect.Descriptor instead.
+func (*MentionSpan) Descriptor() ([]byte, []int) {
+	return file_reply_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MentionSpan) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MentionSpan) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MentionSpan) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *MentionSpan) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
 type RepliesResponse struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Replies []*ReplyItem           `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"`
 	IsEnd   bool                   `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
-	Cursor  int64                  `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	// 用于和cursor一起区分到哪一页了的
-	ReplyId       int64 `protobuf:"varint,4,opt,name=replyId,proto3" json:"replyId,omitempty"`
+	// 不透明的分页游标，原样传给下一页
+	NextPageToken string `protobuf:"bytes,5,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RepliesResponse) Reset() {
 	*x = RepliesResponse{}
-	mi := &file_reply_proto_msgTypes[6]
+	mi := &file_reply_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -455,7 +598,7 @@ func (x *RepliesResponse) String() string {
 func (*RepliesResponse) ProtoMessage() {}
 
 func (x *RepliesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reply_proto_msgTypes[6]
+	mi := &file_reply_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +611,7 @@ func (x *RepliesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepliesResponse.ProtoReflect.Descriptor instead.
 func (*RepliesResponse) Descriptor() ([]byte, []int) {
-	return file_reply_proto_rawDescGZIP(), []int{6}
+	return file_reply_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RepliesResponse) GetReplies() []*ReplyItem {
@@ -485,34 +628,27 @@ func (x *RepliesResponse) GetIsEnd() bool {
 	return false
 }
 
-func (x *RepliesResponse) GetCursor() int64 {
-	if x != nil {
-		return x.Cursor
-	}
-	return 0
-}
-
-func (x *RepliesResponse) GetReplyId() int64 {
+func (x *RepliesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.ReplyId
+		return x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
+// 类似于b站的查看对话功能
 type GetReplyThreadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BizId         string                 `protobuf:"bytes,1,opt,name=bizId,proto3" json:"bizId,omitempty"`              // 业务类型（如 "article"）
 	RootReplyId   int64                  `protobuf:"varint,2,opt,name=rootReplyId,proto3" json:"rootReplyId,omitempty"` // 要查看的对话根评论 ID
-	Cursor        int64                  `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`           // 分页游标
 	PageSize      int64                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`       // 每页数量
-	ReplyId       int64                  `protobuf:"varint,5,opt,name=replyId,proto3" json:"replyId,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`      // 上一页返回的nextPageToken，第一页传空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetReplyThreadRequest) Reset() {
 	*x = GetReplyThreadRequest{}
-	mi := &file_reply_proto_msgTypes[7]
+	mi := &file_reply_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -524,7 +660,7 @@ func (x *GetReplyThreadRequest) String() string {
 func (*GetReplyThreadRequest) ProtoMessage() {}
 
 func (x *GetReplyThreadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reply_proto_msgTypes[7]
+	mi := &file_reply_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +673,7 @@ func (x *GetReplyThreadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReplyThreadRequest.ProtoReflect.Descriptor instead.
 func (*GetReplyThreadRequest) Descriptor() ([]byte, []int) {
-	return file_reply_proto_rawDescGZIP(), []int{7}
+	return file_reply_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetReplyThreadRequest) GetBizId() string {
@@ -554,13 +690,6 @@ func (x *GetReplyThreadRequest) GetRootReplyId() int64 {
 	return 0
 }
 
-func (x *GetReplyThreadRequest) GetCursor() int64 {
-	if x != nil {
-		return x.Cursor
-	}
-	return 0
-}
-
 func (x *GetReplyThreadRequest) GetPageSize() int64 {
 	if x != nil {
 		return x.PageSize
@@ -568,26 +697,25 @@ func (x *GetReplyThreadRequest) GetPageSize() int64 {
 	return 0
 }
 
-func (x *GetReplyThreadRequest) GetReplyId() int64 {
+func (x *GetReplyThreadRequest) GetPageToken() string {
 	if x != nil {
-		return x.ReplyId
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
 type GetReplyThreadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Replies       []*ReplyItem           `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"` // 返回对话链中的所有评论
-	IsEnd         bool                   `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`    // 是否已加载完
-	Cursor        int64                  `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`  // 下一页游标
-	ReplyId       int64                  `protobuf:"varint,4,opt,name=replyId,proto3" json:"replyId,omitempty"`
+	Replies       []*ReplyItem           `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"`             // 返回对话链中的所有评论
+	IsEnd         bool                   `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`                // 是否已加载完
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 下一页游标
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetReplyThreadResponse) Reset() {
 	*x = GetReplyThreadResponse{}
-	mi := &file_reply_proto_msgTypes[8]
+	mi := &file_reply_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +727,7 @@ func (x *GetReplyThreadResponse) String() string {
 func (*GetReplyThreadResponse) ProtoMessage() {}
 
 func (x *GetReplyThreadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reply_proto_msgTypes[8]
+	mi := &file_reply_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,7 +740,7 @@ func (x *GetReplyThreadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReplyThreadResponse.ProtoReflect.Descriptor instead.
 func (*GetReplyThreadResponse) Descriptor() ([]byte, []int) {
-	return file_reply_proto_rawDescGZIP(), []int{8}
+	return file_reply_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetReplyThreadResponse) GetReplies() []*ReplyItem {
@@ -629,20 +757,293 @@ func (x *GetReplyThreadResponse) GetIsEnd() bool {
 	return false
 }
 
-func (x *GetReplyThreadResponse) GetCursor() int64 {
+func (x *GetReplyThreadResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type PinReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"` // 操作用户，必须是文章作者
+	TargetId      int64                  `protobuf:"varint,2,opt,name=targetId,proto3" json:"targetId,omitempty"`
+	ReplyId       int64                  `protobuf:"varint,3,opt,name=replyId,proto3" json:"replyId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinReplyRequest) Reset() {
+	*x = PinReplyRequest{}
+	mi := &file_reply_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinReplyRequest) ProtoMessage() {}
+
+func (x *PinReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reply_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinReplyRequest.ProtoReflect.Descriptor instead.
+func (*PinReplyRequest) Descriptor() ([]byte, []int) {
+	return file_reply_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PinReplyRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PinReplyRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *PinReplyRequest) GetReplyId() int64 {
+	if x != nil {
+		return x.ReplyId
+	}
+	return 0
+}
+
+type PinReplyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinReplyResponse) Reset() {
+	*x = PinReplyResponse{}
+	mi := &file_reply_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinReplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinReplyResponse) ProtoMessage() {}
+
+func (x *PinReplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reply_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinReplyResponse.ProtoReflect.Descriptor instead.
+func (*PinReplyResponse) Descriptor() ([]byte, []int) {
+	return file_reply_proto_rawDescGZIP(), []int{11}
+}
+
+type UnpinReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=targetId,proto3" json:"targetId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinReplyRequest) Reset() {
+	*x = UnpinReplyRequest{}
+	mi := &file_reply_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinReplyRequest) ProtoMessage() {}
+
+func (x *UnpinReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reply_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinReplyRequest.ProtoReflect.Descriptor instead.
+func (*UnpinReplyRequest) Descriptor() ([]byte, []int) {
+	return file_reply_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnpinReplyRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnpinReplyRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type UnpinReplyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinReplyResponse) Reset() {
+	*x = UnpinReplyResponse{}
+	mi := &file_reply_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinReplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinReplyResponse) ProtoMessage() {}
+
+func (x *UnpinReplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reply_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinReplyResponse.ProtoReflect.Descriptor instead.
+func (*UnpinReplyResponse) Descriptor() ([]byte, []int) {
+	return file_reply_proto_rawDescGZIP(), []int{13}
+}
+
+type AuthorLikeReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"` // 操作用户，必须是评论所在文章的作者
+	ReplyId       int64                  `protobuf:"varint,2,opt,name=replyId,proto3" json:"replyId,omitempty"`
+	Action        int32                  `protobuf:"varint,3,opt,name=action,proto3" json:"action,omitempty"` // 0点赞，1取消点赞
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorLikeReplyRequest) Reset() {
+	*x = AuthorLikeReplyRequest{}
+	mi := &file_reply_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorLikeReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorLikeReplyRequest) ProtoMessage() {}
+
+func (x *AuthorLikeReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reply_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorLikeReplyRequest.ProtoReflect.Descriptor instead.
+func (*AuthorLikeReplyRequest) Descriptor() ([]byte, []int) {
+	return file_reply_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuthorLikeReplyRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Cursor
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetReplyThreadResponse) GetReplyId() int64 {
+func (x *AuthorLikeReplyRequest) GetReplyId() int64 {
 	if x != nil {
 		return x.ReplyId
 	}
 	return 0
 }
 
+func (x *AuthorLikeReplyRequest) GetAction() int32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+type AuthorLikeReplyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorLikeReplyResponse) Reset() {
+	*x = AuthorLikeReplyResponse{}
+	mi := &file_reply_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorLikeReplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorLikeReplyResponse) ProtoMessage() {}
+
+func (x *AuthorLikeReplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reply_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorLikeReplyResponse.ProtoReflect.Descriptor instead.
+func (*AuthorLikeReplyResponse) Descriptor() ([]byte, []int) {
+	return file_reply_proto_rawDescGZIP(), []int{15}
+}
+
 var File_reply_proto protoreflect.FileDescriptor
 
 const file_reply_proto_rawDesc = "" +
@@ -662,14 +1063,17 @@ const file_reply_proto_rawDesc = "" +
 	"\btargetId\x18\x02 \x01(\x03R\btargetId\x12\x1a\n" +
 	"\bparentId\x18\x03 \x01(\x03R\bparentId\x12\x18\n" +
 	"\areplyId\x18\x04 \x01(\x03R\areplyId\"\x15\n" +
-	"\x13ReplyDeleteResponse\"\xb3\x01\n" +
+	"\x13ReplyDeleteResponse\"\xa0\x02\n" +
 	"\x0eRepliesRequest\x12\x1a\n" +
 	"\btargetId\x18\x01 \x01(\x03R\btargetId\x12\x1b\n" +
-	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12\x16\n" +
-	"\x06cursor\x18\x03 \x01(\x03R\x06cursor\x12\x1a\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12\x1a\n" +
 	"\bpageSize\x18\x04 \x01(\x03R\bpageSize\x12\x1a\n" +
-	"\bsortType\x18\x05 \x01(\x05R\bsortType\x12\x18\n" +
-	"\areplyId\x18\x06 \x01(\x03R\areplyId\"\xd8\x01\n" +
+	"\bsortType\x18\x05 \x01(\x05R\bsortType\x12 \n" +
+	"\vpreviewSize\x18\a \x01(\x03R\vpreviewSize\x12(\n" +
+	"\x0fpreviewSortType\x18\b \x01(\x05R\x0fpreviewSortType\x12\x16\n" +
+	"\x06userId\x18\t \x01(\x03R\x06userId\x12\x1c\n" +
+	"\tpageToken\x18\n" +
+	" \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04J\x04\b\x06\x10\aR\x06cursorR\areplyId\"\xea\x03\n" +
 	"\tReplyItem\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12 \n" +
 	"\vreplyUserId\x18\x02 \x01(\x03R\vreplyUserId\x12$\n" +
@@ -679,28 +1083,56 @@ const file_reply_proto_rawDesc = "" +
 	"\tlikeCount\x18\x06 \x01(\x03R\tlikeCount\x12\x1e\n" +
 	"\n" +
 	"createTime\x18\a \x01(\x03R\n" +
-	"createTime\"\x87\x01\n" +
+	"createTime\x12\"\n" +
+	"\fdislikeCount\x18\b \x01(\x03R\fdislikeCount\x12\x1a\n" +
+	"\bhotScore\x18\t \x01(\x03R\bhotScore\x12$\n" +
+	"\rsubReplyCount\x18\n" +
+	" \x01(\x03R\rsubReplyCount\x12:\n" +
+	"\x0epreviewReplies\x18\v \x03(\v2\x12.service.ReplyItemR\x0epreviewReplies\x12\x1a\n" +
+	"\bisPinned\x18\f \x01(\bR\bisPinned\x12 \n" +
+	"\vauthorLiked\x18\r \x01(\bR\vauthorLiked\x120\n" +
+	"\bmentions\x18\x0e \x03(\v2\x14.service.MentionSpanR\bmentions\"q\n" +
+	"\vMentionSpan\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06length\x18\x04 \x01(\x05R\x06length\"\x98\x01\n" +
 	"\x0fRepliesResponse\x12,\n" +
 	"\areplies\x18\x01 \x03(\v2\x12.service.ReplyItemR\areplies\x12\x14\n" +
-	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12\x16\n" +
-	"\x06cursor\x18\x03 \x01(\x03R\x06cursor\x12\x18\n" +
-	"\areplyId\x18\x04 \x01(\x03R\areplyId\"\x9d\x01\n" +
+	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x05 \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x06cursorR\areplyId\"\xa6\x01\n" +
 	"\x15GetReplyThreadRequest\x12\x14\n" +
 	"\x05bizId\x18\x01 \x01(\tR\x05bizId\x12 \n" +
-	"\vrootReplyId\x18\x02 \x01(\x03R\vrootReplyId\x12\x16\n" +
-	"\x06cursor\x18\x03 \x01(\x03R\x06cursor\x12\x1a\n" +
-	"\bpageSize\x18\x04 \x01(\x03R\bpageSize\x12\x18\n" +
-	"\areplyId\x18\x05 \x01(\x03R\areplyId\"\x8e\x01\n" +
+	"\vrootReplyId\x18\x02 \x01(\x03R\vrootReplyId\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x06 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04J\x04\b\x05\x10\x06R\x06cursorR\areplyId\"\x9f\x01\n" +
 	"\x16GetReplyThreadResponse\x12,\n" +
 	"\areplies\x18\x01 \x03(\v2\x12.service.ReplyItemR\areplies\x12\x14\n" +
-	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12\x16\n" +
-	"\x06cursor\x18\x03 \x01(\x03R\x06cursor\x12\x18\n" +
-	"\areplyId\x18\x04 \x01(\x03R\areplyId2\xaf\x02\n" +
+	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x05 \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x06cursorR\areplyId\"_\n" +
+	"\x0fPinReplyRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\btargetId\x18\x02 \x01(\x03R\btargetId\x12\x18\n" +
+	"\areplyId\x18\x03 \x01(\x03R\areplyId\"\x12\n" +
+	"\x10PinReplyResponse\"G\n" +
+	"\x11UnpinReplyRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\btargetId\x18\x02 \x01(\x03R\btargetId\"\x14\n" +
+	"\x12UnpinReplyResponse\"b\n" +
+	"\x16AuthorLikeReplyRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\areplyId\x18\x02 \x01(\x03R\areplyId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\x05R\x06action\"\x19\n" +
+	"\x17AuthorLikeReplyResponse2\x8d\x04\n" +
 	"\x05Reply\x12K\n" +
 	"\fReplyPublish\x12\x1c.service.ReplyPublishRequest\x1a\x1d.service.ReplyPublishResponse\x12H\n" +
 	"\vReplyDelete\x12\x1b.service.ReplyDeleteRequest\x1a\x1c.service.ReplyDeleteResponse\x12<\n" +
 	"\aReplies\x12\x17.service.RepliesRequest\x1a\x18.service.RepliesResponse\x12Q\n" +
-	"\x0eGetReplyThread\x12\x1e.service.GetReplyThreadRequest\x1a\x1f.service.GetReplyThreadResponseB\vZ\t./serviceb\x06proto3"
+	"\x0eGetReplyThread\x12\x1e.service.GetReplyThreadRequest\x1a\x1f.service.GetReplyThreadResponse\x12?\n" +
+	"\bPinReply\x12\x18.service.PinReplyRequest\x1a\x19.service.PinReplyResponse\x12E\n" +
+	"\n" +
+	"UnpinReply\x12\x1a.service.UnpinReplyRequest\x1a\x1b.service.UnpinReplyResponse\x12T\n" +
+	"\x0fAuthorLikeReply\x12\x1f.service.AuthorLikeReplyRequest\x1a .service.AuthorLikeReplyResponseB\vZ\t./serviceb\x06proto3"
 
 var (
 	file_reply_proto_rawDescOnce sync.Once
@@ -714,34 +1146,49 @@ func file_reply_proto_rawDescGZIP() []byte {
 	return file_reply_proto_rawDescData
 }
 
-var file_reply_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_reply_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_reply_proto_goTypes = []any{
-	(*ReplyPublishRequest)(nil),    // 0: service.ReplyPublishRequest
-	(*ReplyPublishResponse)(nil),   // 1: service.ReplyPublishResponse
-	(*ReplyDeleteRequest)(nil),     // 2: service.ReplyDeleteRequest
-	(*ReplyDeleteResponse)(nil),    // 3: service.ReplyDeleteResponse
-	(*RepliesRequest)(nil),         // 4: service.RepliesRequest
-	(*ReplyItem)(nil),              // 5: service.ReplyItem
-	(*RepliesResponse)(nil),        // 6: service.RepliesResponse
-	(*GetReplyThreadRequest)(nil),  // 7: service.GetReplyThreadRequest
-	(*GetReplyThreadResponse)(nil), // 8: service.GetReplyThreadResponse
+	(*ReplyPublishRequest)(nil),     // 0: service.ReplyPublishRequest
+	(*ReplyPublishResponse)(nil),    // 1: service.ReplyPublishResponse
+	(*ReplyDeleteRequest)(nil),      // 2: service.ReplyDeleteRequest
+	(*ReplyDeleteResponse)(nil),     // 3: service.ReplyDeleteResponse
+	(*RepliesRequest)(nil),          // 4: service.RepliesRequest
+	(*ReplyItem)(nil),               // 5: service.ReplyItem
+	(*MentionSpan)(nil),             // 6: service.MentionSpan
+	(*RepliesResponse)(nil),         // 7: service.RepliesResponse
+	(*GetReplyThreadRequest)(nil),   // 8: service.GetReplyThreadRequest
+	(*GetReplyThreadResponse)(nil),  // 9: service.GetReplyThreadResponse
+	(*PinReplyRequest)(nil),         // 10: service.PinReplyRequest
+	(*PinReplyResponse)(nil),        // 11: service.PinReplyResponse
+	(*UnpinReplyRequest)(nil),       // 12: service.UnpinReplyRequest
+	(*UnpinReplyResponse)(nil),      // 13: service.UnpinReplyResponse
+	(*AuthorLikeReplyRequest)(nil),  // 14: service.AuthorLikeReplyRequest
+	(*AuthorLikeReplyResponse)(nil), // 15: service.AuthorLikeReplyResponse
 }
 var file_reply_proto_depIdxs = []int32{
-	5, // 0: service.RepliesResponse.replies:type_name -> service.ReplyItem
-	5, // 1: service.GetReplyThreadResponse.replies:type_name -> service.ReplyItem
-	0, // 2: service.Reply.ReplyPublish:input_type -> service.ReplyPublishRequest
-	2, // 3: service.Reply.ReplyDelete:input_type -> service.ReplyDeleteRequest
-	4, // 4: service.Reply.Replies:input_type -> service.RepliesRequest
-	7, // 5: service.Reply.GetReplyThread:input_type -> service.GetReplyThreadRequest
-	1, // 6: service.Reply.ReplyPublish:output_type -> service.ReplyPublishResponse
-	3, // 7: service.Reply.ReplyDelete:output_type -> service.ReplyDeleteResponse
-	6, // 8: service.Reply.Replies:output_type -> service.RepliesResponse
-	8, // 9: service.Reply.GetReplyThread:output_type -> service.GetReplyThreadResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5,  // 0: service.ReplyItem.previewReplies:type_name -> service.ReplyItem
+	6,  // 1: service.ReplyItem.mentions:type_name -> service.MentionSpan
+	5,  // 2: service.RepliesResponse.replies:type_name -> service.ReplyItem
+	5,  // 3: service.GetReplyThreadResponse.replies:type_name -> service.ReplyItem
+	0,  // 4: service.Reply.ReplyPublish:input_type -> service.ReplyPublishRequest
+	2,  // 5: service.Reply.ReplyDelete:input_type -> service.ReplyDeleteRequest
+	4,  // 6: service.Reply.Replies:input_type -> service.RepliesRequest
+	8,  // 7: service.Reply.GetReplyThread:input_type -> service.GetReplyThreadRequest
+	10, // 8: service.Reply.PinReply:input_type -> service.PinReplyRequest
+	12, // 9: service.Reply.UnpinReply:input_type -> service.UnpinReplyRequest
+	14, // 10: service.Reply.AuthorLikeReply:input_type -> service.AuthorLikeReplyRequest
+	1,  // 11: service.Reply.ReplyPublish:output_type -> service.ReplyPublishResponse
+	3,  // 12: service.Reply.ReplyDelete:output_type -> service.ReplyDeleteResponse
+	7,  // 13: service.Reply.Replies:output_type -> service.RepliesResponse
+	9,  // 14: service.Reply.GetReplyThread:output_type -> service.GetReplyThreadResponse
+	11, // 15: service.Reply.PinReply:output_type -> service.PinReplyResponse
+	13, // 16: service.Reply.UnpinReply:output_type -> service.UnpinReplyResponse
+	15, // 17: service.Reply.AuthorLikeReply:output_type -> service.AuthorLikeReplyResponse
+	11, // [11:18] is the sub-list for method output_type
+	4,  // [4:11] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_reply_proto_init() }
@@ -755,7 +1202,7 @@ func file_reply_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reply_proto_rawDesc), len(file_reply_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Reply_ReplyPublish_FullMethodName   = "/service.Reply/ReplyPublish"
-	Reply_ReplyDelete_FullMethodName    = "/service.Reply/ReplyDelete"
-	Reply_Replies_FullMethodName        = "/service.Reply/Replies"
-	Reply_GetReplyThread_FullMethodName = "/service.Reply/GetReplyThread"
+	Reply_ReplyPublish_FullMethodName    = "/service.Reply/ReplyPublish"
+	Reply_ReplyDelete_FullMethodName     = "/service.Reply/ReplyDelete"
+	Reply_Replies_FullMethodName         = "/service.Reply/Replies"
+	Reply_GetReplyThread_FullMethodName  = "/service.Reply/GetReplyThread"
+	Reply_PinReply_FullMethodName        = "/service.Reply/PinReply"
+	Reply_UnpinReply_FullMethodName      = "/service.Reply/UnpinReply"
+	Reply_AuthorLikeReply_FullMethodName = "/service.Reply/AuthorLikeReply"
 )
 
 // ReplyClient is the client API for Reply service.
@@ -35,6 +38,12 @@ type ReplyClient interface {
 	Replies(ctx context.Context, in *RepliesRequest, opts ...grpc.CallOption) (*RepliesResponse, error)
 	// 可以查看二级评论下的对话链。
 	GetReplyThread(ctx context.Context, in *GetReplyThreadRequest, opts ...grpc.CallOption) (*GetReplyThreadResponse, error)
+	// 文章作者置顶一条一级评论，已有置顶时替换
+	PinReply(ctx context.Context, in *PinReplyRequest, opts ...grpc.CallOption) (*PinReplyResponse, error)
+	// 文章作者取消置顶
+	UnpinReply(ctx context.Context, in *UnpinReplyRequest, opts ...grpc.CallOption) (*UnpinReplyResponse, error)
+	// 文章作者给评论点赞或取消点赞，评论会展示"作者赞过"
+	AuthorLikeReply(ctx context.Context, in *AuthorLikeReplyRequest, opts ...grpc.CallOption) (*AuthorLikeReplyResponse, error)
 }
 
 type replyClient struct {
@@ -85,6 +94,36 @@ func (c *replyClient) GetReplyThread(ctx context.Context, in *GetReplyThreadRequ
 	return out, nil
 }
 
+func (c *replyClient) PinReply(ctx context.Context, in *PinReplyRequest, opts ...grpc.CallOption) (*PinReplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinReplyResponse)
+	err := c.cc.Invoke(ctx, Reply_PinReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replyClient) UnpinReply(ctx context.Context, in *UnpinReplyRequest, opts ...grpc.CallOption) (*UnpinReplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinReplyResponse)
+	err := c.cc.Invoke(ctx, Reply_UnpinReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replyClient) AuthorLikeReply(ctx context.Context, in *AuthorLikeReplyRequest, opts ...grpc.CallOption) (*AuthorLikeReplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorLikeReplyResponse)
+	err := c.cc.Invoke(ctx, Reply_AuthorLikeReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReplyServer is the server API for Reply service.
 // All implementations must embed UnimplementedReplyServer
 // for forward compatibility.
@@ -95,6 +134,12 @@ type ReplyServer interface {
 	Replies(context.Context, *RepliesRequest) (*RepliesResponse, error)
 	// 可以查看二级评论下的对话链。
 	GetReplyThread(context.Context, *GetReplyThreadRequest) (*GetReplyThreadResponse, error)
+	// 文章作者置顶一条一级评论，已有置顶时替换
+	PinReply(context.Context, *PinReplyRequest) (*PinReplyResponse, error)
+	// 文章作者取消置顶
+	UnpinReply(context.Context, *UnpinReplyRequest) (*UnpinReplyResponse, error)
+	// 文章作者给评论点赞或取消点赞，评论会展示"作者赞过"
+	AuthorLikeReply(context.Context, *AuthorLikeReplyRequest) (*AuthorLikeReplyResponse, error)
 	mustEmbedUnimplementedReplyServer()
 }
 
@@ -117,6 +162,15 @@ func (UnimplementedReplyServer) Replies(context.Context, *RepliesRequest) (*Repl
 func (UnimplementedReplyServer) GetReplyThread(context.Context, *GetReplyThreadRequest) (*GetReplyThreadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReplyThread not implemented")
 }
+func (UnimplementedReplyServer) PinReply(context.Context, *PinReplyRequest) (*PinReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinReply not implemented")
+}
+func (UnimplementedReplyServer) UnpinReply(context.Context, *UnpinReplyRequest) (*UnpinReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinReply not implemented")
+}
+func (UnimplementedReplyServer) AuthorLikeReply(context.Context, *AuthorLikeReplyRequest) (*AuthorLikeReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorLikeReply not implemented")
+}
 func (UnimplementedReplyServer) mustEmbedUnimplementedReplyServer() {}
 func (UnimplementedReplyServer) testEmbeddedByValue()               {}
 
@@ -210,6 +264,60 @@ func _Reply_GetReplyThread_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Reply_PinReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplyServer).PinReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reply_PinReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplyServer).PinReply(ctx, req.(*PinReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reply_UnpinReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplyServer).UnpinReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reply_UnpinReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplyServer).UnpinReply(ctx, req.(*UnpinReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reply_AuthorLikeReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorLikeReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplyServer).AuthorLikeReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reply_AuthorLikeReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplyServer).AuthorLikeReply(ctx, req.(*AuthorLikeReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Reply_ServiceDesc is the grpc.ServiceDesc for Reply service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +341,18 @@ var Reply_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReplyThread",
 			Handler:    _Reply_GetReplyThread_Handler,
 		},
+		{
+			MethodName: "PinReply",
+			Handler:    _Reply_PinReply_Handler,
+		},
+		{
+			MethodName: "UnpinReply",
+			Handler:    _Reply_UnpinReply_Handler,
+		},
+		{
+			MethodName: "AuthorLikeReply",
+			Handler:    _Reply_AuthorLikeReply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "reply.proto",
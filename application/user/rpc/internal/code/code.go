@@ -6,4 +6,7 @@ import (
 
 var (
 	RegisterNameEmpty = xcode.New(20001, "注册名字不能为空") // 注册名字为空
+	UserIdInvalid     = xcode.New(20002, "用户ID无效")   // 用户ID无效
+	MentionBizInvalid = xcode.New(20003, "@业务类型无效")  // @业务类型无效
+	PageTokenInvalid  = xcode.New(20004, "分页游标无效")   // 分页游标无效
 )
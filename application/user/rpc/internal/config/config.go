@@ -13,4 +13,11 @@ type Config struct {
 	CacheRedis cache.CacheConf
 	BizRedis   redis.RedisConf
 	Consul     consul.Conf
+	// CursorSecret 分页游标的签名密钥
+	CursorSecret string
+	// 被@时发送通知事件
+	KqPusherConf struct {
+		Brokers []string
+		Topic   string
+	}
 }
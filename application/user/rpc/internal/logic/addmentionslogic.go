@@ -0,0 +1,95 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"posta/application/user/rpc/internal/code"
+	"posta/application/user/rpc/internal/model"
+	"posta/application/user/rpc/internal/svc"
+	"posta/application/user/rpc/internal/types"
+	"posta/application/user/rpc/service"
+	"posta/pkg/mention"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+type AddMentionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAddMentionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddMentionsLogic {
+	return &AddMentionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AddMentions 记录一段内容中@到的用户，每个新的@都会发送一条通知事件到kafka
+func (l *AddMentionsLogic) AddMentions(in *service.AddMentionsRequest) (*service.AddMentionsResponse, error) {
+	if in.BizId != mention.BizArticle && in.BizId != mention.BizReply {
+		return nil, code.MentionBizInvalid
+	}
+	if in.FromUserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+
+	var msgs []*types.MentionMsg
+	for i, user := range in.Users {
+		if i >= mention.MaxMentions {
+			break
+		}
+		// 自己@自己不记录
+		if user.UserId <= 0 || user.UserId == in.FromUserId {
+			continue
+		}
+		data := &model.Mention{
+			BizId:      in.BizId,
+			ObjId:      in.ObjId,
+			FromUserId: in.FromUserId,
+			UserId:     user.UserId,
+			Username:   user.Username,
+		}
+		mentionId, err := l.svcCtx.MentionModel.InsertIgnore(l.ctx, data)
+		if err != nil {
+			l.Logger.Errorf("MentionModel.InsertIgnore data: %+v error: %v", data, err)
+			return nil, err
+		}
+		if mentionId == 0 {
+			continue
+		}
+		msgs = append(msgs, &types.MentionMsg{
+			MentionId:  mentionId,
+			BizId:      in.BizId,
+			ObjId:      in.ObjId,
+			FromUserId: in.FromUserId,
+			UserId:     user.UserId,
+			CreateTime: time.Now().Unix(),
+		})
+	}
+
+	// 发送kafka消息，异步
+	if len(msgs) > 0 {
+		threading.GoSafe(func() {
+			ctx := context.Background()
+			for _, msg := range msgs {
+				data, err := json.Marshal(msg)
+				if err != nil {
+					l.Logger.Errorf("MentionMsg marshal msg: %v error: %v", msg, err)
+					continue
+				}
+				err = l.svcCtx.KqPusherClient.Push(ctx, string(data))
+				if err != nil {
+					l.Logger.Errorf("MentionMsg kq push data: %s error: %v", data, err)
+				}
+			}
+		})
+	}
+
+	return &service.AddMentionsResponse{}, nil
+}
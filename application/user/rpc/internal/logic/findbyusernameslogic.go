@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/user/rpc/internal/svc"
+	"posta/application/user/rpc/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FindByUsernamesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFindByUsernamesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FindByUsernamesLogic {
+	return &FindByUsernamesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// FindByUsernames 根据用户名批量查询用户，用于把@username解析成用户ID，不存在的用户名直接忽略
+func (l *FindByUsernamesLogic) FindByUsernames(in *service.FindByUsernamesRequest) (*service.FindByUsernamesResponse, error) {
+	if len(in.Usernames) == 0 {
+		return &service.FindByUsernamesResponse{}, nil
+	}
+
+	users, err := l.svcCtx.UserModel.FindByUsernames(l.ctx, in.Usernames)
+	if err != nil {
+		l.Logger.Errorf("FindByUsernames usernames: %v error: %v", in.Usernames, err)
+		return nil, err
+	}
+
+	var (
+		seen  = make(map[string]struct{}, len(users))
+		items = make([]*service.UserItem, 0, len(users))
+	)
+	for _, user := range users {
+		// 用户名重复时取最早注册的用户
+		if _, ok := seen[user.Username]; ok {
+			continue
+		}
+		seen[user.Username] = struct{}{}
+		items = append(items, &service.UserItem{
+			UserId:   user.Id,
+			Username: user.Username,
+			Avatar:   user.Avatar,
+		})
+	}
+
+	return &service.FindByUsernamesResponse{Users: items}, nil
+}
@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"context"
+
+	"posta/application/user/rpc/internal/code"
+	"posta/application/user/rpc/internal/svc"
+	"posta/application/user/rpc/service"
+	"posta/pkg/mention"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MentionsByObjsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMentionsByObjsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MentionsByObjsLogic {
+	return &MentionsByObjsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// MentionsByObjs 批量查询一批内容中@到的用户，文章和评论列表用它渲染@片段
+func (l *MentionsByObjsLogic) MentionsByObjs(in *service.MentionsByObjsRequest) (*service.MentionsByObjsResponse, error) {
+	if in.BizId != mention.BizArticle && in.BizId != mention.BizReply {
+		return nil, code.MentionBizInvalid
+	}
+	if len(in.ObjIds) == 0 {
+		return &service.MentionsByObjsResponse{}, nil
+	}
+
+	mentions, err := l.svcCtx.MentionModel.MentionsByObjIds(l.ctx, in.BizId, in.ObjIds)
+	if err != nil {
+		l.Logger.Errorf("MentionModel.MentionsByObjIds req: %v error: %v", in, err)
+		return nil, err
+	}
+
+	items := make([]*service.MentionItem, 0, len(mentions))
+	for _, m := range mentions {
+		items = append(items, &service.MentionItem{
+			Id:         m.Id,
+			BizId:      m.BizId,
+			ObjId:      m.ObjId,
+			FromUserId: m.FromUserId,
+			UserId:     m.UserId,
+			Username:   m.Username,
+			CreateTime: m.CreateTime.Unix(),
+		})
+	}
+
+	return &service.MentionsByObjsResponse{Mentions: items}, nil
+}
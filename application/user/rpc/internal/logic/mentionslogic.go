@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"posta/application/user/rpc/internal/code"
+	"posta/application/user/rpc/internal/svc"
+	"posta/application/user/rpc/internal/types"
+	"posta/application/user/rpc/service"
+	"posta/pkg/cursor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MentionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMentionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MentionsLogic {
+	return &MentionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Mentions @我的列表（按@时间倒序）
+func (l *MentionsLogic) Mentions(in *service.MentionsRequest) (*service.MentionsResponse, error) {
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	in.PageSize = min(in.PageSize, types.MaxPageSize)
+	scope := fmt.Sprintf("mentions#%d", in.UserId)
+	pageCursor, err := l.svcCtx.CursorCodec.Decode(scope, in.PageToken)
+	if err != nil {
+		return nil, code.PageTokenInvalid
+	}
+	// Sort是上一页最后一条@的时间，Id是这条@记录的ID
+	if pageCursor.IsZero() {
+		pageCursor.Sort = time.Now().Unix()
+		pageCursor.Id = math.MaxInt64
+	}
+
+	mentions, err := l.svcCtx.MentionModel.MentionsByUserId(l.ctx, in.UserId,
+		time.Unix(pageCursor.Sort, 0).Format("2006-01-02 15:04:05"), pageCursor.Id, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[Mentions] MentionModel.MentionsByUserId error: %v req: %v", err, in)
+		return nil, err
+	}
+
+	ret := &service.MentionsResponse{
+		IsEnd: len(mentions) < int(in.PageSize),
+	}
+	for _, m := range mentions {
+		ret.Mentions = append(ret.Mentions, &service.MentionItem{
+			Id:         m.Id,
+			BizId:      m.BizId,
+			ObjId:      m.ObjId,
+			FromUserId: m.FromUserId,
+			UserId:     m.UserId,
+			Username:   m.Username,
+			CreateTime: m.CreateTime.Unix(),
+		})
+	}
+	if len(mentions) > 0 {
+		last := mentions[len(mentions)-1]
+		ret.NextPageToken = l.svcCtx.CursorCodec.Encode(scope, cursor.Cursor{Sort: last.CreateTime.Unix(), Id: last.Id})
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ MentionModel = (*customMentionModel)(nil)
+
+type (
+	// MentionModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customMentionModel.
+	MentionModel interface {
+		mentionModel
+		InsertIgnore(ctx context.Context, data *Mention) (int64, error)
+		MentionsByObjIds(ctx context.Context, bizId string, objIds []int64) ([]*Mention, error)
+		MentionsByUserId(ctx context.Context, userId int64, createTime string, lastId int64, limit int) ([]*Mention, error)
+	}
+
+	customMentionModel struct {
+		*defaultMentionModel
+	}
+)
+
+// NewMentionModel returns a model for the database table.
+func NewMentionModel(conn sqlx.SqlConn) MentionModel {
+	return &customMentionModel{
+		defaultMentionModel: newMentionModel(conn),
+	}
+}
+
+// InsertIgnore 同一段内容重复@同一个人只记录一次，返回新记录的ID，已存在时返回0
+func (m *customMentionModel) InsertIgnore(ctx context.Context, data *Mention) (int64, error) {
+	query := fmt.Sprintf("insert ignore into %s (%s) values (?, ?, ?, ?, ?)", m.table, mentionRowsExpectAutoSet)
+	ret, err := m.conn.ExecCtx(ctx, query, data.BizId, data.ObjId, data.FromUserId, data.UserId, data.Username)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := ret.RowsAffected()
+	if err != nil || affected == 0 {
+		return 0, err
+	}
+
+	return ret.LastInsertId()
+}
+
+// MentionsByObjIds 批量查询一批内容中的@记录，用于渲染@片段
+func (m *customMentionModel) MentionsByObjIds(ctx context.Context, bizId string, objIds []int64) ([]*Mention, error) {
+	if len(objIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(objIds)+1)
+	args = append(args, bizId)
+	for _, objId := range objIds {
+		args = append(args, objId)
+	}
+
+	var mentions []*Mention
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(objIds)), ",")
+	sql := fmt.Sprintf("select %s from %s where `biz_id` = ? and `obj_id` in (%s)", mentionRows, m.table, placeholders)
+	err := m.conn.QueryRowsCtx(ctx, &mentions, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return mentions, nil
+}
+
+// MentionsByUserId 按 (create_time, id) 倒序查询@我的记录，走 ix_user_ctime 索引
+func (m *customMentionModel) MentionsByUserId(ctx context.Context, userId int64, createTime string, lastId int64, limit int) ([]*Mention, error) {
+	var mentions []*Mention
+	sql := fmt.Sprintf("select %s from %s where `user_id` = ? and (`create_time` < ? or (`create_time` = ? and `id` < ?)) order by `create_time` desc, `id` desc limit ?", mentionRows, m.table)
+	err := m.conn.QueryRowsCtx(ctx, &mentions, sql, userId, createTime, createTime, lastId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return mentions, nil
+}
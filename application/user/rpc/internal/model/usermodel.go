@@ -3,6 +3,8 @@ package model
 import (
 	"context"
 	"fmt"
+	"strings"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
@@ -16,6 +18,7 @@ type (
 		userModel
 		// 注意：除了CURD方法外，其他方法都需要在usermodel.go中实现
 		FindByMobile(ctx context.Context, mobile string) (*User, error)
+		FindByUsernames(ctx context.Context, usernames []string) ([]*User, error)
 	}
 
 	customUserModel struct {
@@ -43,3 +46,24 @@ func (m *customUserModel) FindByMobile(ctx context.Context, mobile string) (*Use
 
 	return user, nil
 }
+
+// FindByUsernames 根据用户名批量查询用户，用户名重复时按ID升序返回，调用方取第一个
+func (m *customUserModel) FindByUsernames(ctx context.Context, usernames []string) ([]*User, error) {
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(usernames))
+	for _, username := range usernames {
+		args = append(args, username)
+	}
+
+	var users []*User
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(usernames)), ",")
+	query := fmt.Sprintf("select %s from %s where `username` in (%s) order by `id` asc", userRows, m.table, placeholders)
+	err := m.QueryRowsNoCacheCtx(ctx, &users, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
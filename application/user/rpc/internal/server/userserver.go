@@ -42,3 +42,23 @@ func (s *UserServer) SendSms(ctx context.Context, in *service.SendSmsRequest) (*
 	l := logic.NewSendSmsLogic(ctx, s.svcCtx)
 	return l.SendSms(in)
 }
+
+func (s *UserServer) FindByUsernames(ctx context.Context, in *service.FindByUsernamesRequest) (*service.FindByUsernamesResponse, error) {
+	l := logic.NewFindByUsernamesLogic(ctx, s.svcCtx)
+	return l.FindByUsernames(in)
+}
+
+func (s *UserServer) AddMentions(ctx context.Context, in *service.AddMentionsRequest) (*service.AddMentionsResponse, error) {
+	l := logic.NewAddMentionsLogic(ctx, s.svcCtx)
+	return l.AddMentions(in)
+}
+
+func (s *UserServer) MentionsByObjs(ctx context.Context, in *service.MentionsByObjsRequest) (*service.MentionsByObjsResponse, error) {
+	l := logic.NewMentionsByObjsLogic(ctx, s.svcCtx)
+	return l.MentionsByObjs(in)
+}
+
+func (s *UserServer) Mentions(ctx context.Context, in *service.MentionsRequest) (*service.MentionsResponse, error) {
+	l := logic.NewMentionsLogic(ctx, s.svcCtx)
+	return l.Mentions(in)
+}
@@ -1,21 +1,29 @@
 package svc
 
 import (
+	"github.com/zeromicro/go-queue/kq"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"posta/application/user/rpc/internal/config"
 	"posta/application/user/rpc/internal/model"
+	"posta/pkg/cursor"
 )
 
 type ServiceContext struct {
-	Config    config.Config
-	UserModel model.UserModel
+	Config         config.Config
+	UserModel      model.UserModel
+	MentionModel   model.MentionModel
+	KqPusherClient *kq.Pusher
+	CursorCodec    *cursor.Codec
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	conn := sqlx.NewMysql(c.DataSource)
 
 	return &ServiceContext{
-		Config:    c,
-		UserModel: model.NewUserModel(conn, c.CacheRedis),
+		Config:         c,
+		UserModel:      model.NewUserModel(conn, c.CacheRedis),
+		MentionModel:   model.NewMentionModel(conn),
+		KqPusherClient: kq.NewPusher(c.KqPusherConf.Brokers, c.KqPusherConf.Topic),
+		CursorCodec:    cursor.NewCodec(c.CursorSecret),
 	}
 }
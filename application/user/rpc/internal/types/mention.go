@@ -0,0 +1,17 @@
+package types
+
+// MentionMsg 被@时发送到kafka的通知事件，由通知服务消费
+type MentionMsg struct {
+	MentionId  int64  `json:"mentionId"`  // @记录ID
+	BizId      string `json:"bizId"`      // 业务类型 article:文章 reply:评论
+	ObjId      int64  `json:"objId"`      // 内容ID
+	FromUserId int64  `json:"fromUserId"` // 发起@的用户ID
+	UserId     int64  `json:"userId"`     // 被@的用户ID
+	CreateTime int64  `json:"createTime"` // @时间
+}
+
+const (
+	DefaultPageSize = 20
+	// MaxPageSize 每页最多的条数，避免一次查询太多
+	MaxPageSize = 50
+)
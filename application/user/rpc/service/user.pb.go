@@ -445,6 +445,566 @@ func (*SendSmsResponse) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{7}
 }
 
+type FindByUsernamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usernames     []string               `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindByUsernamesRequest) Reset() {
+	*x = FindByUsernamesRequest{}
+	mi := &file_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindByUsernamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByUsernamesRequest) ProtoMessage() {}
+
+func (x *FindByUsernamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByUsernamesRequest.ProtoReflect.Descriptor instead.
+func (*FindByUsernamesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FindByUsernamesRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+type UserItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Avatar        string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserItem) Reset() {
+	*x = UserItem{}
+	mi := &file_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserItem) ProtoMessage() {}
+
+func (x *UserItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserItem.ProtoReflect.Descriptor instead.
+func (*UserItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UserItem) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserItem) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserItem) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+type FindByUsernamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserItem            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindByUsernamesResponse) Reset() {
+	*x = FindByUsernamesResponse{}
+	mi := &file_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindByUsernamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByUsernamesResponse) ProtoMessage() {}
+
+func (x *FindByUsernamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByUsernamesResponse.ProtoReflect.Descriptor instead.
+func (*FindByUsernamesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FindByUsernamesResponse) GetUsers() []*UserItem {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type MentionItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BizId         string                 `protobuf:"bytes,2,opt,name=bizId,proto3" json:"bizId,omitempty"`
+	ObjId         int64                  `protobuf:"varint,3,opt,name=objId,proto3" json:"objId,omitempty"`
+	FromUserId    int64                  `protobuf:"varint,4,opt,name=fromUserId,proto3" json:"fromUserId,omitempty"`
+	UserId        int64                  `protobuf:"varint,5,opt,name=userId,proto3" json:"userId,omitempty"`
+	Username      string                 `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,7,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MentionItem) Reset() {
+	*x = MentionItem{}
+	mi := &file_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MentionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MentionItem) ProtoMessage() {}
+
+func (x *MentionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MentionItem.ProtoReflect.Descriptor instead.
+func (*MentionItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MentionItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MentionItem) GetBizId() string {
+	if x != nil {
+		return x.BizId
+	}
+	return ""
+}
+
+func (x *MentionItem) GetObjId() int64 {
+	if x != nil {
+		return x.ObjId
+	}
+	return 0
+}
+
+func (x *MentionItem) GetFromUserId() int64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *MentionItem) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MentionItem) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MentionItem) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type AddMentionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         string                 `protobuf:"bytes,1,opt,name=bizId,proto3" json:"bizId,omitempty"`
+	ObjId         int64                  `protobuf:"varint,2,opt,name=objId,proto3" json:"objId,omitempty"`
+	FromUserId    int64                  `protobuf:"varint,3,opt,name=fromUserId,proto3" json:"fromUserId,omitempty"`
+	Users         []*UserItem            `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMentionsRequest) Reset() {
+	*x = AddMentionsRequest{}
+	mi := &file_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMentionsRequest) ProtoMessage() {}
+
+func (x *AddMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMentionsRequest.ProtoReflect.Descriptor instead.
+func (*AddMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddMentionsRequest) GetBizId() string {
+	if x != nil {
+		return x.BizId
+	}
+	return ""
+}
+
+func (x *AddMentionsRequest) GetObjId() int64 {
+	if x != nil {
+		return x.ObjId
+	}
+	return 0
+}
+
+func (x *AddMentionsRequest) GetFromUserId() int64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *AddMentionsRequest) GetUsers() []*UserItem {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type AddMentionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMentionsResponse) Reset() {
+	*x = AddMentionsResponse{}
+	mi := &file_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMentionsResponse) ProtoMessage() {}
+
+func (x *AddMentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMentionsResponse.ProtoReflect.Descriptor instead.
+func (*AddMentionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{13}
+}
+
+type MentionsByObjsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         string                 `protobuf:"bytes,1,opt,name=bizId,proto3" json:"bizId,omitempty"`
+	ObjIds        []int64                `protobuf:"varint,2,rep,packed,name=objIds,proto3" json:"objIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MentionsByObjsRequest) Reset() {
+	*x = MentionsByObjsRequest{}
+	mi := &file_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MentionsByObjsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MentionsByObjsRequest) ProtoMessage() {}
+
+func (x *MentionsByObjsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MentionsByObjsRequest.ProtoReflect.Descriptor instead.
+func (*MentionsByObjsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MentionsByObjsRequest) GetBizId() string {
+	if x != nil {
+		return x.BizId
+	}
+	return ""
+}
+
+func (x *MentionsByObjsRequest) GetObjIds() []int64 {
+	if x != nil {
+		return x.ObjIds
+	}
+	return nil
+}
+
+type MentionsByObjsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mentions      []*MentionItem         `protobuf:"bytes,1,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MentionsByObjsResponse) Reset() {
+	*x = MentionsByObjsResponse{}
+	mi := &file_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MentionsByObjsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MentionsByObjsResponse) ProtoMessage() {}
+
+func (x *MentionsByObjsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MentionsByObjsResponse.ProtoReflect.Descriptor instead.
+func (*MentionsByObjsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MentionsByObjsResponse) GetMentions() []*MentionItem {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+type MentionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	PageSize      int64                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken，第一页传空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MentionsRequest) Reset() {
+	*x = MentionsRequest{}
+	mi := &file_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MentionsRequest) ProtoMessage() {}
+
+func (x *MentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MentionsRequest.ProtoReflect.Descriptor instead.
+func (*MentionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MentionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MentionsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *MentionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type MentionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mentions      []*MentionItem         `protobuf:"bytes,1,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	IsEnd         bool                   `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 不透明的分页游标，原样传给下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MentionsResponse) Reset() {
+	*x = MentionsResponse{}
+	mi := &file_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MentionsResponse) ProtoMessage() {}
+
+func (x *MentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MentionsResponse.ProtoReflect.Descriptor instead.
+func (*MentionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MentionsResponse) GetMentions() []*MentionItem {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *MentionsResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *MentionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
@@ -475,12 +1035,57 @@ const file_user_proto_rawDesc = "" +
 	"\x0eSendSmsRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
 	"\x06mobile\x18\x02 \x01(\tR\x06mobile\"\x11\n" +
-	"\x0fSendSmsResponse2\x93\x02\n" +
+	"\x0fSendSmsResponse\"6\n" +
+	"\x16FindByUsernamesRequest\x12\x1c\n" +
+	"\tusernames\x18\x01 \x03(\tR\tusernames\"V\n" +
+	"\bUserItem\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\"B\n" +
+	"\x17FindByUsernamesResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\x11.service.UserItemR\x05users\"\xbd\x01\n" +
+	"\vMentionItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05bizId\x18\x02 \x01(\tR\x05bizId\x12\x14\n" +
+	"\x05objId\x18\x03 \x01(\x03R\x05objId\x12\x1e\n" +
+	"\n" +
+	"fromUserId\x18\x04 \x01(\x03R\n" +
+	"fromUserId\x12\x16\n" +
+	"\x06userId\x18\x05 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x06 \x01(\tR\busername\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\a \x01(\x03R\n" +
+	"createTime\"\x89\x01\n" +
+	"\x12AddMentionsRequest\x12\x14\n" +
+	"\x05bizId\x18\x01 \x01(\tR\x05bizId\x12\x14\n" +
+	"\x05objId\x18\x02 \x01(\x03R\x05objId\x12\x1e\n" +
+	"\n" +
+	"fromUserId\x18\x03 \x01(\x03R\n" +
+	"fromUserId\x12'\n" +
+	"\x05users\x18\x04 \x03(\v2\x11.service.UserItemR\x05users\"\x15\n" +
+	"\x13AddMentionsResponse\"E\n" +
+	"\x15MentionsByObjsRequest\x12\x14\n" +
+	"\x05bizId\x18\x01 \x01(\tR\x05bizId\x12\x16\n" +
+	"\x06objIds\x18\x02 \x03(\x03R\x06objIds\"J\n" +
+	"\x16MentionsByObjsResponse\x120\n" +
+	"\bmentions\x18\x01 \x03(\v2\x14.service.MentionItemR\bmentions\"\x82\x01\n" +
+	"\x0fMentionsRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x05 \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03J\x04\b\x04\x10\x05R\x06cursorR\tmentionId\"\x9f\x01\n" +
+	"\x10MentionsResponse\x120\n" +
+	"\bmentions\x18\x01 \x03(\v2\x14.service.MentionItemR\bmentions\x12\x14\n" +
+	"\x05isEnd\x18\x02 \x01(\bR\x05isEnd\x12$\n" +
+	"\rnextPageToken\x18\x05 \x01(\tR\rnextPageTokenJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x06cursorR\tmentionId2\xc7\x04\n" +
 	"\x04User\x12?\n" +
 	"\bRegister\x12\x18.service.RegisterRequest\x1a\x19.service.RegisterResponse\x12?\n" +
 	"\bFindById\x12\x18.service.FindByIdRequest\x1a\x19.service.FindByIdResponse\x12K\n" +
 	"\fFindByMobile\x12\x1c.service.FindByMobileRequest\x1a\x1d.service.FindByMobileResponse\x12<\n" +
-	"\aSendSms\x12\x17.service.SendSmsRequest\x1a\x18.service.SendSmsResponseB\vZ\t./serviceb\x06proto3"
+	"\aSendSms\x12\x17.service.SendSmsRequest\x1a\x18.service.SendSmsResponse\x12T\n" +
+	"\x0fFindByUsernames\x12\x1f.service.FindByUsernamesRequest\x1a .service.FindByUsernamesResponse\x12H\n" +
+	"\vAddMentions\x12\x1b.service.AddMentionsRequest\x1a\x1c.service.AddMentionsResponse\x12Q\n" +
+	"\x0eMentionsByObjs\x12\x1e.service.MentionsByObjsRequest\x1a\x1f.service.MentionsByObjsResponse\x12?\n" +
+	"\bMentions\x12\x18.service.MentionsRequest\x1a\x19.service.MentionsResponseB\vZ\t./serviceb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -494,31 +1099,53 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_user_proto_goTypes = []any{
-	(*RegisterRequest)(nil),      // 0: service.RegisterRequest
-	(*RegisterResponse)(nil),     // 1: service.RegisterResponse
-	(*FindByIdRequest)(nil),      // 2: service.FindByIdRequest
-	(*FindByIdResponse)(nil),     // 3: service.FindByIdResponse
-	(*FindByMobileRequest)(nil),  // 4: service.FindByMobileRequest
-	(*FindByMobileResponse)(nil), // 5: service.FindByMobileResponse
-	(*SendSmsRequest)(nil),       // 6: service.SendSmsRequest
-	(*SendSmsResponse)(nil),      // 7: service.SendSmsResponse
+	(*RegisterRequest)(nil),         // 0: service.RegisterRequest
+	(*RegisterResponse)(nil),        // 1: service.RegisterResponse
+	(*FindByIdRequest)(nil),         // 2: service.FindByIdRequest
+	(*FindByIdResponse)(nil),        // 3: service.FindByIdResponse
+	(*FindByMobileRequest)(nil),     // 4: service.FindByMobileRequest
+	(*FindByMobileResponse)(nil),    // 5: service.FindByMobileResponse
+	(*SendSmsRequest)(nil),          // 6: service.SendSmsRequest
+	(*SendSmsResponse)(nil),         // 7: service.SendSmsResponse
+	(*FindByUsernamesRequest)(nil),  // 8: service.FindByUsernamesRequest
+	(*UserItem)(nil),                // 9: service.UserItem
+	(*FindByUsernamesResponse)(nil), // 10: service.FindByUsernamesResponse
+	(*MentionItem)(nil),             // 11: service.MentionItem
+	(*AddMentionsRequest)(nil),      // 12: service.AddMentionsRequest
+	(*AddMentionsResponse)(nil),     // 13: service.AddMentionsResponse
+	(*MentionsByObjsRequest)(nil),   // 14: service.MentionsByObjsRequest
+	(*MentionsByObjsResponse)(nil),  // 15: service.MentionsByObjsResponse
+	(*MentionsRequest)(nil),         // 16: service.MentionsRequest
+	(*MentionsResponse)(nil),        // 17: service.MentionsResponse
 }
 var file_user_proto_depIdxs = []int32{
-	0, // 0: service.User.Register:input_type -> service.RegisterRequest
-	2, // 1: service.User.FindById:input_type -> service.FindByIdRequest
-	4, // 2: service.User.FindByMobile:input_type -> service.FindByMobileRequest
-	6, // 3: service.User.SendSms:input_type -> service.SendSmsRequest
-	1, // 4: service.User.Register:output_type -> service.RegisterResponse
-	3, // 5: service.User.FindById:output_type -> service.FindByIdResponse
-	5, // 6: service.User.FindByMobile:output_type -> service.FindByMobileResponse
-	7, // 7: service.User.SendSms:output_type -> service.SendSmsResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	9,  // 0: service.FindByUsernamesResponse.users:type_name -> service.UserItem
+	9,  // 1: service.AddMentionsRequest.users:type_name -> service.UserItem
+	11, // 2: service.MentionsByObjsResponse.mentions:type_name -> service.MentionItem
+	11, // 3: service.MentionsResponse.mentions:type_name -> service.MentionItem
+	0,  // 4: service.User.Register:input_type -> service.RegisterRequest
+	2,  // 5: service.User.FindById:input_type -> service.FindByIdRequest
+	4,  // 6: service.User.FindByMobile:input_type -> service.FindByMobileRequest
+	6,  // 7: service.User.SendSms:input_type -> service.SendSmsRequest
+	8,  // 8: service.User.FindByUsernames:input_type -> service.FindByUsernamesRequest
+	12, // 9: service.User.AddMentions:input_type -> service.AddMentionsRequest
+	14, // 10: service.User.MentionsByObjs:input_type -> service.MentionsByObjsRequest
+	16, // 11: service.User.Mentions:input_type -> service.MentionsRequest
+	1,  // 12: service.User.Register:output_type -> service.RegisterResponse
+	3,  // 13: service.User.FindById:output_type -> service.FindByIdResponse
+	5,  // 14: service.User.FindByMobile:output_type -> service.FindByMobileResponse
+	7,  // 15: service.User.SendSms:output_type -> service.SendSmsResponse
+	10, // 16: service.User.FindByUsernames:output_type -> service.FindByUsernamesResponse
+	13, // 17: service.User.AddMentions:output_type -> service.AddMentionsResponse
+	15, // 18: service.User.MentionsByObjs:output_type -> service.MentionsByObjsResponse
+	17, // 19: service.User.Mentions:output_type -> service.MentionsResponse
+	12, // [12:20] is the sub-list for method output_type
+	4,  // [4:12] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -532,7 +1159,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
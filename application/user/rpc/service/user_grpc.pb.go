@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	User_Register_FullMethodName     = "/service.User/Register"
-	User_FindById_FullMethodName     = "/service.User/FindById"
-	User_FindByMobile_FullMethodName = "/service.User/FindByMobile"
-	User_SendSms_FullMethodName      = "/service.User/SendSms"
+	User_Register_FullMethodName        = "/service.User/Register"
+	User_FindById_FullMethodName        = "/service.User/FindById"
+	User_FindByMobile_FullMethodName    = "/service.User/FindByMobile"
+	User_SendSms_FullMethodName         = "/service.User/SendSms"
+	User_FindByUsernames_FullMethodName = "/service.User/FindByUsernames"
+	User_AddMentions_FullMethodName     = "/service.User/AddMentions"
+	User_MentionsByObjs_FullMethodName  = "/service.User/MentionsByObjs"
+	User_Mentions_FullMethodName        = "/service.User/Mentions"
 )
 
 // UserClient is the client API for User service.
@@ -33,6 +37,10 @@ type UserClient interface {
 	FindById(ctx context.Context, in *FindByIdRequest, opts ...grpc.CallOption) (*FindByIdResponse, error)
 	FindByMobile(ctx context.Context, in *FindByMobileRequest, opts ...grpc.CallOption) (*FindByMobileResponse, error)
 	SendSms(ctx context.Context, in *SendSmsRequest, opts ...grpc.CallOption) (*SendSmsResponse, error)
+	FindByUsernames(ctx context.Context, in *FindByUsernamesRequest, opts ...grpc.CallOption) (*FindByUsernamesResponse, error)
+	AddMentions(ctx context.Context, in *AddMentionsRequest, opts ...grpc.CallOption) (*AddMentionsResponse, error)
+	MentionsByObjs(ctx context.Context, in *MentionsByObjsRequest, opts ...grpc.CallOption) (*MentionsByObjsResponse, error)
+	Mentions(ctx context.Context, in *MentionsRequest, opts ...grpc.CallOption) (*MentionsResponse, error)
 }
 
 type userClient struct {
@@ -83,6 +91,46 @@ func (c *userClient) SendSms(ctx context.Context, in *SendSmsRequest, opts ...gr
 	return out, nil
 }
 
+func (c *userClient) FindByUsernames(ctx context.Context, in *FindByUsernamesRequest, opts ...grpc.CallOption) (*FindByUsernamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindByUsernamesResponse)
+	err := c.cc.Invoke(ctx, User_FindByUsernames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AddMentions(ctx context.Context, in *AddMentionsRequest, opts ...grpc.CallOption) (*AddMentionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMentionsResponse)
+	err := c.cc.Invoke(ctx, User_AddMentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) MentionsByObjs(ctx context.Context, in *MentionsByObjsRequest, opts ...grpc.CallOption) (*MentionsByObjsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MentionsByObjsResponse)
+	err := c.cc.Invoke(ctx, User_MentionsByObjs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Mentions(ctx context.Context, in *MentionsRequest, opts ...grpc.CallOption) (*MentionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MentionsResponse)
+	err := c.cc.Invoke(ctx, User_Mentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility.
@@ -91,6 +139,10 @@ type UserServer interface {
 	FindById(context.Context, *FindByIdRequest) (*FindByIdResponse, error)
 	FindByMobile(context.Context, *FindByMobileRequest) (*FindByMobileResponse, error)
 	SendSms(context.Context, *SendSmsRequest) (*SendSmsResponse, error)
+	FindByUsernames(context.Context, *FindByUsernamesRequest) (*FindByUsernamesResponse, error)
+	AddMentions(context.Context, *AddMentionsRequest) (*AddMentionsResponse, error)
+	MentionsByObjs(context.Context, *MentionsByObjsRequest) (*MentionsByObjsResponse, error)
+	Mentions(context.Context, *MentionsRequest) (*MentionsResponse, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -113,6 +165,18 @@ func (UnimplementedUserServer) FindByMobile(context.Context, *FindByMobileReques
 func (UnimplementedUserServer) SendSms(context.Context, *SendSmsRequest) (*SendSmsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendSms not implemented")
 }
+func (UnimplementedUserServer) FindByUsernames(context.Context, *FindByUsernamesRequest) (*FindByUsernamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByUsernames not implemented")
+}
+func (UnimplementedUserServer) AddMentions(context.Context, *AddMentionsRequest) (*AddMentionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMentions not implemented")
+}
+func (UnimplementedUserServer) MentionsByObjs(context.Context, *MentionsByObjsRequest) (*MentionsByObjsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MentionsByObjs not implemented")
+}
+func (UnimplementedUserServer) Mentions(context.Context, *MentionsRequest) (*MentionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mentions not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 func (UnimplementedUserServer) testEmbeddedByValue()              {}
 
@@ -206,6 +270,78 @@ func _User_SendSms_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_FindByUsernames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByUsernamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).FindByUsernames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_FindByUsernames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).FindByUsernames(ctx, req.(*FindByUsernamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AddMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AddMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AddMentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AddMentions(ctx, req.(*AddMentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_MentionsByObjs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MentionsByObjsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).MentionsByObjs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_MentionsByObjs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).MentionsByObjs(ctx, req.(*MentionsByObjsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Mentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Mentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Mentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Mentions(ctx, req.(*MentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +365,22 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendSms",
 			Handler:    _User_SendSms_Handler,
 		},
+		{
+			MethodName: "FindByUsernames",
+			Handler:    _User_FindByUsernames_Handler,
+		},
+		{
+			MethodName: "AddMentions",
+			Handler:    _User_AddMentions_Handler,
+		},
+		{
+			MethodName: "MentionsByObjs",
+			Handler:    _User_MentionsByObjs_Handler,
+		},
+		{
+			MethodName: "Mentions",
+			Handler:    _User_Mentions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
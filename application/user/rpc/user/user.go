@@ -14,20 +14,34 @@ import (
 )
 
 type (
-	FindByIdRequest      = service.FindByIdRequest
-	FindByIdResponse     = service.FindByIdResponse
-	FindByMobileRequest  = service.FindByMobileRequest
-	FindByMobileResponse = service.FindByMobileResponse
-	RegisterRequest      = service.RegisterRequest
-	RegisterResponse     = service.RegisterResponse
-	SendSmsRequest       = service.SendSmsRequest
-	SendSmsResponse      = service.SendSmsResponse
+	AddMentionsRequest      = service.AddMentionsRequest
+	AddMentionsResponse     = service.AddMentionsResponse
+	FindByIdRequest         = service.FindByIdRequest
+	FindByIdResponse        = service.FindByIdResponse
+	FindByMobileRequest     = service.FindByMobileRequest
+	FindByMobileResponse    = service.FindByMobileResponse
+	FindByUsernamesRequest  = service.FindByUsernamesRequest
+	FindByUsernamesResponse = service.FindByUsernamesResponse
+	MentionItem             = service.MentionItem
+	MentionsByObjsRequest   = service.MentionsByObjsRequest
+	MentionsByObjsResponse  = service.MentionsByObjsResponse
+	MentionsRequest         = service.MentionsRequest
+	MentionsResponse        = service.MentionsResponse
+	RegisterRequest         = service.RegisterRequest
+	RegisterResponse        = service.RegisterResponse
+	SendSmsRequest          = service.SendSmsRequest
+	SendSmsResponse         = service.SendSmsResponse
+	UserItem                = service.UserItem
 
 	User interface {
 		Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 		FindById(ctx context.Context, in *FindByIdRequest, opts ...grpc.CallOption) (*FindByIdResponse, error)
 		FindByMobile(ctx context.Context, in *FindByMobileRequest, opts ...grpc.CallOption) (*FindByMobileResponse, error)
 		SendSms(ctx context.Context, in *SendSmsRequest, opts ...grpc.CallOption) (*SendSmsResponse, error)
+		FindByUsernames(ctx context.Context, in *FindByUsernamesRequest, opts ...grpc.CallOption) (*FindByUsernamesResponse, error)
+		AddMentions(ctx context.Context, in *AddMentionsRequest, opts ...grpc.CallOption) (*AddMentionsResponse, error)
+		MentionsByObjs(ctx context.Context, in *MentionsByObjsRequest, opts ...grpc.CallOption) (*MentionsByObjsResponse, error)
+		Mentions(ctx context.Context, in *MentionsRequest, opts ...grpc.CallOption) (*MentionsResponse, error)
 	}
 
 	defaultUser struct {
@@ -60,3 +74,23 @@ func (m *defaultUser) SendSms(ctx context.Context, in *SendSmsRequest, opts ...g
 	client := service.NewUserClient(m.cli.Conn())
 	return client.SendSms(ctx, in, opts...)
 }
+
+func (m *defaultUser) FindByUsernames(ctx context.Context, in *FindByUsernamesRequest, opts ...grpc.CallOption) (*FindByUsernamesResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.FindByUsernames(ctx, in, opts...)
+}
+
+func (m *defaultUser) AddMentions(ctx context.Context, in *AddMentionsRequest, opts ...grpc.CallOption) (*AddMentionsResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.AddMentions(ctx, in, opts...)
+}
+
+func (m *defaultUser) MentionsByObjs(ctx context.Context, in *MentionsByObjsRequest, opts ...grpc.CallOption) (*MentionsByObjsResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.MentionsByObjs(ctx, in, opts...)
+}
+
+func (m *defaultUser) Mentions(ctx context.Context, in *MentionsRequest, opts ...grpc.CallOption) (*MentionsResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.Mentions(ctx, in, opts...)
+}
@@ -24,4 +24,39 @@ CREATE TABLE `follow_count` (
                                 PRIMARY KEY (`id`),
                                 UNIQUE KEY `uk_user_id` (`user_id`),
                                 KEY `ix_update_time` (`update_time`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT '关注计数表';
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT '关注计数表';
+
+CREATE TABLE `user_block` (
+                              `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                              `user_id` bigint(20) SIGNED NOT NULL COMMENT '用户ID',
+                              `target_user_id` bigint(20) SIGNED NOT NULL COMMENT '被拉黑或屏蔽的用户ID',
+                              `block_type` tinyint(1) UNSIGNED NOT NULL DEFAULT '1' COMMENT '类型：1-拉黑，2-屏蔽',
+                              `block_status` tinyint(1) UNSIGNED NOT NULL DEFAULT '1' COMMENT '状态：1-生效，2-已解除',
+                              `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                              `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+                              PRIMARY KEY (`id`),
+                              UNIQUE KEY `uk_user_id_target_user_id_block_type` (`user_id`,`target_user_id`,`block_type`),
+                              KEY `ix_target_user_id` (`target_user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT '拉黑屏蔽表';
+
+CREATE TABLE `follow_group` (
+                                `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                `user_id` bigint(20) SIGNED NOT NULL COMMENT '用户ID',
+                                `name` varchar(64) NOT NULL DEFAULT '' COMMENT '分组名称',
+                                `member_count` int(10) UNSIGNED NOT NULL DEFAULT '0' COMMENT '成员数',
+                                `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+                                PRIMARY KEY (`id`),
+                                UNIQUE KEY `uk_user_id_name` (`user_id`,`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT '关注分组表';
+
+CREATE TABLE `follow_group_member` (
+                                       `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                       `group_id` bigint(20) SIGNED NOT NULL COMMENT '分组ID',
+                                       `user_id` bigint(20) SIGNED NOT NULL COMMENT '分组所属用户ID',
+                                       `member_user_id` bigint(20) SIGNED NOT NULL COMMENT '成员用户ID',
+                                       `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                       PRIMARY KEY (`id`),
+                                       UNIQUE KEY `uk_group_id_member_user_id` (`group_id`,`member_user_id`),
+                                       KEY `ix_user_id_member_user_id` (`user_id`,`member_user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT '关注分组成员表';
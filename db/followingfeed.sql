@@ -14,5 +14,33 @@ CREATE TABLE user_inbox (
                             UNIQUE KEY uk_user_sender_article(user_id, sender_id, article_id),
                             KEY ix_user_publish_time(user_id, publish_time DESC),
                             -- 方便通过一篇文章ID，快速定位它被推送到了哪些用户的inbox。比如删除文章
-                            KEY ix_article_id(article_id)
+                            KEY ix_article_id(article_id),
+                            -- 作者切换为拉模式后，按作者清理所有粉丝收信箱中的动态
+                            KEY ix_sender_id(sender_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT '动态功能收信箱表';
+
+CREATE TABLE author_feed_mode (
+                            id bigint(20) NOT NULL AUTO_INCREMENT,
+                            user_id bigint(20) NOT NULL COMMENT '作者ID',
+                            mode tinyint(4) NOT NULL DEFAULT '1' COMMENT '分发模式 1:推模式(写粉丝收信箱) 2:拉模式(粉丝读发件箱)',
+                            migrate_status tinyint(4) NOT NULL DEFAULT '0' COMMENT '迁移状态 0:无 1:切换模式后正在迁移粉丝收信箱',
+                            create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                            update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                            PRIMARY KEY(id),
+                            UNIQUE KEY uk_user_id(user_id),
+                            KEY ix_migrate_status_update_time(migrate_status, update_time)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT '作者关注流分发模式表';
+
+-- 超出保留窗口的收信箱记录归档到这里，关注流翻页超过保留窗口时改为拉作者的发件箱
+CREATE TABLE user_inbox_archive (
+                            id bigint(20) NOT NULL COMMENT '原user_inbox的id',
+                            user_id bigint(20) NOT NULL COMMENT '收件人',
+                            article_id bigint(20) NOT NULL COMMENT '推送动态的文章ID',
+                            sender_id bigint(20) NOT NULL COMMENT '动态作者ID',
+                            status tinyint(4) NOT NULL DEFAULT '0' COMMENT '状态 0:待审核 1:审核不通过 2:可见 3:用户删除或者取消关注而删除',
+                            publish_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '动态推入时间',
+                            is_read TINYINT(1) NOT NULL DEFAULT 0 COMMENT '0未读、1已读',
+                            archive_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '归档时间',
+                            PRIMARY KEY(id),
+                            KEY ix_user_publish_time(user_id, publish_time DESC)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT '动态功能收信箱归档表';
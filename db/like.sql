@@ -6,10 +6,13 @@ CREATE TABLE `like_record` (
                                `biz_id` int NOT NULL DEFAULT 0 COMMENT '业务ID',
                                `obj_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '点赞对象id',
                                `user_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '用户ID',
+                               `reaction_type` tinyint(4) NOT NULL DEFAULT '0' COMMENT '表态类型 0:赞 1:爱心 2:笑 3:哇 4:难过',
                                `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
                                `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
                                PRIMARY KEY (`id`),
                                KEY `ix_update_time` (`update_time`),
+                               KEY `ix_biz_obj_ctime_uid` (`biz_id`,`obj_id`,`create_time`,`user_id`),
+                               KEY `ix_user_biz_ctime` (`user_id`,`biz_id`,`create_time`),
                                UNIQUE KEY `uk_biz_obj_uid` (`biz_id`,`obj_id`,`user_id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='点赞记录表';
 
@@ -23,4 +26,20 @@ CREATE TABLE `like_count` (
                               PRIMARY KEY (`id`),
                               KEY `ix_update_time` (`update_time`),
                               UNIQUE KEY `uk_biz_obj` (`biz_id`,`obj_id`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='点赞计数表';
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='点赞计数表';
+
+CREATE TABLE `like_reaction_count` (
+                                       `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                       `biz_id` int NOT NULL DEFAULT 0 COMMENT '业务ID',
+                                       `obj_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '点赞对象id',
+                                       `reaction_type` tinyint(4) NOT NULL DEFAULT '0' COMMENT '表态类型 1:爱心 2:笑 3:哇 4:难过，赞的数量由总数推算，不在这里计数',
+                                       `reaction_num` int(11) NOT NULL DEFAULT '0' COMMENT '该类型的表态数',
+                                       `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                       `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+                                       PRIMARY KEY (`id`),
+                                       KEY `ix_update_time` (`update_time`),
+                                       UNIQUE KEY `uk_biz_obj_type` (`biz_id`,`obj_id`,`reaction_type`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='表态分类型计数表';
+
+-- 赞的数量由总数减去其他类型的数量得到，不保存分类型的计数，删除之前落库的赞的计数
+DELETE FROM `like_reaction_count` WHERE `reaction_type` = 0;
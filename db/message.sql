@@ -0,0 +1,70 @@
+create database posta_message;
+use posta_message;
+
+CREATE TABLE `conversation` (
+                                `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '会话ID',
+                                `user_id_a` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '会话双方中ID较小的用户',
+                                `user_id_b` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '会话双方中ID较大的用户',
+                                `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+                                PRIMARY KEY (`id`),
+                                UNIQUE KEY `uk_user_a_b` (`user_id_a`, `user_id_b`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='私信会话表';
+
+CREATE TABLE `conversation_member` (
+                                       `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                       `conversation_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '会话ID',
+                                       `user_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '用户ID',
+                                       `peer_user_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '会话的另一方',
+                                       `last_message_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '最后一条消息ID',
+                                       `last_sender_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '最后一条消息的发送者',
+                                       `last_message` varchar(128) NOT NULL DEFAULT '' COMMENT '最后一条消息的预览',
+                                       `last_message_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '最后一条消息的时间',
+                                       `last_read_message_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '已读到的消息ID',
+                                       `unread_count` int(11) NOT NULL DEFAULT '0' COMMENT '未读数',
+                                       `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                       `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+                                       PRIMARY KEY (`id`),
+                                       UNIQUE KEY `uk_conversation_user` (`conversation_id`, `user_id`),
+                                       KEY `ix_user_mtime` (`user_id`, `last_message_time`, `conversation_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='会话成员表，每个会话两条，用于会话列表和未读数';
+
+CREATE TABLE `message_setting` (
+                                   `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                   `user_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '用户ID',
+                                   `only_following` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否只接收我关注的人的私信 0:否 1:是',
+                                   `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                   `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+                                   PRIMARY KEY (`id`),
+                                   UNIQUE KEY `uk_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='私信设置表';
+
+-- 消息按 conversation_id % 16 分表，同一个会话的消息在同一张表中，按ID分页
+CREATE TABLE `message_0` (
+                             `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '消息ID',
+                             `conversation_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '会话ID',
+                             `sender_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '发送者',
+                             `receiver_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '接收者',
+                             `content` varchar(2048) NOT NULL DEFAULT '' COMMENT '消息内容',
+                             `status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '状态 0:正常 1:已撤回',
+                             `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                             `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+                             PRIMARY KEY (`id`),
+                             KEY `ix_conversation_id` (`conversation_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='私信消息表';
+
+CREATE TABLE `message_1` LIKE `message_0`;
+CREATE TABLE `message_2` LIKE `message_0`;
+CREATE TABLE `message_3` LIKE `message_0`;
+CREATE TABLE `message_4` LIKE `message_0`;
+CREATE TABLE `message_5` LIKE `message_0`;
+CREATE TABLE `message_6` LIKE `message_0`;
+CREATE TABLE `message_7` LIKE `message_0`;
+CREATE TABLE `message_8` LIKE `message_0`;
+CREATE TABLE `message_9` LIKE `message_0`;
+CREATE TABLE `message_10` LIKE `message_0`;
+CREATE TABLE `message_11` LIKE `message_0`;
+CREATE TABLE `message_12` LIKE `message_0`;
+CREATE TABLE `message_13` LIKE `message_0`;
+CREATE TABLE `message_14` LIKE `message_0`;
+CREATE TABLE `message_15` LIKE `message_0`;
@@ -0,0 +1,27 @@
+create database posta_notification;
+use posta_notification;
+
+CREATE TABLE `notification` (
+                                `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                `user_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '接收通知的用户ID',
+                                `type` tinyint(4) NOT NULL DEFAULT '0' COMMENT '通知类型 1:点赞 2:评论 3:关注 4:@',
+                                `biz_id` varchar(64) NOT NULL DEFAULT '' COMMENT '业务ID article:文章 reply:评论',
+                                `obj_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '通知关联的对象ID',
+                                `actor_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '最近一次触发通知的用户ID',
+                                `actor_count` int(11) NOT NULL DEFAULT '0' COMMENT '聚合的触发人数',
+                                `is_read` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否已读 0:未读 1:已读',
+                                `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+                                PRIMARY KEY (`id`),
+                                KEY `ix_user_utime` (`user_id`, `update_time`, `id`),
+                                KEY `ix_user_type_obj` (`user_id`, `type`, `biz_id`, `obj_id`, `is_read`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='通知表';
+
+CREATE TABLE `notification_actor` (
+                                      `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                      `notification_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '聚合通知ID',
+                                      `actor_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '触发通知的用户ID',
+                                      `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                      PRIMARY KEY (`id`),
+                                      UNIQUE KEY `uk_notification_actor` (`notification_id`, `actor_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='聚合通知的触发人表';
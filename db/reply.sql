@@ -12,6 +12,9 @@ CREATE TABLE `reply` (
                          `content` text COLLATE utf8_unicode_ci NOT NULL COMMENT '内容',
                          `status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '状态 0:正常 1:删除',
                          `like_num` int(11) NOT NULL DEFAULT '0' COMMENT '点赞数',
+                         `dislike_num` int(11) NOT NULL DEFAULT '0' COMMENT '点踩数',
+                         `hot_score` int(11) NOT NULL DEFAULT '0' COMMENT '热度分，点赞点踩的威尔逊置信区间下界*1000000',
+                         `author_liked` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否被作者赞过 0:否 1:是',
                          `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
                          PRIMARY KEY (`id`),
                          KEY `uk_biz_tar` (`biz_id`,`target_id`),
@@ -40,4 +43,24 @@ CREATE TABLE `reply_sub_count` (
                                    `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
                                    PRIMARY KEY (`id`),
                                    UNIQUE KEY `uk_biz_rootreply` (`biz_id`,`parent_id`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='子评论计数表';
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='子评论计数表';
+
+CREATE TABLE `reply_pin` (
+                             `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                             `biz_id` varchar(64) NOT NULL DEFAULT '' COMMENT '业务ID',
+                             `target_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '评论目标id',
+                             `reply_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '置顶的评论ID',
+                             `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                             `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+                             PRIMARY KEY (`id`),
+                             UNIQUE KEY `uk_biz_tar` (`biz_id`,`target_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='评论置顶表';
+
+-- 已有的库执行下面的语句加上点踩、热度和作者赞过的字段，新建的库不用执行
+ALTER TABLE `reply`
+    ADD COLUMN `dislike_num` int(11) NOT NULL DEFAULT '0' COMMENT '点踩数' AFTER `like_num`,
+    ADD COLUMN `hot_score` int(11) NOT NULL DEFAULT '0' COMMENT '热度分，点赞点踩的威尔逊置信区间下界*1000000' AFTER `dislike_num`,
+    ADD COLUMN `author_liked` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否被作者赞过 0:否 1:是' AFTER `hot_score`;
+
+-- 已有评论还没有点踩，威尔逊下界化简为 like_num / (like_num + 1.96²)，和reply-mq中wilsonScore的结果一致
+UPDATE `reply` SET `hot_score` = FLOOR(`like_num` / (`like_num` + 1.96 * 1.96) * 1000000) WHERE `like_num` > 0;
@@ -9,5 +9,20 @@ CREATE TABLE `user` (
                         `avatar` varchar(256) NOT NULL DEFAULT '' COMMENT '头像',
                         `mobile` varchar(128) NOT NULL DEFAULT '' COMMENT '手机号',
                         PRIMARY KEY (`id`),
-                        KEY `ix_mtime` (`mtime`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户表';
\ No newline at end of file
+                        KEY `ix_mtime` (`mtime`),
+                        KEY `ix_username` (`username`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户表';
+
+CREATE TABLE `mention` (
+                           `id` bigint(20) SIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                           `biz_id` varchar(64) NOT NULL DEFAULT '' COMMENT '业务ID article:文章 reply:评论',
+                           `obj_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '内容ID',
+                           `from_user_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '发起@的用户ID',
+                           `user_id` bigint(20) SIGNED NOT NULL DEFAULT '0' COMMENT '被@的用户ID',
+                           `username` varchar(32) NOT NULL DEFAULT '' COMMENT '被@时的用户名',
+                           `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                           `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+                           PRIMARY KEY (`id`),
+                           UNIQUE KEY `uk_biz_obj_user` (`biz_id`, `obj_id`, `user_id`),
+                           KEY `ix_user_ctime` (`user_id`, `create_time`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='@提及表';
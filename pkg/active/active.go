@@ -0,0 +1,109 @@
+package active
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/collection"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/rest"
+)
+
+// UsersKey 用户最近一次活跃的时间，score是unix秒
+const UsersKey = "biz#user#active"
+
+// TouchInterval 同一个用户两次写入活跃时间的最小间隔
+const TouchInterval = 5 * time.Minute
+
+// jwt中用户id的字段名，和登录时写入token的字段保持一致
+const userIdKey = "userId"
+
+// 批量过滤活跃用户，ZSCORE不存在时返回false
+const filterScript = `
+local ret = {}
+for i = 2, #ARGV do
+	local score = redis.call("ZSCORE", KEYS[1], ARGV[i])
+	if score and tonumber(score) >= tonumber(ARGV[1]) then
+		table.insert(ret, ARGV[i])
+	end
+end
+return ret`
+
+// Touch 记录用户当前活跃
+func Touch(ctx context.Context, rds *redis.Redis, userId int64) error {
+	_, err := rds.ZaddCtx(ctx, UsersKey, time.Now().Unix(), strconv.FormatInt(userId, 10))
+	return err
+}
+
+// LastActive 用户最近一次活跃的时间，没有记录时返回0
+func LastActive(ctx context.Context, rds *redis.Redis, userId int64) (int64, error) {
+	score, err := rds.ZscoreCtx(ctx, UsersKey, strconv.FormatInt(userId, 10))
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return score, err
+}
+
+// Trim 删除before之前最后活跃的用户。Filter只看最近一段时间内的活跃，更早的记录没有用，不删会一直增长
+func Trim(ctx context.Context, rds *redis.Redis, before time.Time) error {
+	_, err := rds.ZremrangebyscoreCtx(ctx, UsersKey, 0, before.Unix()-1)
+	return err
+}
+
+// Filter 返回userIds中since之后活跃过的用户
+func Filter(ctx context.Context, rds *redis.Redis, userIds []int64, since time.Time) ([]int64, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(userIds)+1)
+	args = append(args, since.Unix())
+	for _, id := range userIds {
+		args = append(args, id)
+	}
+	val, err := rds.EvalCtx(ctx, filterScript, []string{UsersKey}, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	members, _ := val.([]any)
+	ret := make([]int64, 0, len(members))
+	for _, member := range members {
+		s, _ := member.(string)
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			continue
+		}
+		ret = append(ret, id)
+	}
+	return ret, nil
+}
+
+// Middleware 记录jwt鉴权通过的用户的活跃时间，未登录的请求直接跳过。
+// 同一个进程内每个用户interval内只写一次redis
+func Middleware(rds *redis.Redis, interval time.Duration) rest.Middleware {
+	touched, err := collection.NewCache(interval)
+	if err != nil {
+		panic(err)
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if number, ok := r.Context().Value(userIdKey).(json.Number); ok {
+				if userId, err := number.Int64(); err == nil && userId > 0 {
+					key := number.String()
+					if _, ok = touched.Get(key); !ok {
+						touched.Set(key, struct{}{})
+						if err = Touch(r.Context(), rds, userId); err != nil {
+							logx.WithContext(r.Context()).Errorf("active.Touch userId: %d error: %v", userId, err)
+						}
+					}
+				}
+			}
+			next(w, r)
+		}
+	}
+}
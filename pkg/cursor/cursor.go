@@ -0,0 +1,98 @@
+package cursor
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// 当前token的版本号，Cursor的编码方式变化时加一，旧版本的token会被当成无效的
+const version byte = 1
+
+// 签名只保留前12个字节，足够防篡改，同时让token短一些
+const macSize = 12
+
+// ErrInvalid token被篡改、版本不对或者不属于这个列表
+var ErrInvalid = errors.New("cursor: invalid page token")
+
+// Cursor 分页游标的内容。Sort是上一页最后一条记录的排序字段的值，
+// Id用来在Sort相同时区分先后。多路合并的列表（比如关注流）每一路的游标放在Subs中
+type Cursor struct {
+	Sort int64             `json:"s,omitempty"`
+	Id   int64             `json:"i,omitempty"`
+	Subs map[string]Cursor `json:"c,omitempty"`
+}
+
+// IsZero 是否是第一页
+func (c Cursor) IsZero() bool {
+	return c.Sort == 0 && c.Id == 0 && len(c.Subs) == 0
+}
+
+// Sub 取出名为name的子游标，不存在时返回零值
+func (c Cursor) Sub(name string) Cursor {
+	return c.Subs[name]
+}
+
+// SetSub 设置名为name的子游标
+func (c *Cursor) SetSub(name string, sub Cursor) {
+	if c.Subs == nil {
+		c.Subs = make(map[string]Cursor)
+	}
+	c.Subs[name] = sub
+}
+
+// Codec 把Cursor编码成客户端不透明的token，并用HMAC签名防止客户端伪造
+type Codec struct {
+	secret []byte
+}
+
+func NewCodec(secret string) *Codec {
+	return &Codec{secret: []byte(secret)}
+}
+
+// Encode 生成token。scope标识token所属的列表，通常带上列表的查询条件，
+// 这样一个列表的token不能拿到另一个列表中使用
+func (c *Codec) Encode(scope string, cur Cursor) string {
+	// Cursor中只有整数和map，Marshal不会失败
+	payload, _ := json.Marshal(cur)
+	buf := make([]byte, 0, 1+len(payload)+macSize)
+	buf = append(buf, version)
+	buf = append(buf, payload...)
+	buf = append(buf, c.sign(scope, payload)...)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// Decode 解析token，空token表示第一页，返回零值
+func (c *Codec) Decode(scope, token string) (Cursor, error) {
+	var cur Cursor
+	if token == "" {
+		return cur, nil
+	}
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) <= 1+macSize || buf[0] != version {
+		return cur, ErrInvalid
+	}
+	payload, mac := buf[1:len(buf)-macSize], buf[len(buf)-macSize:]
+	if !hmac.Equal(mac, c.sign(scope, payload)) {
+		return cur, ErrInvalid
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(&cur); err != nil {
+		return cur, ErrInvalid
+	}
+	return cur, nil
+}
+
+func (c *Codec) sign(scope string, payload []byte) []byte {
+	h := hmac.New(sha256.New, c.secret)
+	h.Write([]byte{version})
+	h.Write([]byte(scope))
+	// scope和payload之间加分隔符，避免拼接后产生歧义
+	h.Write([]byte{0})
+	h.Write(payload)
+	return h.Sum(nil)[:macSize]
+}
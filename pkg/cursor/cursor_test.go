@@ -0,0 +1,47 @@
+package cursor
+
+import (
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	codec := NewCodec("secret")
+	cur := Cursor{Sort: 1700000000, Id: 42}
+	cur.SetSub("bigUp", Cursor{Sort: 1699999999, Id: 7})
+
+	token := codec.Encode("feed#1", cur)
+	got, err := codec.Decode("feed#1", token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Sort != cur.Sort || got.Id != cur.Id || got.Sub("bigUp").Sort != 1699999999 || got.Sub("bigUp").Id != 7 {
+		t.Fatalf("expected %+v, but got %+v", cur, got)
+	}
+}
+
+func TestDecodeEmpty(t *testing.T) {
+	got, err := NewCodec("secret").Decode("feed#1", "")
+	if err != nil || !got.IsZero() {
+		t.Fatalf("expected zero cursor, but got %+v err: %v", got, err)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	codec := NewCodec("secret")
+	token := codec.Encode("feed#1", Cursor{Sort: 1, Id: 2})
+
+	if _, err := codec.Decode("feed#2", token); err != ErrInvalid {
+		t.Fatalf("expected ErrInvalid for another scope, but got %v", err)
+	}
+	if _, err := NewCodec("other").Decode("feed#1", token); err != ErrInvalid {
+		t.Fatalf("expected ErrInvalid for another secret, but got %v", err)
+	}
+	tampered := []byte(token)
+	tampered[3] ^= 1
+	if _, err := codec.Decode("feed#1", string(tampered)); err != ErrInvalid {
+		t.Fatalf("expected ErrInvalid for tampered token, but got %v", err)
+	}
+	if _, err := codec.Decode("feed#1", "not a token"); err != ErrInvalid {
+		t.Fatalf("expected ErrInvalid for garbage, but got %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package mention
+
+import (
+	"regexp"
+	"unicode/utf8"
+)
+
+// 被@的业务类型
+const (
+	BizArticle = "article"
+	BizReply   = "reply"
+)
+
+// MaxMentions 一段内容最多解析的@用户数，防止刷屏式@
+const MaxMentions = 20
+
+// 用户名和注册时保持一致：字母、数字、下划线、中划线以及中文等，最长32个字符，遇到空格或标点结束。
+// @前面必须是开头或者不属于邮箱、链接的字符，避免把bob@example.com、https://x.com/@bob当成@。
+// 中文之间通常不加空格，所以@前面可以是中文。regexp不支持后行断言，前面的字符也会被匹配进来
+var mentionRegexp = regexp.MustCompile(`(?:^|[^0-9A-Za-z_.+\-/:=@])(@([\p{L}\p{N}_\-]{1,32}))`)
+
+// Span 内容中的一个@片段，Offset和Length都是按rune计算的，方便前端直接截取渲染
+type Span struct {
+	UserId   int64
+	Username string
+	Offset   int
+	Length   int
+}
+
+// Parse 解析内容中所有的@username片段，此时还没有解析出UserId
+func Parse(content string) []Span {
+	matches := mentionRegexp.FindAllStringSubmatchIndex(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	spans := make([]Span, 0, len(matches))
+	for _, m := range matches {
+		// m[0],m[1]还包括@前面的字符，m[2],m[3]是"@username"的字节区间，m[4],m[5]是username的字节区间
+		spans = append(spans, Span{
+			Username: content[m[4]:m[5]],
+			Offset:   utf8.RuneCountInString(content[:m[2]]),
+			Length:   utf8.RuneCountInString(content[m[2]:m[3]]),
+		})
+	}
+
+	return spans
+}
+
+// Usernames 返回内容中去重后的用户名，最多MaxMentions个
+func Usernames(content string) []string {
+	var (
+		seen      = make(map[string]struct{})
+		usernames []string
+	)
+	for _, span := range Parse(content) {
+		if _, ok := seen[span.Username]; ok {
+			continue
+		}
+		seen[span.Username] = struct{}{}
+		usernames = append(usernames, span.Username)
+		if len(usernames) >= MaxMentions {
+			break
+		}
+	}
+
+	return usernames
+}
+
+// BuildSpans 根据已经解析好的用户名->用户ID映射生成渲染用的片段，没有对应用户的@会被忽略
+func BuildSpans(content string, userIds map[string]int64) []Span {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	var spans []Span
+	for _, span := range Parse(content) {
+		userId, ok := userIds[span.Username]
+		if !ok {
+			continue
+		}
+		span.UserId = userId
+		spans = append(spans, span)
+	}
+
+	return spans
+}
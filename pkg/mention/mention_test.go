@@ -0,0 +1,50 @@
+package mention
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	spans := Parse("你好@张三，和@bob_1 一起看看 @张三")
+	if len(spans) != 3 {
+		t.Fatalf("expected 3 spans, but got %d", len(spans))
+	}
+	if spans[0].Username != "张三" || spans[0].Offset != 2 || spans[0].Length != 3 {
+		t.Fatalf("unexpected span: %+v", spans[0])
+	}
+	if spans[1].Username != "bob_1" || spans[1].Offset != 7 || spans[1].Length != 6 {
+		t.Fatalf("unexpected span: %+v", spans[1])
+	}
+}
+
+func TestParseEmailAndURL(t *testing.T) {
+	spans := Parse("联系bob@example.com，主页https://medium.com/@bob?ref=x@y")
+	if len(spans) != 0 {
+		t.Fatalf("expected no spans, but got %+v", spans)
+	}
+
+	spans = Parse("@alice 发邮件到a.b@c.com,@张三")
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, but got %+v", spans)
+	}
+	if spans[0].Username != "alice" || spans[0].Offset != 0 || spans[0].Length != 6 {
+		t.Fatalf("unexpected span: %+v", spans[0])
+	}
+	if spans[1].Username != "张三" || spans[1].Offset != 21 || spans[1].Length != 3 {
+		t.Fatalf("unexpected span: %+v", spans[1])
+	}
+}
+
+func TestUsernames(t *testing.T) {
+	usernames := Usernames("@a @b @a")
+	if len(usernames) != 2 || usernames[0] != "a" || usernames[1] != "b" {
+		t.Fatalf("unexpected usernames: %v", usernames)
+	}
+}
+
+func TestBuildSpans(t *testing.T) {
+	spans := BuildSpans("@a @nobody", map[string]int64{"a": 1})
+	if len(spans) != 1 || spans[0].UserId != 1 {
+		t.Fatalf("unexpected spans: %+v", spans)
+	}
+}
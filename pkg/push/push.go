@@ -0,0 +1,34 @@
+package push
+
+import "encoding/json"
+
+// 推送给客户端的事件类型
+const (
+	// EventNotification 有新的通知（点赞、关注、@）
+	EventNotification = "notification"
+	// EventReply 有人回复了我
+	EventReply = "reply"
+	// EventFeed 关注流有新内容
+	EventFeed = "feed"
+	// EventMessage 私信有新消息、被撤回或者被对方读了
+	EventMessage = "message"
+	// EventResync 断线太久，缓存的事件已经不完整，客户端需要重新拉取列表
+	EventResync = "resync"
+)
+
+// Msg 业务服务发送到push topic的消息，由push-gateway分配事件ID后推送给在线的用户
+type Msg struct {
+	UserId int64           `json:"userId"`
+	Type   string          `json:"type"`
+	Data   json.RawMessage `json:"data,omitempty"`
+}
+
+// NewMsg 把业务数据序列化后组装成推送消息
+func NewMsg(userId int64, eventType string, data any) (*Msg, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Msg{UserId: userId, Type: eventType, Data: raw}, nil
+}
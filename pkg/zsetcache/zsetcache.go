@@ -0,0 +1,305 @@
+package zsetcache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// Order 列表的排列顺序
+type Order int
+
+const (
+	// Desc score从大到小，比如按发布时间从新到旧
+	Desc Order = iota
+	// Asc score从小到大，比如二级评论按发布时间从旧到新
+	Asc
+)
+
+// 结束标记，有它说明缓存的是完整的列表。score取列表尾部方向的无穷大，永远排在最后
+const endMember = "end"
+
+// Conf 缓存的配置，同一个Cache可以用于多个key
+type Conf struct {
+	Order   Order
+	MaxSize int // 最多缓存多少条，超出时从尾部裁掉
+	Expire  int // 过期时间，单位秒，每次读取时续期
+}
+
+// Item 缓存中的一条记录，Id是记录的id，Score是排序字段的值
+type Item struct {
+	Id    int64
+	Score int64
+}
+
+// Page 从缓存中读到的一页
+type Page struct {
+	Items []Item
+	IsEnd bool
+}
+
+// Cache 按score分页的zset缓存。缓存中只保存列表的前MaxSize条，
+// 所有更新都在Lua中判断缓存的状态后原子地执行。
+// 每个key还有一个版本号，每次Add、Remove都会加1，缓存不存在时也一样。回源前先用Version取版本号，
+// Fill时版本号变了说明查询数据库期间有更新，查到的数据可能已经过期，不再写入缓存
+type Cache struct {
+	rds  *redis.Redis
+	conf Conf
+}
+
+func New(rds *redis.Redis, conf Conf) *Cache {
+	return &Cache{rds: rds, conf: conf}
+}
+
+// 读取cursor之后的limit+1条。score相同时zset按member的字典序排列，所以游标要同时比较score和member
+const readScript = `
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return {0}
+end
+redis.call("EXPIRE", KEYS[1], ARGV[6])
+local desc = ARGV[1] == "desc"
+local need = tonumber(ARGV[5]) + 1
+local ret, count = {1}, 0
+local function collect(pairs)
+	for i = 1, #pairs, 2 do
+		if count >= need then
+			return
+		end
+		table.insert(ret, pairs[i])
+		table.insert(ret, pairs[i + 1])
+		count = count + 1
+	end
+end
+if ARGV[2] == "0" then
+	if desc then
+		collect(redis.call("ZREVRANGE", KEYS[1], 0, need - 1, "WITHSCORES"))
+	else
+		collect(redis.call("ZRANGE", KEYS[1], 0, need - 1, "WITHSCORES"))
+	end
+	return ret
+end
+local score, member = ARGV[3], ARGV[4]
+local ties
+if desc then
+	ties = redis.call("ZREVRANGEBYSCORE", KEYS[1], score, score)
+else
+	ties = redis.call("ZRANGEBYSCORE", KEYS[1], score, score)
+end
+local after = {}
+for _, m in ipairs(ties) do
+	if (desc and m < member) or (not desc and m > member) then
+		table.insert(after, m)
+		table.insert(after, score)
+	end
+end
+collect(after)
+if count < need then
+	if desc then
+		collect(redis.call("ZREVRANGEBYSCORE", KEYS[1], "(" .. score, "-inf", "WITHSCORES", "LIMIT", 0, need - count))
+	else
+		collect(redis.call("ZRANGEBYSCORE", KEYS[1], "(" .. score, "+inf", "WITHSCORES", "LIMIT", 0, need - count))
+	end
+end
+return ret`
+
+// 先增加版本号，再只在缓存存在时加入。缓存不完整时比尾部还靠后的记录不能加进来，否则翻页时会漏掉中间没有缓存的记录，
+// 分数变化后移到尾部之后的记录也要从缓存中去掉。超出MaxSize时从尾部裁掉，列表也就不完整了
+const addScript = `
+redis.call("INCR", KEYS[2])
+redis.call("EXPIRE", KEYS[2], ARGV[5])
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+local desc = ARGV[1] == "desc"
+local member, score, max = ARGV[2], tonumber(ARGV[3]), tonumber(ARGV[4])
+local complete = redis.call("ZSCORE", KEYS[1], "` + endMember + `")
+if not complete then
+	local tail
+	if desc then
+		tail = redis.call("ZRANGE", KEYS[1], 0, 0, "WITHSCORES")
+	else
+		tail = redis.call("ZREVRANGE", KEYS[1], 0, 0, "WITHSCORES")
+	end
+	if #tail > 0 then
+		local tailScore = tonumber(tail[2])
+		local beyond
+		if desc then
+			beyond = score < tailScore or (score == tailScore and member < tail[1])
+		else
+			beyond = score > tailScore or (score == tailScore and member > tail[1])
+		end
+		if beyond then
+			redis.call("ZREM", KEYS[1], member)
+			return 0
+		end
+	end
+end
+redis.call("ZADD", KEYS[1], ARGV[3], member)
+local n = redis.call("ZCARD", KEYS[1])
+if complete then
+	n = n - 1
+end
+if n > max then
+	redis.call("ZREM", KEYS[1], "` + endMember + `")
+	if desc then
+		redis.call("ZREMRANGEBYRANK", KEYS[1], 0, n - max - 1)
+	else
+		redis.call("ZREMRANGEBYRANK", KEYS[1], max, -1)
+	end
+end
+return 1`
+
+// 删除记录前增加版本号
+const removeScript = `
+redis.call("INCR", KEYS[2])
+redis.call("EXPIRE", KEYS[2], ARGV[2])
+return redis.call("ZREM", KEYS[1], ARGV[1])`
+
+// 回源后写入缓存，缓存已经存在时说明别的请求写过了，不再覆盖；
+// 版本号和回源前取到的不一致时，说明查询期间有更新，查到的数据可能缺了这次更新，也不写入
+const fillScript = `
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return 0
+end
+if tonumber(redis.call("GET", KEYS[2]) or "0") ~= tonumber(ARGV[4]) then
+	return 0
+end
+for i = 5, #ARGV, 2 do
+	redis.call("ZADD", KEYS[1], ARGV[i], ARGV[i + 1])
+end
+if ARGV[2] == "1" then
+	redis.call("ZADD", KEYS[1], ARGV[3], "` + endMember + `")
+end
+redis.call("EXPIRE", KEYS[1], ARGV[1])
+return 1`
+
+// Read 读取after之后的limit条，after为nil时从第一条开始读。
+// 缓存不存在，或者缓存的部分不够一页并且列表没有结束时返回nil，需要回源查询
+func (c *Cache) Read(ctx context.Context, key string, after *Item, limit int) (*Page, error) {
+	hasCursor, score, member := "0", "0", ""
+	if after != nil {
+		hasCursor, score, member = "1", strconv.FormatInt(after.Score, 10), encodeMember(after.Id)
+	}
+	val, err := c.rds.EvalCtx(ctx, readScript, []string{key},
+		c.order(), hasCursor, score, member, limit, c.conf.Expire)
+	if err != nil {
+		return nil, err
+	}
+	return parsePage(val, limit)
+}
+
+// Version 返回key当前的版本号，要在回源查询数据库之前调用，查到的结果和版本号一起传给Fill
+func (c *Cache) Version(ctx context.Context, key string) (int64, error) {
+	val, err := c.rds.GetCtx(ctx, versionKey(key))
+	if err != nil {
+		return 0, err
+	}
+	if val == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(val, 10, 64)
+}
+
+// Fill 回源查询第一页时，用查到的前面一部分记录写入缓存，complete表示items已经是完整的列表，
+// version是回源之前用Version取到的版本号。
+// 只能用从列表开头查到的记录写入，否则缓存的列表前面会缺一段
+func (c *Cache) Fill(ctx context.Context, key string, version int64, items []Item, complete bool) error {
+	if len(items) > c.conf.MaxSize {
+		items, complete = items[:c.conf.MaxSize], false
+	}
+	if len(items) == 0 && !complete {
+		return nil
+	}
+	args := make([]any, 0, 4+len(items)*2)
+	args = append(args, c.conf.Expire, boolArg(complete), c.endScore(), version)
+	for _, item := range items {
+		args = append(args, item.Score, encodeMember(item.Id))
+	}
+	_, err := c.rds.EvalCtx(ctx, fillScript, []string{key, versionKey(key)}, args...)
+	return err
+}
+
+// Add 新增记录或者更新记录的score，缓存不存在时什么都不做
+func (c *Cache) Add(ctx context.Context, key string, item Item) error {
+	_, err := c.rds.EvalCtx(ctx, addScript, []string{key, versionKey(key)},
+		c.order(), encodeMember(item.Id), item.Score, c.conf.MaxSize, c.conf.Expire)
+	return err
+}
+
+// Remove 删除记录，ZREM不会创建key，不需要判断缓存是否存在
+func (c *Cache) Remove(ctx context.Context, key string, id int64) error {
+	_, err := c.rds.EvalCtx(ctx, removeScript, []string{key, versionKey(key)}, encodeMember(id), c.conf.Expire)
+	return err
+}
+
+func (c *Cache) order() string {
+	if c.conf.Order == Asc {
+		return "asc"
+	}
+	return "desc"
+}
+
+func (c *Cache) endScore() string {
+	if c.conf.Order == Asc {
+		return "+inf"
+	}
+	return "-inf"
+}
+
+// versionKey 版本号的key，和缓存的过期时间相同
+func versionKey(key string) string {
+	return key + "#version"
+}
+
+// encodeMember member补零到19位，score相同时字典序和id的数值顺序一致，和数据库按id排序的结果相同
+func encodeMember(id int64) string {
+	return fmt.Sprintf("%019d", id)
+}
+
+func boolArg(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// parsePage 解析readScript的返回值，第一个元素为0表示缓存不存在，之后是member和score交替
+func parsePage(val any, limit int) (*Page, error) {
+	values, ok := val.([]any)
+	if !ok || len(values) == 0 {
+		return nil, fmt.Errorf("zsetcache: unexpected reply %v", val)
+	}
+	if exists, _ := values[0].(int64); exists == 0 {
+		return nil, nil
+	}
+
+	page := &Page{}
+	for i := 1; i+1 < len(values); i += 2 {
+		member, _ := values[i].(string)
+		if member == endMember {
+			page.IsEnd = true
+			break
+		}
+		if len(page.Items) == limit {
+			// 多读的一条不是结束标记，说明后面还有
+			return page, nil
+		}
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("zsetcache: invalid member %q", member)
+		}
+		rawScore, _ := values[i+1].(string)
+		score, err := strconv.ParseFloat(rawScore, 64)
+		if err != nil {
+			return nil, fmt.Errorf("zsetcache: invalid score %q", rawScore)
+		}
+		page.Items = append(page.Items, Item{Id: id, Score: int64(score)})
+	}
+	// 缓存的部分读完了，列表却还没有结束，剩下的需要回源
+	if !page.IsEnd && len(page.Items) < limit {
+		return nil, nil
+	}
+	return page, nil
+}
@@ -0,0 +1,274 @@
+package zsetcache
+
+import (
+	"context"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+func TestParsePage(t *testing.T) {
+	page, err := parsePage([]any{int64(1), encodeMember(3), "30", encodeMember(2), "20", encodeMember(1), "10"}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page == nil || page.IsEnd || len(page.Items) != 2 || page.Items[1] != (Item{Id: 2, Score: 20}) {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+
+	page, err = parsePage([]any{int64(1), encodeMember(2), "20", endMember, "-inf"}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page == nil || !page.IsEnd || len(page.Items) != 1 {
+		t.Fatalf("expected the last page, but got %+v", page)
+	}
+}
+
+func TestParsePageMiss(t *testing.T) {
+	// 缓存不存在
+	page, err := parsePage([]any{int64(0)}, 2)
+	if err != nil || page != nil {
+		t.Fatalf("expected miss, but got %+v err: %v", page, err)
+	}
+	// 缓存的部分不够一页，也没有结束标记
+	page, err = parsePage([]any{int64(1), encodeMember(2), "20"}, 2)
+	if err != nil || page != nil {
+		t.Fatalf("expected miss, but got %+v err: %v", page, err)
+	}
+}
+
+func TestEncodeMember(t *testing.T) {
+	// 补零后字典序和数值顺序一致
+	if !(encodeMember(9) < encodeMember(10)) {
+		t.Fatalf("expected %s < %s", encodeMember(9), encodeMember(10))
+	}
+}
+
+func newTestCache(t *testing.T, conf Conf) (*Cache, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	rds := redis.MustNewRedis(redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType})
+	conf.Expire = 60
+	return New(rds, conf), mr
+}
+
+func itemIds(page *Page) []int64 {
+	ids := make([]int64, 0, len(page.Items))
+	for _, item := range page.Items {
+		ids = append(ids, item.Id)
+	}
+	return ids
+}
+
+func TestReadCursorTies(t *testing.T) {
+	ctx := context.Background()
+	// 2、3、4的score相同，score相同时按id排序，和数据库的(score, id)游标一致
+	items := []Item{{Id: 5, Score: 30}, {Id: 4, Score: 20}, {Id: 3, Score: 20}, {Id: 2, Score: 20}, {Id: 1, Score: 10}}
+
+	desc, _ := newTestCache(t, Conf{Order: Desc, MaxSize: 10})
+	if err := desc.Fill(ctx, "desc", 0, items, true); err != nil {
+		t.Fatal(err)
+	}
+	page, err := desc.Read(ctx, "desc", &Item{Id: 4, Score: 20}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page == nil || page.IsEnd || !slices.Equal(itemIds(page), []int64{3, 2}) {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+	page, err = desc.Read(ctx, "desc", &page.Items[1], 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page == nil || !page.IsEnd || !slices.Equal(itemIds(page), []int64{1}) {
+		t.Fatalf("expected the last page, but got %+v", page)
+	}
+
+	asc, _ := newTestCache(t, Conf{Order: Asc, MaxSize: 10})
+	if err = asc.Fill(ctx, "asc", 0, items, true); err != nil {
+		t.Fatal(err)
+	}
+	page, err = asc.Read(ctx, "asc", &Item{Id: 2, Score: 20}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page == nil || page.IsEnd || !slices.Equal(itemIds(page), []int64{3, 4}) {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+	page, err = asc.Read(ctx, "asc", &Item{Id: 4, Score: 20}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page == nil || !page.IsEnd || !slices.Equal(itemIds(page), []int64{5}) {
+		t.Fatalf("expected the last page, but got %+v", page)
+	}
+}
+
+func TestAddBeyondTail(t *testing.T) {
+	ctx := context.Background()
+	cache, mr := newTestCache(t, Conf{Order: Desc, MaxSize: 10})
+	// 只缓存了列表的前两条
+	if err := cache.Fill(ctx, "key", 0, []Item{{Id: 3, Score: 30}, {Id: 2, Score: 20}}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// 比尾部还靠后的记录不能加入，否则翻页会跳过数据库中两者之间的记录
+	if err := cache.Add(ctx, "key", Item{Id: 1, Score: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Add(ctx, "key", Item{Id: 4, Score: 40}); err != nil {
+		t.Fatal(err)
+	}
+	// score变化后移到尾部之后的记录要从缓存中去掉
+	if err := cache.Add(ctx, "key", Item{Id: 3, Score: 5}); err != nil {
+		t.Fatal(err)
+	}
+	members, err := mr.ZMembers("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(members, []string{encodeMember(2), encodeMember(4)}) {
+		t.Fatalf("unexpected members: %v", members)
+	}
+
+	// 缓存不存在时什么都不做
+	if err = cache.Add(ctx, "missing", Item{Id: 1, Score: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists("missing") {
+		t.Fatal("expected missing key not to be created")
+	}
+}
+
+func TestAddTrimDropsEnd(t *testing.T) {
+	ctx := context.Background()
+	cache, mr := newTestCache(t, Conf{Order: Desc, MaxSize: 2})
+	if err := cache.Fill(ctx, "key", 0, []Item{{Id: 2, Score: 20}, {Id: 1, Score: 10}}, true); err != nil {
+		t.Fatal(err)
+	}
+	page, err := cache.Read(ctx, "key", nil, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page == nil || !page.IsEnd || len(page.Items) != 2 {
+		t.Fatalf("expected the complete list, but got %+v", page)
+	}
+
+	// 超出MaxSize时从尾部裁掉，列表不再完整，结束标记也要去掉
+	if err = cache.Add(ctx, "key", Item{Id: 3, Score: 30}); err != nil {
+		t.Fatal(err)
+	}
+	members, err := mr.ZMembers("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(members, []string{encodeMember(2), encodeMember(3)}) {
+		t.Fatalf("unexpected members: %v", members)
+	}
+	// 缓存的部分不够一页，需要回源
+	page, err = cache.Read(ctx, "key", nil, 5)
+	if err != nil || page != nil {
+		t.Fatalf("expected miss, but got %+v err: %v", page, err)
+	}
+	page, err = cache.Read(ctx, "key", nil, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page == nil || page.IsEnd || !slices.Equal(itemIds(page), []int64{3, 2}) {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+}
+
+func TestEndScore(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		order Order
+		score float64
+	}{
+		{Desc, math.Inf(-1)},
+		{Asc, math.Inf(1)},
+	} {
+		cache, mr := newTestCache(t, Conf{Order: tc.order, MaxSize: 10})
+		if err := cache.Fill(ctx, "key", 0, []Item{{Id: 1, Score: 10}, {Id: 2, Score: 20}}, true); err != nil {
+			t.Fatal(err)
+		}
+		score, err := mr.ZScore("key", endMember)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if score != tc.score {
+			t.Fatalf("order %d: expected end score %v, but got %v", tc.order, tc.score, score)
+		}
+
+		// 结束标记永远在列表的最后
+		page, err := cache.Read(ctx, "key", nil, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page == nil || !page.IsEnd || len(page.Items) != 2 {
+			t.Fatalf("order %d: expected the complete list, but got %+v", tc.order, page)
+		}
+	}
+}
+
+func TestFillAfterConcurrentAdd(t *testing.T) {
+	ctx := context.Background()
+	cache, mr := newTestCache(t, Conf{Order: Desc, MaxSize: 10})
+
+	// 回源前取版本号，然后查数据库
+	version, err := cache.Version(ctx, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot := []Item{{Id: 1, Score: 10}}
+
+	// 查询期间有新记录，缓存还不存在，Add什么都不写，但是版本号变了
+	if err = cache.Add(ctx, "key", Item{Id: 2, Score: 20}); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists("key") {
+		t.Fatal("expected Add not to create the cache")
+	}
+
+	// 用查询前的快照写缓存会漏掉新记录，不能写入
+	if err = cache.Fill(ctx, "key", version, snapshot, true); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists("key") {
+		t.Fatal("expected stale fill to be skipped")
+	}
+
+	// 重新回源后可以正常写入
+	version, err = cache.Version(ctx, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = cache.Fill(ctx, "key", version, []Item{{Id: 2, Score: 20}, {Id: 1, Score: 10}}, true); err != nil {
+		t.Fatal(err)
+	}
+	page, err := cache.Read(ctx, "key", nil, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page == nil || !page.IsEnd || !slices.Equal(itemIds(page), []int64{2, 1}) {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+
+	// Remove同样会让查询前的快照失效
+	version, err = cache.Version(ctx, "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = cache.Remove(ctx, "other", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err = cache.Fill(ctx, "other", version, snapshot, true); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists("other") {
+		t.Fatal("expected stale fill after Remove to be skipped")
+	}
+}